@@ -1,15 +1,35 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
-	"path/filepath"
-	"sort"
+	"strconv"
+	"text/tabwriter"
 
 	"github.com/davidalecrim/red-airlines/internal/database"
+	"github.com/davidalecrim/red-airlines/internal/migrate"
+	"github.com/davidalecrim/red-airlines/migrations"
 )
 
+const usage = `Usage: migrate [command]
+
+Commands:
+  up            Apply the pending migrations (default)
+  down [N]      Revert the last N applied migrations (default 1)
+  goto VERSION  Apply or revert migrations until VERSION is the last applied one
+  status        List the migrations and when they were applied
+  baseline VERSION
+                Record the migrations up to VERSION as applied without running
+                them, for databases migrated before schema_migrations existed`
+
 func main() {
+	command, args := "up", []string(nil)
+	if len(os.Args) > 1 {
+		command, args = os.Args[1], os.Args[2:]
+	}
+
 	db, err := database.ConnectSQL()
 	if err != nil {
 		log.Fatalf("Connection failed: %v", err)
@@ -22,24 +42,75 @@ func main() {
 
 	log.Println("Connected to database")
 
-	files, err := filepath.Glob("migrations/*.sql")
+	migrator, err := migrate.New(db, migrations.FS)
 	if err != nil {
-		log.Fatalf("Failed to read migrations directory: %v", err)
+		log.Fatal(err)
 	}
 
-	sort.Strings(files)
-
-	for _, migrationFile := range files {
-		log.Printf("Running migration: %s", filepath.Base(migrationFile))
-		migrationSQL, err := os.ReadFile(migrationFile)
-		if err != nil {
-			log.Fatalf("Failed to read migration %s: %v", migrationFile, err)
+	ctx := context.Background()
+	switch command {
+	case "up":
+		err = migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			if steps, err = strconv.Atoi(args[0]); err != nil {
+				log.Fatalf("Invalid number of steps %q\n\n%s", args[0], usage)
+			}
 		}
-
-		if _, err := db.Exec(string(migrationSQL)); err != nil {
-			log.Fatalf("Migration %s failed: %v", migrationFile, err)
+		err = migrator.Down(ctx, steps)
+	case "goto":
+		if len(args) != 1 {
+			log.Fatalf("goto needs a version\n\n%s", usage)
+		}
+		version, convErr := strconv.Atoi(args[0])
+		if convErr != nil {
+			log.Fatalf("Invalid version %q\n\n%s", args[0], usage)
+		}
+		err = migrator.Goto(ctx, version)
+	case "status":
+		err = printStatus(ctx, migrator)
+	case "baseline":
+		if len(args) != 1 {
+			log.Fatalf("baseline needs a version\n\n%s", usage)
 		}
+		version, convErr := strconv.Atoi(args[0])
+		if convErr != nil {
+			log.Fatalf("Invalid version %q\n\n%s", args[0], usage)
+		}
+		err = migrator.Baseline(ctx, version)
+	default:
+		log.Fatalf("Unknown command %q\n\n%s", command, usage)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if command != "status" {
+		log.Println("Migrations completed")
+	}
+}
+
+func printStatus(ctx context.Context, migrator *migrate.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
 	}
 
-	log.Println("All migrations completed")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MIGRATION\tAPPLIED AT\tNOTE")
+	for _, s := range statuses {
+		appliedAt, note := "pending", ""
+		if s.AppliedAt != nil {
+			appliedAt = s.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		switch {
+		case s.Missing:
+			note = "file missing"
+		case s.Modified:
+			note = "file edited after it was applied"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", s.Name, appliedAt, note)
+	}
+	return w.Flush()
 }
@@ -0,0 +1,119 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//go:embed airports.csv
+var defaultAirports string
+
+// minRouteKm leaves out airports of the same city, such as DFW and DAL.
+const minRouteKm = 300
+
+type Airport struct {
+	Code      string
+	Country   string
+	Latitude  float64
+	Longitude float64
+	// Hub airports connect to every other airport; the others only to hubs.
+	Hub bool
+}
+
+type Route struct {
+	Origin      Airport
+	Destination Airport
+	DistanceKm  float64
+}
+
+// loadAirports reads a CSV file with the columns code, country, latitude,
+// longitude and hub, or the embedded airports when path is empty.
+func loadAirports(path string) ([]Airport, error) {
+	var r io.Reader = strings.NewReader(defaultAirports)
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read airports: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("airports file has no airports")
+	}
+
+	airports := make([]Airport, 0, len(records)-1)
+	seen := map[string]bool{}
+	for i, record := range records[1:] {
+		line := i + 2
+		if len(record) != 5 {
+			return nil, fmt.Errorf("airports line %d: expected code,country,latitude,longitude,hub", line)
+		}
+
+		a := Airport{Code: strings.ToUpper(record[0]), Country: strings.ToUpper(record[1])}
+		if len(a.Code) != 3 || len(a.Country) != 3 {
+			return nil, fmt.Errorf("airports line %d: codes must be 3 letters", line)
+		}
+		if seen[a.Code] {
+			return nil, fmt.Errorf("airports line %d: duplicate airport %s", line, a.Code)
+		}
+		seen[a.Code] = true
+
+		if a.Latitude, err = strconv.ParseFloat(record[2], 64); err != nil || math.Abs(a.Latitude) > 90 {
+			return nil, fmt.Errorf("airports line %d: invalid latitude %q", line, record[2])
+		}
+		if a.Longitude, err = strconv.ParseFloat(record[3], 64); err != nil || math.Abs(a.Longitude) > 180 {
+			return nil, fmt.Errorf("airports line %d: invalid longitude %q", line, record[3])
+		}
+		if a.Hub, err = strconv.ParseBool(record[4]); err != nil {
+			return nil, fmt.Errorf("airports line %d: invalid hub %q", line, record[4])
+		}
+		airports = append(airports, a)
+	}
+	return airports, nil
+}
+
+// routes connects hubs to every airport at least minRouteKm away, in both
+// directions. Without hubs, every airport is connected to every other.
+func routes(airports []Airport) []Route {
+	hasHub := false
+	for _, a := range airports {
+		hasHub = hasHub || a.Hub
+	}
+
+	var network []Route
+	for _, origin := range airports {
+		for _, dest := range airports {
+			if origin.Code == dest.Code || hasHub && !origin.Hub && !dest.Hub {
+				continue
+			}
+			if d := greatCircleKm(origin, dest); d >= minRouteKm {
+				network = append(network, Route{Origin: origin, Destination: dest, DistanceKm: d})
+			}
+		}
+	}
+	return network
+}
+
+// greatCircleKm returns the haversine distance between two airports.
+func greatCircleKm(a, b Airport) float64 {
+	const earthRadiusKm = 6371
+
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
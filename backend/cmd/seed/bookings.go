@@ -0,0 +1,258 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/checkin"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+const (
+	// cancellationRate adds cancelled bookings on top of the seat holders of
+	// each fare.
+	cancellationRate = 0.08
+	// seatSelectionRate is the share of passengers choosing a seat when
+	// booking; the others get one at check-in.
+	seatSelectionRate = 0.5
+	// checkInRate is the share of passengers who have checked in once
+	// check-in opened.
+	checkInRate = 0.6
+)
+
+type dataset struct {
+	flights  []*scheduledFlight
+	fares    []*model.Fare
+	bookings []*model.Booking
+}
+
+// bookings books each flight to a random load factor of the seats of each of
+// its fares, keeping the invariants of the API: every seat holder takes one
+// seat of its fare's inventory, cancelled bookings gave theirs back, seats
+// come from the check-in seat layout and are never shared, and passengers of
+// domestic flights whose check-in window has opened may be checked in.
+func (g *generator) bookings(flights []*scheduledFlight, fares []*model.Fare) []*model.Booking {
+	faresByFlight := make(map[string][]*model.Fare)
+	for _, f := range fares {
+		faresByFlight[f.FlightID] = append(faresByFlight[f.FlightID], f)
+	}
+
+	now := g.config.Start
+	var bookings []*model.Booking
+	for _, flight := range flights {
+		load := g.config.MinLoad + g.rng.Float64()*(g.config.MaxLoad-g.config.MinLoad)
+
+		seats := checkin.SeatLayout(flight.TotalSeats)
+		g.rng.Shuffle(len(seats), func(i, j int) { seats[i], seats[j] = seats[j], seats[i] })
+		takeSeat := func() *string {
+			seat := seats[0]
+			seats = seats[1:]
+			return &seat
+		}
+
+		checkInOpensAt := flight.EstimatedDeparture().Add(-checkin.WindowOpens)
+		canCheckIn := !flight.international() && !checkInOpensAt.After(now)
+
+		var checkedIn []*model.Booking
+		for _, fare := range faresByFlight[flight.ID] {
+			holders := int(math.Round(float64(fare.AvailableSeats) * load))
+			cancellations := int(math.Round(float64(holders) * cancellationRate))
+
+			for i := range holders + cancellations {
+				b := g.booking(flight, fare, now)
+				bookings = append(bookings, b)
+
+				if i >= holders {
+					b.BookingStatus = model.BookingStatusCancelled
+					b.UpdatedAt = g.between(b.BookedAt, now)
+					continue
+				}
+
+				// As Fares().TakeSeat when the API creates a booking.
+				fare.AvailableSeats--
+				if g.rng.Float64() < seatSelectionRate {
+					b.SeatNumber = takeSeat()
+				}
+
+				if canCheckIn && g.rng.Float64() < checkInRate {
+					if b.SeatNumber == nil {
+						b.SeatNumber = takeSeat()
+					}
+					from := checkInOpensAt
+					if b.BookedAt.After(from) {
+						from = b.BookedAt
+					}
+					at := g.between(from, now)
+					b.BookingStatus = model.BookingStatusCheckedIn
+					b.CheckedInAt = &at
+					b.UpdatedAt = at
+					checkedIn = append(checkedIn, b)
+				}
+			}
+		}
+
+		slices.SortStableFunc(checkedIn, func(a, b *model.Booking) int {
+			return a.CheckedInAt.Compare(*b.CheckedInAt)
+		})
+		for i, b := range checkedIn {
+			sequence := i + 1
+			b.CheckInSequence = &sequence
+		}
+	}
+	return bookings
+}
+
+// booking returns a confirmed booking of fare made before now.
+func (g *generator) booking(flight *scheduledFlight, fare *model.Fare, now time.Time) *model.Booking {
+	firstName, lastName := g.pick(firstNames), g.pick(lastNames)
+	phone := fmt.Sprintf("(%03d) %03d-%04d", g.rng.IntN(1000), g.rng.IntN(1000), g.rng.IntN(10000))
+	bookedAt := g.between(g.published, now)
+
+	return &model.Booking{
+		ID:               g.uuid(),
+		BookingReference: g.reference(),
+		FlightID:         flight.ID,
+		FareID:           fare.ID,
+		PassengerName:    firstName + " " + lastName,
+		PassengerEmail:   fmt.Sprintf("%s.%s@example.com", strings.ToLower(firstName), strings.ToLower(lastName)),
+		PassengerPhone:   &phone,
+		BookingStatus:    model.BookingStatusConfirmed,
+		TotalPrice:       fare.Price,
+		BookedAt:         bookedAt,
+		CreatedAt:        bookedAt,
+		UpdatedAt:        bookedAt,
+	}
+}
+
+// reference has the format of booking.NewReference, drawn from the seed and
+// unique within the dataset.
+func (g *generator) reference() string {
+	const charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	ref := make([]byte, 7)
+	for {
+		for i := range ref {
+			ref[i] = charset[g.rng.IntN(len(charset))]
+		}
+		if reference := "RDA" + string(ref); !g.references[reference] {
+			g.references[reference] = true
+			return reference
+		}
+	}
+}
+
+// check verifies the invariants the API maintains, so a generator bug stops
+// the seeder before anything is written.
+func (d *dataset) check() error {
+	var problems []error
+	fail := func(format string, args ...any) {
+		problems = append(problems, fmt.Errorf(format, args...))
+	}
+
+	flights := map[string]*scheduledFlight{}
+	for _, f := range d.flights {
+		flights[f.ID] = f
+	}
+	fares := map[string]*model.Fare{}
+	for _, f := range d.fares {
+		if !f.FareClass.IsValid() {
+			fail("fare %s has class %q", f.ID, f.FareClass)
+		}
+		if f.AvailableSeats < 0 {
+			fail("fare %s is oversold by %d seats", f.ID, -f.AvailableSeats)
+		}
+		fares[f.ID] = f
+	}
+
+	references := map[string]bool{}
+	seats := map[string]bool{}
+	sequences := map[string]bool{}
+	// Seats each flight started with: the remaining inventory of its fares
+	// plus the seats their bookings hold.
+	capacity := map[string]int{}
+	for _, f := range d.fares {
+		capacity[f.FlightID] += f.AvailableSeats
+	}
+
+	for _, b := range d.bookings {
+		fare, ok := fares[b.FareID]
+		if !ok || fare.FlightID != b.FlightID {
+			fail("booking %s has a fare of another flight", b.BookingReference)
+			continue
+		}
+		if references[b.BookingReference] {
+			fail("booking reference %s is used twice", b.BookingReference)
+		}
+		references[b.BookingReference] = true
+
+		if !b.BookingStatus.IsValid() {
+			fail("booking %s has status %q", b.BookingReference, b.BookingStatus)
+		}
+		if !b.BookingStatus.HoldsSeat() {
+			continue
+		}
+		capacity[b.FlightID]++
+
+		if b.SeatNumber != nil {
+			key := b.FlightID + "/" + *b.SeatNumber
+			if seats[key] {
+				fail("seat %s of flight %s is taken twice", *b.SeatNumber, flights[b.FlightID].FlightNumber)
+			}
+			seats[key] = true
+		}
+		if b.BookingStatus == model.BookingStatusCheckedIn {
+			if b.SeatNumber == nil || b.CheckedInAt == nil || b.CheckInSequence == nil {
+				fail("checked-in booking %s lacks its seat, time or sequence", b.BookingReference)
+				continue
+			}
+			key := fmt.Sprintf("%s/%d", b.FlightID, *b.CheckInSequence)
+			if sequences[key] {
+				fail("check-in sequence %d of flight %s is used twice", *b.CheckInSequence, flights[b.FlightID].FlightNumber)
+			}
+			sequences[key] = true
+		}
+	}
+
+	for _, f := range d.flights {
+		if capacity[f.ID] != f.TotalSeats {
+			fail("flight %s has %d seats but its fares account for %d", f.FlightNumber, f.TotalSeats, capacity[f.ID])
+		}
+	}
+
+	if len(problems) > 10 {
+		problems = append(problems[:10], fmt.Errorf("and %d more problems", len(problems)-10))
+	}
+	return errors.Join(problems...)
+}
+
+func (d *dataset) report(routes int, took time.Duration) {
+	byStatus := map[model.BookingStatus]int{}
+	held := 0
+	for _, b := range d.bookings {
+		byStatus[b.BookingStatus]++
+		if b.BookingStatus.HoldsSeat() {
+			held++
+		}
+	}
+	var statuses []string
+	for _, status := range slices.Sorted(maps.Keys(byStatus)) {
+		statuses = append(statuses, fmt.Sprintf("%s %d", status, byStatus[status]))
+	}
+
+	seats := 0
+	for _, f := range d.flights {
+		seats += f.TotalSeats
+	}
+
+	log.Println("Seed data completed")
+	log.Printf("  flights:  %d on %d routes", len(d.flights), routes)
+	log.Printf("  fares:    %d", len(d.fares))
+	log.Printf("  bookings: %d (%s)", len(d.bookings), strings.Join(statuses, ", "))
+	log.Printf("  seats:    %d of %d held (%.1f%%)", held, seats, 100*float64(held)/float64(max(seats, 1)))
+	log.Printf("  took:     %s", took.Round(time.Millisecond))
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+func copyFlights(tx *sqlx.Tx, flights []*scheduledFlight) error {
+	columns := []string{
+		"id", "flight_number", "origin", "destination", "departure_time", "arrival_time",
+		"aircraft_type", "total_seats", "available_seats", "status", "created_at", "updated_at",
+	}
+	return copyRows(tx, "flights", columns, len(flights), func(i int) []any {
+		f := flights[i]
+		return []any{
+			f.ID, f.FlightNumber, f.Origin, f.Destination, f.DepartureTime, f.ArrivalTime,
+			f.AircraftType, f.TotalSeats, f.AvailableSeats, f.Status, f.CreatedAt, f.UpdatedAt,
+		}
+	})
+}
+
+func copyFares(tx *sqlx.Tx, fares []*model.Fare) error {
+	columns := []string{
+		"id", "flight_id", "fare_class", "price", "baggage_allowance",
+		"is_refundable", "is_changeable", "available_seats", "created_at", "updated_at",
+	}
+	return copyRows(tx, "fares", columns, len(fares), func(i int) []any {
+		f := fares[i]
+		return []any{
+			f.ID, f.FlightID, f.FareClass, f.Price, f.BaggageAllowance,
+			f.IsRefundable, f.IsChangeable, f.AvailableSeats, f.CreatedAt, f.UpdatedAt,
+		}
+	})
+}
+
+func copyBookings(tx *sqlx.Tx, bookings []*model.Booking) error {
+	columns := []string{
+		"id", "booking_reference", "flight_id", "fare_id", "passenger_name", "passenger_email",
+		"passenger_phone", "seat_number", "booking_status", "total_price", "booked_at",
+		"checked_in_at", "check_in_sequence", "created_at", "updated_at",
+	}
+	return copyRows(tx, "bookings", columns, len(bookings), func(i int) []any {
+		b := bookings[i]
+		return []any{
+			b.ID, b.BookingReference, b.FlightID, b.FareID, b.PassengerName, b.PassengerEmail,
+			b.PassengerPhone, b.SeatNumber, b.BookingStatus, b.TotalPrice, b.BookedAt,
+			b.CheckedInAt, b.CheckInSequence, b.CreatedAt, b.UpdatedAt,
+		}
+	})
+}
+
+// copyRows streams n rows into table with COPY. Postgres reports most
+// errors, such as constraint violations, when the copy ends.
+func copyRows(tx *sqlx.Tx, table string, columns []string, n int, row func(i int) []any) error {
+	stmt, err := tx.Prepare(pq.CopyIn(table, columns...))
+	if err != nil {
+		return fmt.Errorf("copy %s: %w", table, err)
+	}
+	defer stmt.Close()
+
+	for i := range n {
+		if _, err := stmt.Exec(row(i)...); err != nil {
+			return fmt.Errorf("copy %s: %w", table, err)
+		}
+	}
+	if _, err := stmt.Exec(); err != nil {
+		return fmt.Errorf("copy %s: %w", table, err)
+	}
+	if err := stmt.Close(); err != nil {
+		return fmt.Errorf("copy %s: %w", table, err)
+	}
+
+	log.Printf("Inserted %d %s", n, table)
+	return nil
+}
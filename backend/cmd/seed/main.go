@@ -1,16 +1,21 @@
 package main
 
 import (
+	"encoding/binary"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"math"
-	"math/rand"
+	"math/rand/v2"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/davidalecrim/red-airlines/internal/database"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
 )
 
 var (
@@ -22,58 +27,126 @@ var (
 		"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis",
 		"Rodriguez", "Martinez", "Hernandez", "Lopez", "Gonzalez", "Wilson", "Anderson",
 	}
-	airports = []string{
-		"JFK", "LAX", "ORD", "DFW", "ATL", "BOS", "SEA", "MIA",
-		"SFO", "LAS", "DEN", "PHX", "IAD", "SAN", "DAL", "EWR",
-	}
-	aircraftTypes = []string{"Boeing 737", "Airbus A320", "Boeing 777"}
 )
 
-type Flight struct {
-	ID             string    `db:"id"`
-	FlightNumber   string    `db:"flight_number"`
-	Origin         string    `db:"origin"`
-	Destination    string    `db:"destination"`
-	DepartureTime  time.Time `db:"departure_time"`
-	ArrivalTime    time.Time `db:"arrival_time"`
-	AircraftType   string    `db:"aircraft_type"`
-	TotalSeats     int       `db:"total_seats"`
-	AvailableSeats int       `db:"available_seats"`
-	Status         string    `db:"status"`
-	CreatedAt      time.Time `db:"created_at"`
-	UpdatedAt      time.Time `db:"updated_at"`
+// Aircraft of the fleet. Routes longer than narrowbodyRangeKm are flown by
+// widebodies.
+type Aircraft struct {
+	Type  string
+	Seats int
 }
 
-type Fare struct {
-	ID               string    `db:"id"`
-	FlightID         string    `db:"flight_id"`
-	FareClass        string    `db:"fare_class"`
-	Price            float64   `db:"price"`
-	BaggageAllowance int       `db:"baggage_allowance"`
-	IsRefundable     bool      `db:"is_refundable"`
-	IsChangeable     bool      `db:"is_changeable"`
-	AvailableSeats   int       `db:"available_seats"`
-	CreatedAt        time.Time `db:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at"`
+const narrowbodyRangeKm = 4000
+
+var (
+	narrowbodies = []Aircraft{{"Airbus A320", 180}, {"Boeing 737", 172}}
+	widebodies   = []Aircraft{{"Boeing 777", 368}}
+)
+
+// fareClasses split the seats of a flight. BASIC gets the seats left over
+// by the other classes.
+var fareClasses = []struct {
+	class      model.FareClass
+	share      float64
+	multiplier float64
+	refund     bool
+	change     bool
+	baggage    int
+}{
+	{model.FareClassPromo, 0.15, 0.7, false, false, 1},
+	{model.FareClassBasic, 0, 1.0, true, true, 2},
+	{model.FareClassPro, 0.20, 1.5, true, true, 3},
 }
 
-type Booking struct {
-	ID               string    `db:"id"`
-	BookingReference string    `db:"booking_reference"`
-	FlightID         string    `db:"flight_id"`
-	FareID           string    `db:"fare_id"`
-	PassengerName    string    `db:"passenger_name"`
-	PassengerEmail   string    `db:"passenger_email"`
-	PassengerPhone   string    `db:"passenger_phone"`
-	SeatNumber       string    `db:"seat_number"`
-	BookingStatus    string    `db:"booking_status"`
-	TotalPrice       float64   `db:"total_price"`
-	BookedAt         time.Time `db:"booked_at"`
-	CreatedAt        time.Time `db:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at"`
+type Config struct {
+	Flights int
+	// Start is the first departure day, and the moment the dataset
+	// describes: bookings are made and check-ins done before it.
+	Start time.Time
+	Days  int
+	// Airports is a CSV file, the embedded airports when empty.
+	Airports string
+	Seed     uint64
+	// Each flight is booked to a load factor between MinLoad and MaxLoad of
+	// the seats of each fare.
+	MinLoad  float64
+	MaxLoad  float64
+	Truncate bool
+}
+
+func parseFlags() (Config, error) {
+	var c Config
+	var start string
+	flag.IntVar(&c.Flights, "flights", 1000, "number of flights")
+	flag.StringVar(&start, "start", "", "first departure day (YYYY-MM-DD), today when empty; required with -seed")
+	flag.IntVar(&c.Days, "days", 30, "number of days flights depart over")
+	flag.StringVar(&c.Airports, "airports", "", "CSV file of airports (code,country,latitude,longitude,hub), defaults to the built-in network")
+	flag.Uint64Var(&c.Seed, "seed", 1, "random seed; the same seed, -start and flags produce the same dataset")
+	flag.Float64Var(&c.MinLoad, "min-load", 0.4, "minimum share of the seats of each fare that is booked")
+	flag.Float64Var(&c.MaxLoad, "max-load", 0.95, "maximum share of the seats of each fare that is booked")
+	flag.BoolVar(&c.Truncate, "truncate", false, "delete existing flights, fares, bookings and their dependents first")
+	flag.Parse()
+
+	// A seed is given to reproduce a dataset, which a start moving with the
+	// current day would not.
+	seeded := false
+	flag.Visit(func(f *flag.Flag) { seeded = seeded || f.Name == "seed" })
+	if seeded && start == "" {
+		return c, errors.New("-start is required with -seed, so the dataset can be reproduced")
+	}
+	if start == "" {
+		start = time.Now().UTC().Format(time.DateOnly)
+	}
+
+	var err error
+	if c.Start, err = time.Parse(time.DateOnly, start); err != nil {
+		return c, fmt.Errorf("invalid -start %q: %w", start, err)
+	}
+
+	var problems []string
+	if c.Flights < 1 {
+		problems = append(problems, "-flights must be positive")
+	}
+	if c.Days < 1 {
+		problems = append(problems, "-days must be positive")
+	}
+	if c.MinLoad < 0 || c.MaxLoad > 1 || c.MinLoad > c.MaxLoad {
+		problems = append(problems, "loads must satisfy 0 <= -min-load <= -max-load <= 1")
+	}
+	if len(problems) > 0 {
+		return c, errors.New(strings.Join(problems, "; "))
+	}
+	return c, nil
 }
 
 func main() {
+	started := time.Now()
+
+	config, err := parseFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	airports, err := loadAirports(config.Airports)
+	if err != nil {
+		log.Fatal(err)
+	}
+	network := routes(airports)
+	if len(network) == 0 {
+		log.Fatal("The airports have no routes between them")
+	}
+
+	g := newGenerator(config)
+
+	log.Printf("Generating flights on %d routes between %d airports...", len(network), len(airports))
+	data := &dataset{flights: g.flights(network)}
+	data.fares = g.fares(data.flights)
+	data.bookings = g.bookings(data.flights, data.fares)
+
+	if err := data.check(); err != nil {
+		log.Fatalf("Generated data is inconsistent, nothing was written: %v", err)
+	}
+
 	db, err := database.ConnectSQLX()
 	if err != nil {
 		log.Fatalf("Connection failed: %v", err)
@@ -84,240 +157,192 @@ func main() {
 		}
 	}()
 
-	log.Println("Generating flights...")
-	flights := generateFlights(1000)
-	insertFlights(db, flights)
-
-	log.Println("Generating fares...")
-	fares := generateFares(flights)
-	insertFares(db, fares)
-
-	log.Println("Generating bookings...")
-	generateAndInsertBookings(db, flights, fares)
+	if err := write(db, config.Truncate, airports, data); err != nil {
+		log.Fatalf("Seeding failed, nothing was written: %v", err)
+	}
 
-	log.Println("Seed data completed")
+	data.report(len(network), time.Since(started))
 }
 
-func generateFlights(count int) []Flight {
-	flights := make([]Flight, 0, count)
-	now := time.Now()
-
-	for i := range count {
-		origin := airports[rand.Intn(len(airports))]
-		dest := airports[rand.Intn(len(airports))]
-		for dest == origin {
-			dest = airports[rand.Intn(len(airports))]
-		}
-
-		depTime := now.AddDate(0, 0, rand.Intn(30)).Add(time.Duration(rand.Intn(24)) * time.Hour)
-		arrTime := depTime.Add(time.Duration(2+rand.Intn(8)) * time.Hour)
-		totalSeats := 150 + rand.Intn(151)
-
-		flights = append(flights, Flight{
-			ID:             uuid.New().String(),
-			FlightNumber:   fmt.Sprintf("RA%d", 1001+i),
-			Origin:         origin,
-			Destination:    dest,
-			DepartureTime:  depTime,
-			ArrivalTime:    arrTime,
-			AircraftType:   aircraftTypes[rand.Intn(len(aircraftTypes))],
-			TotalSeats:     totalSeats,
-			AvailableSeats: totalSeats,
-			Status:         "SCHEDULED",
-			CreatedAt:      now,
-			UpdatedAt:      now,
-		})
+// write stores the dataset in one transaction, so a failure leaves the
+// database as it was.
+func write(db *sqlx.DB, truncate bool, airports []Airport, data *dataset) error {
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
 	}
-	return flights
-}
+	defer func() {
+		_ = tx.Rollback()
+	}()
 
-func insertFlights(db *sqlx.DB, flights []Flight) {
-	query := `INSERT INTO flights (id, flight_number, origin, destination, departure_time, arrival_time,
-             aircraft_type, total_seats, available_seats, status, created_at, updated_at)
-             VALUES (:id, :flight_number, :origin, :destination, :departure_time, :arrival_time,
-             :aircraft_type, :total_seats, :available_seats, :status, :created_at, :updated_at)`
-
-	for i := 0; i < len(flights); i += 500 {
-		end := min(i+500, len(flights))
-		tx := db.MustBegin()
-		for _, f := range flights[i:end] {
-			if _, err := tx.NamedExec(query, f); err != nil {
-				log.Printf("Failed to insert flight: %v", err)
-			}
+	if truncate {
+		log.Println("Deleting existing flights, fares and bookings...")
+		// Fares, bookings and everything recorded about them reference
+		// flights, directly or through bookings.
+		if _, err := tx.Exec("TRUNCATE flights CASCADE"); err != nil {
+			return fmt.Errorf("truncate flights: %w", err)
 		}
-		if err := tx.Commit(); err != nil {
-			log.Printf("Failed to commit flights: %v", err)
+	} else {
+		var count int
+		if err := tx.Get(&count, "SELECT COUNT(*) FROM flights"); err != nil {
+			return fmt.Errorf("count flights: %w", err)
+		}
+		if count > 0 {
+			return fmt.Errorf("the database already has %d flights, run with -truncate to replace them", count)
 		}
 	}
-	log.Printf("Inserted %d flights", len(flights))
-}
 
-func generateFares(flights []Flight) []Fare {
-	fares := make([]Fare, 0, len(flights)*3)
-	now := time.Now()
-
-	config := map[string]struct {
-		percent    float64
-		multiplier float64
-		refund     bool
-		change     bool
-		baggage    int
-	}{
-		"Promo": {0.15, 0.7, false, false, 1},
-		"Basic": {0.65, 1.0, true, true, 2},
-		"Pro":   {0.20, 1.5, true, true, 3},
+	// Check-in tells international flights apart by the airport countries.
+	for _, a := range airports {
+		_, err := tx.Exec("INSERT INTO airports (code, country) VALUES ($1, $2) ON CONFLICT (code) DO NOTHING", a.Code, a.Country)
+		if err != nil {
+			return fmt.Errorf("insert airport %s: %w", a.Code, err)
+		}
 	}
 
-	for _, f := range flights {
-		dist := distance(f.Origin, f.Destination)
-		basePrice := 100.0 + float64(dist)/1000.0*0.5
-
-		for _, class := range []string{"Promo", "Basic", "Pro"} {
-			c := config[class]
-			fares = append(fares, Fare{
-				ID:               uuid.New().String(),
-				FlightID:         f.ID,
-				FareClass:        class,
-				Price:            math.Round(basePrice*c.multiplier*100) / 100,
-				BaggageAllowance: c.baggage,
-				IsRefundable:     c.refund,
-				IsChangeable:     c.change,
-				AvailableSeats:   int(float64(f.TotalSeats) * c.percent),
-				CreatedAt:        now,
-				UpdatedAt:        now,
-			})
-		}
+	if err := copyFlights(tx, data.flights); err != nil {
+		return err
+	}
+	if err := copyFares(tx, data.fares); err != nil {
+		return err
+	}
+	if err := copyBookings(tx, data.bookings); err != nil {
+		return err
 	}
-	return fares
-}
 
-func insertFares(db *sqlx.DB, fares []Fare) {
-	query := `INSERT INTO fares (id, flight_id, fare_class, price, baggage_allowance,
-             is_refundable, is_changeable, available_seats, created_at, updated_at)
-             VALUES (:id, :flight_id, :fare_class, :price, :baggage_allowance,
-             :is_refundable, :is_changeable, :available_seats, :created_at, :updated_at)`
-
-	for i := 0; i < len(fares); i += 500 {
-		end := min(i+500, len(fares))
-		tx := db.MustBegin()
-		for _, f := range fares[i:end] {
-			if _, err := tx.NamedExec(query, f); err != nil {
-				log.Printf("Failed to insert fare: %v", err)
-			}
-		}
-		if err := tx.Commit(); err != nil {
-			log.Printf("Failed to commit fares: %v", err)
-		}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
 	}
-	log.Printf("Inserted %d fares", len(fares))
+	return nil
 }
 
-func generateAndInsertBookings(db *sqlx.DB, flights []Flight, fares []Fare) {
-	query := `INSERT INTO bookings (id, booking_reference, flight_id, fare_id, passenger_name,
-             passenger_email, passenger_phone, seat_number, booking_status, total_price,
-             booked_at, created_at, updated_at)
-             VALUES (:id, :booking_reference, :flight_id, :fare_id, :passenger_name,
-             :passenger_email, :passenger_phone, :seat_number, :booking_status, :total_price,
-             :booked_at, :created_at, :updated_at)`
-
-	faresByFlight := make(map[string][]Fare)
-	for _, f := range fares {
-		faresByFlight[f.FlightID] = append(faresByFlight[f.FlightID], f)
-	}
+// generator derives every value from the seed, so a seed and configuration
+// always produce the same records.
+type generator struct {
+	config Config
+	src    *rand.ChaCha8
+	rng    *rand.Rand
+	// published is when the schedule went on sale, the creation time of
+	// flights and fares.
+	published time.Time
+	// references holds the booking references drawn so far.
+	references map[string]bool
+}
 
-	bookingStatuses := []string{
-		"CONFIRMED", "CONFIRMED", "CONFIRMED", "CONFIRMED", "CONFIRMED",
-		"CONFIRMED", "CONFIRMED", "CONFIRMED", "CHECKED_IN", "CANCELLED",
+func newGenerator(config Config) *generator {
+	var seed [32]byte
+	binary.LittleEndian.PutUint64(seed[:], config.Seed)
+	src := rand.NewChaCha8(seed)
+	return &generator{
+		config:     config,
+		src:        src,
+		rng:        rand.New(src),
+		published:  config.Start.AddDate(0, 0, -90),
+		references: map[string]bool{},
 	}
-	now := time.Now()
-	bookingCount := 0
+}
 
-	for flightIdx, flight := range flights {
-		flightFares := faresByFlight[flight.ID]
-		if len(flightFares) == 0 {
-			continue
-		}
+func (g *generator) uuid() string {
+	return uuid.Must(uuid.NewRandomFromReader(g.src)).String()
+}
 
-		tx := db.MustBegin()
-		for range 100 {
-			firstName := firstNames[rand.Intn(len(firstNames))]
-			lastName := lastNames[rand.Intn(len(lastNames))]
-
-			fare := flightFares[selectFareIndex(len(flightFares))]
-
-			booking := Booking{
-				ID:               uuid.New().String(),
-				BookingReference: generateRef(),
-				FlightID:         flight.ID,
-				FareID:           fare.ID,
-				PassengerName:    firstName + " " + lastName,
-				PassengerEmail:   fmt.Sprintf("%s.%s@example.com", toLowerCase(firstName), toLowerCase(lastName)),
-				PassengerPhone:   fmt.Sprintf("(%03d) %03d-%04d", rand.Intn(1000), rand.Intn(1000), rand.Intn(10000)),
-				SeatNumber:       fmt.Sprintf("%d%c", rand.Intn(30)+1, 'A'+rune(rand.Intn(6))),
-				BookingStatus:    bookingStatuses[rand.Intn(len(bookingStatuses))],
-				TotalPrice:       fare.Price,
-				BookedAt:         now.Add(-time.Duration(rand.Intn(30*24)) * time.Hour),
-				CreatedAt:        now,
-				UpdatedAt:        now,
-			}
-			if _, err := tx.NamedExec(query, booking); err != nil {
-				log.Printf("Failed to insert booking: %v", err)
-			}
-			bookingCount++
-		}
-		if err := tx.Commit(); err != nil {
-			log.Printf("Failed to commit bookings: %v", err)
-		}
+func (g *generator) pick(values []string) string {
+	return values[g.rng.IntN(len(values))]
+}
 
-		if (flightIdx+1)%100 == 0 {
-			log.Printf("Processed %d flights, %d bookings", flightIdx+1, bookingCount)
-		}
+// between returns a time in [from, to), truncated to the second.
+func (g *generator) between(from, to time.Time) time.Time {
+	if !to.After(from) {
+		return from
 	}
-	log.Printf("Inserted %d bookings", bookingCount)
+	return from.Add(time.Duration(g.rng.Int64N(int64(to.Sub(from))))).Truncate(time.Second)
 }
 
-func selectFareIndex(count int) int {
-	weights := []int{70, 65, 20}
-	total := weights[0] + weights[1] + weights[2]
-	roll := rand.Intn(total)
-	if roll < weights[0] {
-		return 1
-	} else if roll < weights[0]+weights[1] {
-		return 0
-	}
-	return 2
+// scheduledFlight is a flight with the route it flies.
+type scheduledFlight struct {
+	model.Flight
+	route Route
 }
 
-func distance(origin, dest string) int {
-	distances := map[[2]string]int{
-		{"JFK", "LAX"}: 2475, {"LAX", "JFK"}: 2475,
-		{"JFK", "ORD"}: 790, {"ORD", "JFK"}: 790,
-		{"JFK", "DFW"}: 1391, {"DFW", "JFK"}: 1391,
-		{"LAX", "ORD"}: 1745, {"ORD", "LAX"}: 1745,
-	}
-	if d, ok := distances[[2]string{origin, dest}]; ok {
-		return d
-	}
-	return 1000 + rand.Intn(2000)
+func (f *scheduledFlight) international() bool {
+	return f.route.Origin.Country != f.route.Destination.Country
 }
 
-func generateRef() string {
-	const chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	ref := make([]byte, 10)
-	for i := range ref {
-		ref[i] = chars[rand.Intn(len(chars))]
+func (g *generator) flights(network []Route) []*scheduledFlight {
+	flights := make([]*scheduledFlight, 0, g.config.Flights)
+
+	for i := range g.config.Flights {
+		route := network[g.rng.IntN(len(network))]
+
+		fleet := narrowbodies
+		if route.DistanceKm > narrowbodyRangeKm {
+			fleet = widebodies
+		}
+		aircraft := fleet[g.rng.IntN(len(fleet))]
+
+		// Departures between 06:00 and 22:55, every five minutes.
+		departure := g.config.Start.
+			AddDate(0, 0, g.rng.IntN(g.config.Days)).
+			Add(6*time.Hour + time.Duration(g.rng.IntN(17*12))*5*time.Minute)
+
+		flights = append(flights, &scheduledFlight{
+			Flight: model.Flight{
+				ID:             g.uuid(),
+				FlightNumber:   fmt.Sprintf("RA%d", 1001+i),
+				Origin:         route.Origin.Code,
+				Destination:    route.Destination.Code,
+				DepartureTime:  departure,
+				ArrivalTime:    departure.Add(blockTime(route.DistanceKm)),
+				AircraftType:   aircraft.Type,
+				TotalSeats:     aircraft.Seats,
+				AvailableSeats: aircraft.Seats,
+				Status:         model.FlightStatusScheduled,
+				CreatedAt:      g.published,
+				UpdatedAt:      g.published,
+			},
+			route: route,
+		})
 	}
-	return string(ref)
+	return flights
 }
 
-func toLowerCase(s string) string {
-	result := make([]byte, len(s))
-	for i := 0; i < len(s); i++ {
-		if s[i] >= 'A' && s[i] <= 'Z' {
-			result[i] = s[i] + 32
-		} else {
-			result[i] = s[i]
+// blockTime is 40 minutes of taxi, climb and descent plus cruising at
+// 810 km/h, rounded to five minutes.
+func blockTime(distanceKm float64) time.Duration {
+	minutes := 40 + distanceKm/13.5
+	return time.Duration(math.Round(minutes/5)*5) * time.Minute
+}
+
+// fares splits the seats of each flight between the fare classes. The
+// seats are the inventory before any booking.
+func (g *generator) fares(flights []*scheduledFlight) []*model.Fare {
+	fares := make([]*model.Fare, 0, len(flights)*len(fareClasses))
+
+	for _, f := range flights {
+		basePrice := 49 + 0.11*f.route.DistanceKm
+
+		seats := map[model.FareClass]int{}
+		basic := f.TotalSeats
+		for _, c := range fareClasses {
+			seats[c.class] = int(float64(f.TotalSeats) * c.share)
+			basic -= seats[c.class]
+		}
+		seats[model.FareClassBasic] = basic
+
+		for _, c := range fareClasses {
+			fares = append(fares, &model.Fare{
+				ID:               g.uuid(),
+				FlightID:         f.ID,
+				FareClass:        c.class,
+				Price:            math.Round(basePrice*c.multiplier*100) / 100,
+				BaggageAllowance: c.baggage,
+				IsRefundable:     c.refund,
+				IsChangeable:     c.change,
+				AvailableSeats:   seats[c.class],
+				CreatedAt:        g.published,
+				UpdatedAt:        g.published,
+			})
 		}
 	}
-	return string(result)
+	return fares
 }
@@ -1,22 +1,71 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"net/http"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+	"github.com/jmoiron/sqlx"
+	"github.com/vektah/gqlparser/v2/ast"
 
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/auth"
+	"github.com/davidalecrim/red-airlines/internal/boardingpass"
 	"github.com/davidalecrim/red-airlines/internal/database"
+	"github.com/davidalecrim/red-airlines/internal/document"
 	"github.com/davidalecrim/red-airlines/internal/graph/dataloader"
 	"github.com/davidalecrim/red-airlines/internal/graph/generated"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/graph/persisted"
+	"github.com/davidalecrim/red-airlines/internal/graph/querylimit"
 	"github.com/davidalecrim/red-airlines/internal/graph/resolver"
+	"github.com/davidalecrim/red-airlines/internal/manifest"
+	"github.com/davidalecrim/red-airlines/internal/migrate"
+	"github.com/davidalecrim/red-airlines/internal/pubsub"
+	"github.com/davidalecrim/red-airlines/internal/ratelimit"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+	"github.com/davidalecrim/red-airlines/internal/waitlist"
+	"github.com/davidalecrim/red-airlines/migrations"
 )
 
-func corsMiddleware(next http.Handler) http.Handler {
+const defaultAllowedOrigins = "http://localhost:5173"
+
+// allowedOrigins reads the comma-separated CORS_ALLOWED_ORIGINS.
+func allowedOrigins() map[string]bool {
+	value := os.Getenv("CORS_ALLOWED_ORIGINS")
+	if value == "" {
+		value = defaultAllowedOrigins
+	}
+	origins := map[string]bool{}
+	for _, origin := range strings.Split(value, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins[origin] = true
+		}
+	}
+	return origins
+}
+
+// corsMiddleware only lets the configured origins call the API, now that
+// requests carry access tokens.
+func corsMiddleware(origins map[string]bool, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Add("Vary", "Origin")
+		if origin := r.Header.Get("Origin"); origins[origin] {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-API-Key")
+			w.Header().Set("Access-Control-Expose-Headers", "Retry-After")
+		}
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -27,7 +76,123 @@ func corsMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+func newBroker(db *sqlx.DB) (pubsub.Broker, func()) {
+	if os.Getenv("PUBSUB_BACKEND") != "postgres" {
+		return pubsub.NewMemoryHub(), func() {}
+	}
+
+	hub, err := pubsub.NewPostgresHub(db, database.NewListener())
+	if err != nil {
+		log.Fatalf("Failed to start Postgres pub/sub: %v", err)
+	}
+	return hub, func() {
+		if err := hub.Close(); err != nil {
+			log.Printf("Failed to close pub/sub listener: %v", err)
+		}
+	}
+}
+
+func newRateLimiter(db *sqlx.DB) *ratelimit.Limiter {
+	config, err := ratelimit.ConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid rate limit configuration: %v", err)
+	}
+
+	if os.Getenv("RATE_LIMIT_BACKEND") != "postgres" {
+		return ratelimit.New(ratelimit.NewMemoryStore(), config)
+	}
+
+	store := ratelimit.NewPostgresStore(db)
+	go store.RunPrune(context.Background(), time.Minute)
+	return ratelimit.New(store, config)
+}
+
+// newPersistedQueries only allows the documents of TRUSTED_DOCUMENTS_MANIFEST
+// when it is set, and otherwise accepts automatic persisted queries, cached in
+// memory or, with PERSISTED_QUERIES_BACKEND=postgres, in the database.
+func newPersistedQueries(db *sqlx.DB) graphql.HandlerExtension {
+	if path := os.Getenv("TRUSTED_DOCUMENTS_MANIFEST"); path != "" {
+		trusted, err := persisted.LoadManifest(path)
+		if err != nil {
+			log.Fatalf("Failed to load trusted documents: %v", err)
+		}
+		log.Printf("Only executing the %d trusted documents of %s", trusted.Len(), path)
+		return trusted
+	}
+
+	if os.Getenv("PERSISTED_QUERIES_BACKEND") != "postgres" {
+		return extension.AutomaticPersistedQuery{Cache: lru.New[string](100)}
+	}
+	cache := persisted.NewPostgresCache(db, 100)
+	go cache.RunPrune(context.Background(), time.Hour, 30*24*time.Hour)
+	return extension.AutomaticPersistedQuery{Cache: cache}
+}
+
+func newServer(
+	es graphql.ExecutableSchema,
+	issuer *auth.Issuer,
+	limiter *ratelimit.Limiter,
+	limits querylimit.Config,
+	persistedQueries graphql.HandlerExtension,
+	loaders dataloader.Extension,
+	origins map[string]bool,
+) *handler.Server {
+	srv := handler.New(es)
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			// Mirrors corsMiddleware. Non-browser clients send no Origin.
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || origins[origin]
+			},
+		},
+		InitFunc: issuer.WebsocketInit,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+
+	srv.Use(extension.Introspection{})
+	srv.Use(ratelimit.Extension{Limiter: limiter})
+	srv.Use(querylimit.DepthLimit{Max: limits.MaxDepth})
+	srv.Use(limits.ComplexityLimit())
+	srv.Use(persistedQueries)
+	srv.Use(loaders)
+
+	srv.SetErrorPresenter(apperror.Presenter)
+	srv.SetRecoverFunc(apperror.Recover)
+
+	return srv
+}
+
+// checkSchema applies the pending migrations when apply is set, then refuses
+// to serve unless the database has every migration the binary embeds.
+func checkSchema(db *sqlx.DB, apply bool) {
+	migrator, err := migrate.New(db.DB, migrations.FS)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	ctx := context.Background()
+	if apply {
+		if err := migrator.Up(ctx); err != nil {
+			log.Fatalf("Failed to migrate the database: %v", err)
+		}
+	}
+	if err := migrator.Check(ctx); err != nil {
+		log.Fatalf("Refusing to serve: %v. Run cmd/migrate or start with --migrate", err)
+	}
+}
+
 func main() {
+	applyMigrations := flag.Bool("migrate", false, "apply pending database migrations before serving")
+	flag.Parse()
+
 	db, err := database.ConnectSQLX()
 	if err != nil {
 		log.Fatal(err)
@@ -38,15 +203,67 @@ func main() {
 		}
 	}()
 
-	loaders := dataloader.NewLoaders(db)
+	checkSchema(db, *applyMigrations)
+
+	broker, closeBroker := newBroker(db)
+	defer closeBroker()
+
+	documents, err := document.NewVaultFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid document encryption key: %v", err)
+	}
+	if documents == nil {
+		log.Printf("%s is not set, passenger documents are disabled", document.KeyEnv)
+	}
+
+	issuer, err := auth.NewIssuerFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid JWT configuration: %v", err)
+	}
+
+	origins := allowedOrigins()
+	limiter := newRateLimiter(db)
+	limits, err := querylimit.ConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid query limit configuration: %v", err)
+	}
+
+	cache, err := dataloader.CacheFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid dataloader configuration: %v", err)
+	}
+
+	store := repository.NewPostgresStore(db)
+	go waitlist.RunExpiry(context.Background(), store, time.Minute)
 
-	srv := handler.NewDefaultServer(
+	srv := newServer(
 		generated.NewExecutableSchema(
 			generated.Config{
-				Resolvers: &resolver.Resolver{DB: db, Loaders: loaders},
-			}))
+				Resolvers: &resolver.Resolver{
+					Store:          store,
+					PubSub:         broker,
+					Documents:      documents,
+					Auth:           issuer,
+					BookingLookups: auth.NewLookupLimiter(),
+				},
+				Directives: generated.DirectiveRoot{HasRole: auth.HasRole},
+				Complexity: querylimit.Complexity(),
+			}),
+		issuer, limiter, limits, newPersistedQueries(db),
+		dataloader.Extension{Store: store, Cache: cache}, origins)
 
-	http.Handle("/query", corsMiddleware(dataloader.Middleware(loaders, srv)))
+	http.Handle("/query", corsMiddleware(origins,
+		limiter.GuardTokens(auth.Middleware(issuer, limiter.Middleware(srv)))))
+	http.Handle("GET /boarding-passes/{reference}", corsMiddleware(origins,
+		limiter.Handler(ratelimit.Queries, boardingpass.Handler(store, issuer))))
+	// The manifest and APIS downloads hold the data of every passenger, so
+	// they need an agent's access token like flightManifest.
+	http.Handle("GET /flights/{flightId}/manifest", corsMiddleware(origins,
+		limiter.GuardTokens(auth.Middleware(issuer, auth.RequireRoleHandler(model.RoleAgent,
+			limiter.Handler(ratelimit.Queries, manifest.Handler(store)))))))
+	http.Handle("GET /flights/{flightId}/apis", corsMiddleware(origins,
+		limiter.GuardTokens(auth.Middleware(issuer, auth.RequireRoleHandler(model.RoleAgent,
+			limiter.Handler(ratelimit.Queries, document.APISHandler(store, documents)))))))
 
 	log.Println("Server: http://localhost:8080")
 	log.Fatal(http.ListenAndServe(":8080", nil))
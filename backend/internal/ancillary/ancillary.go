@@ -0,0 +1,306 @@
+package ancillary
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// Offer is a product as sold on a given flight.
+type Offer struct {
+	Product *model.AncillaryProduct
+	// Price is the route price, or the product base price when the route has
+	// none.
+	Price float64
+	// Remaining is nil for products without flight inventory.
+	Remaining *int
+}
+
+func offer(product *model.AncillaryProduct, routePrices map[string]float64, sold map[string]int) *Offer {
+	offer := &Offer{Product: product, Price: product.BasePrice}
+	if price, ok := routePrices[product.ID]; ok {
+		offer.Price = price
+	}
+	if product.FlightInventory != nil {
+		remaining := max(0, *product.FlightInventory-sold[product.ID])
+		offer.Remaining = &remaining
+	}
+	return offer
+}
+
+// Offers lists the catalog priced for flight.
+func Offers(ctx context.Context, store repository.Store, flight *model.Flight) ([]*Offer, error) {
+	products, err := store.Ancillaries().ListProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	routePrices, err := store.Ancillaries().RoutePrices(ctx, flight.Origin, flight.Destination)
+	if err != nil {
+		return nil, err
+	}
+	sold, err := store.Ancillaries().SoldByFlight(ctx, flight.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	offers := make([]*Offer, 0, len(products))
+	for _, product := range products {
+		offers = append(offers, offer(product, routePrices, sold))
+	}
+	return offers, nil
+}
+
+// Add buys quantity units of productID for the booking and adds them to its
+// total price. authorized decides whether the caller may change the booking,
+// like check-in.
+func Add(ctx context.Context, store repository.Store, bookingReference string, authorized func(*model.Booking) bool, productID string, quantity int, now time.Time) (*model.Booking, error) {
+	if quantity <= 0 {
+		return nil, apperror.Validation("quantity must be positive")
+	}
+
+	var booking *model.Booking
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		var err error
+		booking, err = tx.Bookings().GetByReferenceForUpdate(ctx, bookingReference)
+		if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+			return err
+		}
+		if err != nil || !authorized(booking) {
+			return apperror.NotFound("booking %s not found", bookingReference)
+		}
+		if !booking.BookingStatus.HoldsSeat() {
+			return apperror.Conflict("booking is %s and cannot be changed", booking.BookingStatus)
+		}
+
+		flight, err := tx.Flights().Get(ctx, booking.FlightID)
+		if err != nil {
+			return err
+		}
+		if !flight.Status.IsOperable() {
+			return apperror.Conflict("flight %s is %s", flight.FlightNumber, flight.Status)
+		}
+
+		fare, err := tx.Fares().Get(ctx, booking.FareID)
+		if err != nil {
+			return err
+		}
+
+		product, err := tx.Ancillaries().GetProduct(ctx, productID)
+		if err != nil {
+			return err
+		}
+		if err := buy(ctx, tx, booking, flight, fare, product, quantity, now); err != nil {
+			return err
+		}
+		return tx.Bookings().Update(ctx, booking)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// buy adds quantity units of product to booking, taking them from the flight
+// inventory, and adds their price to the booking total; the caller saves
+// booking.
+func buy(ctx context.Context, tx repository.Store, booking *model.Booking, flight *model.Flight, fare *model.Fare, product *model.AncillaryProduct, quantity int, now time.Time) error {
+	if !product.Allows(fare.FareClass) {
+		return apperror.Validation("%s is not available on %s fares", product.Name, fare.FareClass)
+	}
+
+	routePrices, err := tx.Ancillaries().RoutePrices(ctx, flight.Origin, flight.Destination)
+	if err != nil {
+		return err
+	}
+	price := offer(product, routePrices, nil).Price
+
+	lines, err := tx.Ancillaries().ListLinesByBookings(ctx, []string{booking.ID})
+	if err != nil {
+		return err
+	}
+	bought := 0
+	for _, line := range lines {
+		if line.ProductID == product.ID {
+			bought += line.Quantity
+		}
+	}
+	if bought+quantity > product.MaxPerBooking {
+		return apperror.Validation("at most %d %s per booking", product.MaxPerBooking, product.Name)
+	}
+
+	if product.FlightInventory != nil {
+		if err := takeInventory(ctx, tx, flight.ID, product, quantity); err != nil {
+			return err
+		}
+	}
+
+	line := &model.BookingAncillary{
+		BookingID:  booking.ID,
+		ProductID:  product.ID,
+		Quantity:   quantity,
+		UnitPrice:  price,
+		TotalPrice: roundCents(price * float64(quantity)),
+		CreatedAt:  now,
+	}
+	if err := tx.Ancillaries().CreateLine(ctx, line); err != nil {
+		return err
+	}
+
+	booking.TotalPrice = roundCents(booking.TotalPrice + line.TotalPrice)
+	booking.UpdatedAt = now
+	return nil
+}
+
+// roundCents rounds a price to cents, as the database stores it.
+func roundCents(price float64) float64 {
+	return math.Round(price*100) / 100
+}
+
+func takeInventory(ctx context.Context, tx repository.Store, flightID string, product *model.AncillaryProduct, quantity int) error {
+	sold, err := tx.Ancillaries().GetSoldForUpdate(ctx, flightID, product.ID)
+	if err != nil {
+		return err
+	}
+	if sold+quantity > *product.FlightInventory {
+		return apperror.SoldOut("%s is sold out on this flight", product.Name)
+	}
+	return tx.Ancillaries().AddSold(ctx, flightID, product.ID, quantity)
+}
+
+// BuySeatSelection buys seat selection for a booking that picked its seat
+// when booking; PROMO fares cannot buy it. The caller saves booking.
+func BuySeatSelection(ctx context.Context, tx repository.Store, booking *model.Booking, flight *model.Flight, fare *model.Fare, now time.Time) error {
+	products, err := tx.Ancillaries().ListProducts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, product := range products {
+		if product.Category == model.AncillaryCategorySeatSelection && product.Allows(fare.FareClass) {
+			return buy(ctx, tx, booking, flight, fare, product, 1, now)
+		}
+	}
+	return apperror.Validation("seat selection is not available on %s fares", fare.FareClass)
+}
+
+// HasSeatSelection reports whether a booking bought seat selection.
+func HasSeatSelection(ctx context.Context, tx repository.Store, bookingID string) (bool, error) {
+	lines, err := tx.Ancillaries().ListLinesByBookings(ctx, []string{bookingID})
+	if err != nil {
+		return false, err
+	}
+	ids := make([]string, 0, len(lines))
+	for _, line := range lines {
+		ids = append(ids, line.ProductID)
+	}
+	if len(ids) == 0 {
+		return false, nil
+	}
+
+	products, err := tx.Ancillaries().ListProductsByIDs(ctx, ids)
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(products, func(p *model.AncillaryProduct) bool {
+		return p.Category == model.AncillaryCategorySeatSelection
+	}), nil
+}
+
+// heldInventory is the quantity of a product with flight inventory that a
+// booking holds.
+type heldInventory struct {
+	product  *model.AncillaryProduct
+	quantity int
+}
+
+// inventoryOf lists the products with flight inventory bought on a booking,
+// by product ID.
+func inventoryOf(ctx context.Context, tx repository.Store, bookingID string) ([]heldInventory, error) {
+	lines, err := tx.Ancillaries().ListLinesByBookings(ctx, []string{bookingID})
+	if err != nil {
+		return nil, err
+	}
+	quantities := map[string]int{}
+	for _, line := range lines {
+		quantities[line.ProductID] += line.Quantity
+	}
+
+	products, err := tx.Ancillaries().ListProductsByIDs(ctx, slices.Sorted(maps.Keys(quantities)))
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(products, func(a, b *model.AncillaryProduct) int { return strings.Compare(a.ID, b.ID) })
+
+	var held []heldInventory
+	for _, product := range products {
+		if product.FlightInventory != nil {
+			held = append(held, heldInventory{product, quantities[product.ID]})
+		}
+	}
+	return held, nil
+}
+
+// MoveInventory moves the inventory held by the ancillaries of a rebooked
+// booking from its cancelled flight to the one booking is now on. Products
+// the new flight has sold out are removed from the booking and refunded from
+// its total price; the caller saves booking.
+func MoveInventory(ctx context.Context, tx repository.Store, booking *model.Booking, fromFlightID string) error {
+	held, err := inventoryOf(ctx, tx, booking.ID)
+	if err != nil {
+		return err
+	}
+	if err := release(ctx, tx, held, fromFlightID); err != nil {
+		return err
+	}
+
+	for _, h := range held {
+		err := takeInventory(ctx, tx, booking.FlightID, h.product, h.quantity)
+		if apperror.CodeOf(err) == apperror.CodeSoldOut {
+			err = refund(ctx, tx, booking, h.product.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refund removes productID from booking and takes its price off the booking
+// total.
+func refund(ctx context.Context, tx repository.Store, booking *model.Booking, productID string) error {
+	lines, err := tx.Ancillaries().ListLinesByBookings(ctx, []string{booking.ID})
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if line.ProductID == productID {
+			booking.TotalPrice = roundCents(booking.TotalPrice - line.TotalPrice)
+		}
+	}
+	return tx.Ancillaries().DeleteLines(ctx, booking.ID, productID)
+}
+
+// ReleaseInventory returns the inventory held by the ancillaries of a
+// cancelled booking to its flight.
+func ReleaseInventory(ctx context.Context, tx repository.Store, bookingID, flightID string) error {
+	held, err := inventoryOf(ctx, tx, bookingID)
+	if err != nil {
+		return err
+	}
+	return release(ctx, tx, held, flightID)
+}
+
+func release(ctx context.Context, tx repository.Store, held []heldInventory, flightID string) error {
+	for _, h := range held {
+		if err := tx.Ancillaries().AddSold(ctx, flightID, h.product.ID, -h.quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
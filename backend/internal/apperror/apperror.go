@@ -0,0 +1,122 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Code classifies an error for API clients. It is exposed as the "code"
+// extension of every GraphQL error.
+type Code string
+
+const (
+	CodeNotFound        Code = "NOT_FOUND"
+	CodeValidation      Code = "VALIDATION"
+	CodeSoldOut         Code = "SOLD_OUT"
+	CodeConflict        Code = "CONFLICT"
+	CodeUnauthenticated Code = "UNAUTHENTICATED"
+	CodeForbidden       Code = "FORBIDDEN"
+	CodeRateLimited     Code = "RATE_LIMITED"
+	CodeUnavailable     Code = "UNAVAILABLE"
+	CodeInternal        Code = "INTERNAL"
+)
+
+// Error is a client-safe error. Message is returned to the client while Err
+// keeps the underlying cause for logging only.
+type Error struct {
+	Code    Code
+	Message string
+	Err     error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func NotFound(format string, args ...any) *Error {
+	return &Error{Code: CodeNotFound, Message: fmt.Sprintf(format, args...)}
+}
+
+func Validation(format string, args ...any) *Error {
+	return &Error{Code: CodeValidation, Message: fmt.Sprintf(format, args...)}
+}
+
+func SoldOut(format string, args ...any) *Error {
+	return &Error{Code: CodeSoldOut, Message: fmt.Sprintf(format, args...)}
+}
+
+func Conflict(format string, args ...any) *Error {
+	return &Error{Code: CodeConflict, Message: fmt.Sprintf(format, args...)}
+}
+
+func Unauthenticated(format string, args ...any) *Error {
+	return &Error{Code: CodeUnauthenticated, Message: fmt.Sprintf(format, args...)}
+}
+
+func Forbidden(format string, args ...any) *Error {
+	return &Error{Code: CodeForbidden, Message: fmt.Sprintf(format, args...)}
+}
+
+func RateLimited(format string, args ...any) *Error {
+	return &Error{Code: CodeRateLimited, Message: fmt.Sprintf(format, args...)}
+}
+
+// Unavailable reports a feature this server is not configured for.
+func Unavailable(format string, args ...any) *Error {
+	return &Error{Code: CodeUnavailable, Message: fmt.Sprintf(format, args...)}
+}
+
+// Internal wraps an unexpected failure. The message is only used in logs,
+// clients always see a generic message.
+func Internal(err error, message string) *Error {
+	return &Error{Code: CodeInternal, Message: message, Err: err}
+}
+
+// CodeOf returns the code of the first *Error in err's chain, or
+// CodeInternal if there is none.
+func CodeOf(err error) Code {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Code
+	}
+	return CodeInternal
+}
+
+// HTTPStatus maps err to the status code used by the plain HTTP endpoints.
+func HTTPStatus(err error) int {
+	switch CodeOf(err) {
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeValidation:
+		return http.StatusBadRequest
+	case CodeSoldOut, CodeConflict:
+		return http.StatusConflict
+	case CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case CodeForbidden:
+		return http.StatusForbidden
+	case CodeRateLimited:
+		return http.StatusTooManyRequests
+	case CodeUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// PublicMessage returns the message that can be shown to clients for err.
+func PublicMessage(err error) string {
+	var appErr *Error
+	if errors.As(err, &appErr) && appErr.Code != CodeInternal {
+		return appErr.Message
+	}
+	return "internal server error"
+}
@@ -0,0 +1,55 @@
+package apperror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Presenter is the GraphQL error presenter. It maps resolver errors to the
+// apperror taxonomy. Client-facing messages are kept for known errors,
+// anything else is logged and masked as INTERNAL so SQL and driver details
+// never reach the response.
+func Presenter(ctx context.Context, err error) *gqlerror.Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		gqlErr := gqlerror.WrapPath(graphql.GetPath(ctx), err)
+		gqlErr.Message = appErr.Message
+		if appErr.Code == CodeInternal {
+			log.Printf("Internal error at %s: %v", gqlErr.Path, err)
+			gqlErr.Message = "internal server error"
+		}
+		gqlErr.Extensions = map[string]any{"code": appErr.Code}
+		return gqlErr
+	}
+
+	// Errors raised by gqlgen itself (argument coercion, etc.) only describe
+	// the incoming request and are safe to return as-is.
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		if gqlErr.Path == nil {
+			gqlErr.Path = graphql.GetPath(ctx)
+		}
+		if _, ok := gqlErr.Extensions["code"]; !ok {
+			if gqlErr.Extensions == nil {
+				gqlErr.Extensions = map[string]any{}
+			}
+			gqlErr.Extensions["code"] = CodeValidation
+		}
+		return gqlErr
+	}
+
+	return Presenter(ctx, Internal(err, "unclassified error"))
+}
+
+// Recover is the GraphQL recover function. Panics are logged with their stack
+// and reported as INTERNAL errors.
+func Recover(_ context.Context, p any) error {
+	log.Printf("Panic in resolver: %v\n%s", p, debug.Stack())
+	return Internal(fmt.Errorf("panic: %v", p), "recovered from panic")
+}
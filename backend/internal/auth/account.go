@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// Session is returned by every call that signs a user in.
+type Session struct {
+	User                 *model.User
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+}
+
+// SignupInput describes a new customer account.
+type SignupInput struct {
+	Email    string
+	Name     string
+	Password string
+}
+
+// Signup creates an account and signs it in.
+func Signup(ctx context.Context, store repository.Store, issuer *Issuer, in SignupInput, now time.Time) (*Session, error) {
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return nil, apperror.Validation("name is required")
+	}
+	if err := validatePassword(in.Password); err != nil {
+		return nil, err
+	}
+	hash, err := hashPassword(in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &model.User{
+		Email:        email,
+		Name:         name,
+		PasswordHash: hash,
+		RoleNames:    []string{string(model.RoleCustomer)},
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	var session *Session
+	err = store.InTx(ctx, func(tx repository.Store) error {
+		if err := tx.Users().Create(ctx, user); err != nil {
+			return err
+		}
+		session, err = startSession(ctx, tx, issuer, user, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// Login checks the credentials of an account. Unknown emails and wrong
+// passwords return the same error.
+func Login(ctx context.Context, store repository.Store, issuer *Issuer, email, password string, now time.Time) (*Session, error) {
+	invalid := apperror.Unauthenticated("invalid email or password")
+
+	user, err := store.Users().GetByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+	if apperror.CodeOf(err) == apperror.CodeNotFound {
+		checkPassword(string(dummyHash), password)
+		return nil, invalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !checkPassword(user.PasswordHash, password) {
+		return nil, invalid
+	}
+
+	return startSession(ctx, store, issuer, user, now)
+}
+
+// Refresh exchanges a refresh token for a new session. Refresh tokens are
+// single use: presenting one that was already rotated means it leaked, so
+// every session of the user is revoked.
+func Refresh(ctx context.Context, store repository.Store, issuer *Issuer, refreshToken string, now time.Time) (*Session, error) {
+	var session *Session
+	reused := false
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		stored, err := tx.RefreshTokens().GetByHashForUpdate(ctx, hashRefreshToken(refreshToken))
+		if apperror.CodeOf(err) == apperror.CodeNotFound {
+			return apperror.Unauthenticated("invalid refresh token")
+		}
+		if err != nil {
+			return err
+		}
+
+		// The revocation must be committed, so the error is returned once the
+		// transaction is.
+		if stored.RevokedAt != nil {
+			reused = true
+			return tx.RefreshTokens().RevokeAll(ctx, stored.UserID, now)
+		}
+		if !now.Before(stored.ExpiresAt) {
+			return apperror.Unauthenticated("refresh token has expired")
+		}
+
+		if err := tx.RefreshTokens().Revoke(ctx, stored.TokenHash, now); err != nil {
+			return err
+		}
+
+		user, err := tx.Users().Get(ctx, stored.UserID)
+		if err != nil {
+			return err
+		}
+		session, err = startSession(ctx, tx, issuer, user, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		return nil, apperror.Unauthenticated("invalid refresh token")
+	}
+	return session, nil
+}
+
+// Logout revokes a refresh token. Unknown tokens are ignored so the call is
+// idempotent.
+func Logout(ctx context.Context, store repository.Store, refreshToken string, now time.Time) error {
+	return store.RefreshTokens().Revoke(ctx, hashRefreshToken(refreshToken), now)
+}
+
+func startSession(ctx context.Context, store repository.Store, issuer *Issuer, user *model.User, now time.Time) (*Session, error) {
+	accessToken, expiresAt, err := issuer.AccessToken(user)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to sign access token")
+	}
+
+	refreshToken, hash, err := newRefreshToken()
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to generate refresh token")
+	}
+	err = store.RefreshTokens().Create(ctx, &model.RefreshToken{
+		UserID:    user.ID,
+		TokenHash: hash,
+		ExpiresAt: now.Add(RefreshTokenTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Session{
+		User:                 user,
+		AccessToken:          accessToken,
+		AccessTokenExpiresAt: expiresAt,
+		RefreshToken:         refreshToken,
+	}, nil
+}
+
+func normalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email || len(email) > 100 {
+		return "", apperror.Validation("email is not valid")
+	}
+	return email, nil
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+type (
+	claimsKey        struct{}
+	bookingClaimsKey struct{}
+)
+
+// WithClaims returns a context carrying the authenticated user.
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFrom returns the authenticated user of ctx, or nil for anonymous
+// requests.
+func ClaimsFrom(ctx context.Context) *Claims {
+	claims, _ := ctx.Value(claimsKey{}).(*Claims)
+	return claims
+}
+
+// WithBookingClaims returns a context carrying a guest's booking token.
+func WithBookingClaims(ctx context.Context, claims *BookingClaims) context.Context {
+	return context.WithValue(ctx, bookingClaimsKey{}, claims)
+}
+
+// BookingClaimsFrom returns the booking token of ctx, or nil.
+func BookingClaimsFrom(ctx context.Context) *BookingClaims {
+	claims, _ := ctx.Value(bookingClaimsKey{}).(*BookingClaims)
+	return claims
+}
+
+// RequireUser returns the authenticated user or an UNAUTHENTICATED error.
+func RequireUser(ctx context.Context) (*Claims, error) {
+	claims := ClaimsFrom(ctx)
+	if claims == nil {
+		return nil, apperror.Unauthenticated("sign in required")
+	}
+	return claims, nil
+}
+
+// RequireRole returns the authenticated user if they hold role, or an
+// UNAUTHENTICATED or FORBIDDEN error.
+func RequireRole(ctx context.Context, role model.Role) (*Claims, error) {
+	claims, err := RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.HasRole(role) {
+		return nil, apperror.Forbidden("%s role required", role)
+	}
+	return claims, nil
+}
+
+// BookingAccess returns a check for the bookings the caller may manage: those
+// of their account, or the one their booking token was issued for. Anonymous
+// callers get an UNAUTHENTICATED error.
+func BookingAccess(ctx context.Context) (func(*model.Booking) bool, error) {
+	claims := ClaimsFrom(ctx)
+	bookingClaims := BookingClaimsFrom(ctx)
+	if claims == nil && bookingClaims == nil {
+		return nil, apperror.Unauthenticated("sign in or look up the booking with manageBooking first")
+	}
+	return func(b *model.Booking) bool {
+		if claims != nil && b.UserID != nil && *b.UserID == claims.UserID() {
+			return true
+		}
+		return bookingClaims != nil && bookingClaims.BookingID() == b.ID
+	}, nil
+}
@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+// HasRole implements the @hasRole schema directive.
+func HasRole(ctx context.Context, obj any, next graphql.Resolver, role model.Role) (any, error) {
+	if _, err := RequireRole(ctx, role); err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+const (
+	// maxLookupFailures locks a reference for a client, so the last name of
+	// a known reference cannot be brute forced.
+	maxLookupFailures = 5
+	// maxClientLookupFailures caps the failures of a client across
+	// references, so it cannot try reference after reference.
+	maxClientLookupFailures = 20
+	lookupWindow            = 15 * time.Minute
+	// pruneThreshold bounds the maps before expired counters are dropped.
+	pruneThreshold = 10_000
+)
+
+// BookingSession is a guest's access to a single booking.
+type BookingSession struct {
+	Booking   *model.Booking
+	Token     string
+	ExpiresAt time.Time
+}
+
+// LookupLimiter counts failed booking lookups per client, and per client and
+// reference. Keying by client keeps an attacker from locking the passenger
+// out of their own booking. Counters live in memory, so each server instance
+// enforces its own limit.
+type LookupLimiter struct {
+	mu          sync.Mutex
+	byClient    map[string]*lookupFailures
+	byReference map[lookupKey]*lookupFailures
+}
+
+type lookupKey struct {
+	client    string
+	reference string
+}
+
+type lookupFailures struct {
+	count   int
+	resetAt time.Time
+}
+
+func NewLookupLimiter() *LookupLimiter {
+	return &LookupLimiter{
+		byClient:    map[string]*lookupFailures{},
+		byReference: map[lookupKey]*lookupFailures{},
+	}
+}
+
+// allow returns a RATE_LIMITED error when client has failed too often, in
+// general or for reference.
+func (l *LookupLimiter) allow(client, reference string, now time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if f, ok := l.byClient[client]; ok && now.Before(f.resetAt) && f.count >= maxClientLookupFailures {
+		return apperror.RateLimited("too many failed booking lookups, try again later")
+	}
+	if f, ok := l.byReference[lookupKey{client, reference}]; ok && now.Before(f.resetAt) && f.count >= maxLookupFailures {
+		return apperror.RateLimited("too many attempts for booking %s, try again later", reference)
+	}
+	return nil
+}
+
+// fail counts a failed lookup. Only references that exist get a counter of
+// their own; guesses of unknown ones count towards the client's cap alone.
+func (l *LookupLimiter) fail(client, reference string, known bool, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	count(l.byClient, client, now)
+	if known {
+		count(l.byReference, lookupKey{client, reference}, now)
+	}
+}
+
+// reset clears the failures of client for reference after a successful
+// lookup. The client's cap is kept, or looking up a booking of one's own
+// would reset it.
+func (l *LookupLimiter) reset(client, reference string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.byReference, lookupKey{client, reference})
+}
+
+func count[K comparable](failures map[K]*lookupFailures, key K, now time.Time) {
+	if len(failures) >= pruneThreshold {
+		for key, f := range failures {
+			if !now.Before(f.resetAt) {
+				delete(failures, key)
+			}
+		}
+	}
+	f, ok := failures[key]
+	if !ok || !now.Before(f.resetAt) {
+		f = &lookupFailures{resetAt: now.Add(lookupWindow)}
+		failures[key] = f
+	}
+	f.count++
+}
+
+// ManageBooking identifies a booking by reference and passenger last name and
+// returns a booking token for it. Unknown references and wrong last names
+// return the same error. After maxLookupFailures failures a reference is
+// locked for client, and after maxClientLookupFailures every reference is,
+// both for lookupWindow. client identifies the caller, usually by IP.
+func ManageBooking(ctx context.Context, bookings repository.BookingRepository, issuer *Issuer, limiter *LookupLimiter, client, bookingReference, lastName string, now time.Time) (*BookingSession, error) {
+	bookingReference = strings.ToUpper(strings.TrimSpace(bookingReference))
+	if err := limiter.allow(client, bookingReference, now); err != nil {
+		return nil, err
+	}
+
+	booking, err := bookings.GetByReference(ctx, bookingReference)
+	if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+		return nil, err
+	}
+	if err != nil || !booking.MatchesLastName(lastName) {
+		limiter.fail(client, bookingReference, err == nil, now)
+		return nil, apperror.NotFound("no booking matches reference %s and the given last name", bookingReference)
+	}
+	limiter.reset(client, bookingReference)
+
+	token, expiresAt, err := issuer.BookingToken(booking)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to sign booking token")
+	}
+	return &BookingSession{Booking: booking, Token: token, ExpiresAt: expiresAt}, nil
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+func TestLookupLimiter(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		// failures are the references client "a" fails to look up; the
+		// known ones start with RDA.
+		failures []string
+		client   string
+		lookup   string
+		at       time.Duration
+		allowed  bool
+	}{
+		{"below the limit", slices.Repeat([]string{"RDAKNOWN01"}, maxLookupFailures-1), "a", "RDAKNOWN01", 0, true},
+		{"reference locked", slices.Repeat([]string{"RDAKNOWN01"}, maxLookupFailures), "a", "RDAKNOWN01", 0, false},
+		{"other reference", slices.Repeat([]string{"RDAKNOWN01"}, maxLookupFailures), "a", "RDAKNOWN02", 0, true},
+		{"passenger on another client", slices.Repeat([]string{"RDAKNOWN01"}, maxLookupFailures), "b", "RDAKNOWN01", 0, true},
+		{"lock expired", slices.Repeat([]string{"RDAKNOWN01"}, maxLookupFailures), "a", "RDAKNOWN01", lookupWindow, true},
+		{"client capped", slices.Repeat([]string{"ZZUNKNOWN"}, maxClientLookupFailures), "a", "RDAKNOWN01", 0, false},
+		{"other client not capped", slices.Repeat([]string{"ZZUNKNOWN"}, maxClientLookupFailures), "b", "RDAKNOWN01", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLookupLimiter()
+			for _, reference := range tt.failures {
+				l.fail("a", reference, strings.HasPrefix(reference, "RDA"), now)
+			}
+
+			err := l.allow(tt.client, tt.lookup, now.Add(tt.at))
+			if allowed := err == nil; allowed != tt.allowed {
+				t.Fatalf("expected allowed %v, got %v", tt.allowed, err)
+			}
+			if err != nil && apperror.CodeOf(err) != apperror.CodeRateLimited {
+				t.Errorf("expected a RATE_LIMITED error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestLookupLimiterUnknownReferences(t *testing.T) {
+	l := NewLookupLimiter()
+	now := time.Now()
+	for range 3 {
+		l.fail("a", "ZZUNKNOWN", false, now)
+	}
+	if len(l.byReference) != 0 || l.byClient["a"].count != 3 {
+		t.Errorf("expected only the client counter, got %d references and client %+v", len(l.byReference), l.byClient["a"])
+	}
+}
+
+func TestLookupLimiterReset(t *testing.T) {
+	l := NewLookupLimiter()
+	now := time.Now()
+	for range maxLookupFailures {
+		l.fail("a", "RDAKNOWN01", true, now)
+	}
+	l.reset("a", "RDAKNOWN01")
+
+	if err := l.allow("a", "RDAKNOWN01", now); err != nil {
+		t.Errorf("expected the reference unlocked after a successful lookup, got %v", err)
+	}
+	if l.byClient["a"].count != maxLookupFailures {
+		t.Errorf("expected the client's failures kept, got %+v", l.byClient["a"])
+	}
+}
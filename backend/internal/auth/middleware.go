@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+var errInvalidScheme = errors.New("authorization header must use the Bearer scheme")
+
+// Middleware authenticates requests carrying "Authorization: Bearer <token>"
+// with either an access token or a booking token. Requests without the header
+// continue anonymously; an invalid or expired token is rejected with 401 so
+// clients know to refresh it.
+func Middleware(issuer *Issuer, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, err := issuer.authenticate(r.Context(), header)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			http.Error(w, "invalid or expired token", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RequireRoleHandler serves next only to users holding role, as @hasRole does
+// for fields. It reads the claims set by Middleware, so it must be wrapped in
+// it.
+func RequireRoleHandler(role model.Role, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := RequireRole(r.Context(), role); err != nil {
+			if apperror.CodeOf(err) == apperror.CodeUnauthenticated {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+			}
+			http.Error(w, apperror.PublicMessage(err), apperror.HTTPStatus(err))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// WebsocketInit authenticates subscriptions from the "Authorization" entry
+// of the connection_init payload, since browsers cannot set headers on
+// WebSocket upgrades.
+func (i *Issuer) WebsocketInit(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+	header := payload.Authorization()
+	if header == "" {
+		return ctx, nil, nil
+	}
+	ctx, err := i.authenticate(ctx, header)
+	if err != nil {
+		return ctx, nil, err
+	}
+	return ctx, nil, nil
+}
+
+// authenticate adds the claims of the bearer token in header to ctx.
+func (i *Issuer) authenticate(ctx context.Context, header string) (context.Context, error) {
+	token, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok {
+		return ctx, errInvalidScheme
+	}
+	token = strings.TrimSpace(token)
+
+	claims, err := i.ParseAccessToken(token)
+	if err == nil {
+		return WithClaims(ctx, claims), nil
+	}
+	if bookingClaims, bookingErr := i.ParseBookingToken(token); bookingErr == nil {
+		return WithBookingClaims(ctx, bookingClaims), nil
+	}
+	return ctx, err
+}
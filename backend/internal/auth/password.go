@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+const (
+	minPasswordLength = 8
+	// bcrypt ignores everything past 72 bytes, so longer passwords are
+	// rejected instead of being silently truncated.
+	maxPasswordLength = 72
+)
+
+// dummyHash is compared against when the email is unknown so a login takes
+// the same time whether or not the account exists.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("red-airlines-dummy-password"), bcrypt.DefaultCost)
+
+func validatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return apperror.Validation("password must be between %d and %d bytes", minPasswordLength, maxPasswordLength)
+	}
+	return nil
+}
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", apperror.Internal(err, "failed to hash password")
+	}
+	return string(hash), nil
+}
+
+func checkPassword(hash, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
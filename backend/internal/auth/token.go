@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+// SecretEnv holds the HMAC secret used to sign access and booking tokens.
+const SecretEnv = "JWT_SECRET"
+
+const (
+	issuer          = "red-airlines"
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+	BookingTokenTTL = 15 * time.Minute
+	// BoardingPassTokenTTL bounds how long a boarding pass link works.
+	BoardingPassTokenTTL = 15 * time.Minute
+	minSecretLength      = 32
+)
+
+// Audiences keep a token of one kind from being accepted as another.
+// Boarding pass tokens travel in URLs, so they are only accepted by the
+// boarding pass download and never by /query.
+const (
+	accessAudience       = "api"
+	bookingAudience      = "booking"
+	boardingPassAudience = "boarding-pass"
+)
+
+// Claims are the claims of an access token. The subject is the user ID.
+// Roles are copied from the account when the token is issued, so a change
+// takes effect on the next refresh.
+type Claims struct {
+	Email string       `json:"email"`
+	Roles []model.Role `json:"roles"`
+	jwt.RegisteredClaims
+}
+
+// UserID returns the ID of the authenticated user.
+func (c *Claims) UserID() string {
+	return c.Subject
+}
+
+// HasRole reports whether the authenticated user holds role.
+func (c *Claims) HasRole(role model.Role) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// BookingClaims are the claims of a booking token, which lets a guest manage
+// a single booking. The subject is the booking ID.
+type BookingClaims struct {
+	Reference string `json:"ref"`
+	jwt.RegisteredClaims
+}
+
+// BookingID returns the ID of the booking the token grants access to.
+func (c *BookingClaims) BookingID() string {
+	return c.Subject
+}
+
+// Issuer signs and verifies HS256 access and booking tokens.
+type Issuer struct {
+	secret []byte
+	now    func() time.Time
+}
+
+func NewIssuer(secret []byte) (*Issuer, error) {
+	if len(secret) < minSecretLength {
+		return nil, fmt.Errorf("%s must be at least %d bytes", SecretEnv, minSecretLength)
+	}
+	return &Issuer{secret: secret, now: time.Now}, nil
+}
+
+// NewIssuerFromEnv reads the secret from SecretEnv.
+func NewIssuerFromEnv() (*Issuer, error) {
+	return NewIssuer([]byte(os.Getenv(SecretEnv)))
+}
+
+// AccessToken signs a token for user valid for AccessTokenTTL.
+func (i *Issuer) AccessToken(user *model.User) (string, time.Time, error) {
+	now := i.now()
+	expiresAt := now.Add(AccessTokenTTL)
+	return i.sign(&Claims{
+		Email:            user.Email,
+		Roles:            user.Roles(),
+		RegisteredClaims: i.registeredClaims(user.ID, accessAudience, now, expiresAt),
+	}, expiresAt)
+}
+
+// BookingToken signs a token scoped to booking valid for BookingTokenTTL.
+func (i *Issuer) BookingToken(booking *model.Booking) (string, time.Time, error) {
+	now := i.now()
+	expiresAt := now.Add(BookingTokenTTL)
+	return i.sign(&BookingClaims{
+		Reference:        booking.BookingReference,
+		RegisteredClaims: i.registeredClaims(booking.ID, bookingAudience, now, expiresAt),
+	}, expiresAt)
+}
+
+// BoardingPassToken signs a token that only downloads the boarding pass of
+// booking, valid for BoardingPassTokenTTL.
+func (i *Issuer) BoardingPassToken(booking *model.Booking) (string, time.Time, error) {
+	now := i.now()
+	expiresAt := now.Add(BoardingPassTokenTTL)
+	return i.sign(&BookingClaims{
+		Reference:        booking.BookingReference,
+		RegisteredClaims: i.registeredClaims(booking.ID, boardingPassAudience, now, expiresAt),
+	}, expiresAt)
+}
+
+// ParseAccessToken verifies the signature, issuer, audience and expiry of
+// token.
+func (i *Issuer) ParseAccessToken(token string) (*Claims, error) {
+	var claims Claims
+	if err := i.parse(token, &claims, accessAudience); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+// ParseBookingToken verifies a token issued by BookingToken.
+func (i *Issuer) ParseBookingToken(token string) (*BookingClaims, error) {
+	var claims BookingClaims
+	if err := i.parse(token, &claims, bookingAudience); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+// ParseBoardingPassToken verifies a token issued by BoardingPassToken.
+func (i *Issuer) ParseBoardingPassToken(token string) (*BookingClaims, error) {
+	var claims BookingClaims
+	if err := i.parse(token, &claims, boardingPassAudience); err != nil {
+		return nil, err
+	}
+	return &claims, nil
+}
+
+func (i *Issuer) registeredClaims(subject, audience string, now, expiresAt time.Time) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Issuer:    issuer,
+		Subject:   subject,
+		Audience:  jwt.ClaimStrings{audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+}
+
+func (i *Issuer) sign(claims jwt.Claims, expiresAt time.Time) (string, time.Time, error) {
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+func (i *Issuer) parse(token string, claims jwt.Claims, audience string) error {
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return i.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(i.now),
+	)
+	if err != nil {
+		return err
+	}
+	if subject, _ := claims.GetSubject(); subject == "" {
+		return errors.New("token has no subject")
+	}
+	return nil
+}
+
+// newRefreshToken returns an opaque refresh token and the hash stored in
+// the database.
+func newRefreshToken() (string, []byte, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	return token, hashRefreshToken(token), nil
+}
+
+func hashRefreshToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
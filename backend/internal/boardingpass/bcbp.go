@@ -0,0 +1,94 @@
+package boardingpass
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Carrier is the IATA designator printed on every boarding pass.
+const Carrier = "RA"
+
+// BCBP encodes the mandatory items of an IATA Bar Coded Boarding Pass
+// (Resolution 792, format M, one leg, no conditional items).
+func (p *Pass) BCBP() string {
+	var b strings.Builder
+	b.WriteString("M1")
+	b.WriteString(pad(bcbpName(p.PassengerName), 20))
+	b.WriteString("E")
+	b.WriteString(pad(bcbpReference(p.BookingReference), 7))
+	b.WriteString(pad(p.Origin, 3))
+	b.WriteString(pad(p.Destination, 3))
+	b.WriteString(pad(Carrier, 3))
+	b.WriteString(pad(bcbpFlightNumber(p.FlightNumber), 5))
+	fmt.Fprintf(&b, "%03d", p.Departure.YearDay())
+	b.WriteString("Y")
+	b.WriteString(bcbpSeat(p.SeatNumber))
+	fmt.Fprintf(&b, "%04d ", p.Sequence%10000)
+	b.WriteString("1")
+	b.WriteString("00")
+	return b.String()
+}
+
+// bcbpName formats "First Middle Last" as "LAST/FIRST MIDDLE" using only the
+// characters allowed in the barcode.
+func bcbpName(name string) string {
+	fields := strings.Fields(asciiUpper(name))
+	if len(fields) == 0 {
+		return ""
+	}
+	last := fields[len(fields)-1]
+	if len(fields) == 1 {
+		return last
+	}
+	return last + "/" + strings.Join(fields[:len(fields)-1], " ")
+}
+
+// bcbpReference keeps the last seven characters of the booking reference,
+// the size of the PNR field. Red Airlines references share the "RDA" prefix,
+// so those characters are the unique part.
+func bcbpReference(reference string) string {
+	if len(reference) > 7 {
+		return reference[len(reference)-7:]
+	}
+	return reference
+}
+
+// bcbpFlightNumber turns "RA1001" into "1001 ": four digits followed by an
+// operational suffix.
+func bcbpFlightNumber(flightNumber string) string {
+	digits := strings.TrimLeftFunc(flightNumber, unicode.IsLetter)
+	if len(digits) < 4 {
+		digits = strings.Repeat("0", 4-len(digits)) + digits
+	}
+	return digits
+}
+
+// bcbpSeat turns "7C" into "007C".
+func bcbpSeat(seat string) string {
+	seat = strings.ToUpper(strings.TrimSpace(seat))
+	if len(seat) >= 4 {
+		return seat[:4]
+	}
+	return strings.Repeat("0", 4-len(seat)) + seat
+}
+
+func asciiUpper(s string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToUpper(r)
+		if (r >= 'A' && r <= 'Z') || r == ' ' {
+			return r
+		}
+		if unicode.IsSpace(r) || r == '-' {
+			return ' '
+		}
+		return -1
+	}, s)
+}
+
+func pad(s string, size int) string {
+	if len(s) >= size {
+		return s[:size]
+	}
+	return s + strings.Repeat(" ", size-len(s))
+}
@@ -0,0 +1,55 @@
+package boardingpass
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBCBP(t *testing.T) {
+	pass := &Pass{
+		PassengerName:    "Ada Lovelace",
+		BookingReference: "RDA1B2C3D",
+		FlightNumber:     "RA100",
+		Origin:           "GRU",
+		Destination:      "GIG",
+		Departure:        time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC),
+		SeatNumber:       "7c",
+		Sequence:         12,
+	}
+
+	want := "M1LOVELACE/ADA        EA1B2C3DGRUGIGRA 0100 032Y007C0012 100"
+	if got := pass.BCBP(); got != want {
+		t.Errorf("expected\n%q, got\n%q", want, got)
+	}
+	if got := len(pass.BCBP()); got != 60 {
+		t.Errorf("expected 60 characters, got %d", got)
+	}
+}
+
+func TestBCBPFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		encode func(string) string
+		value  string
+		want   string
+	}{
+		{"name", bcbpName, "Ada Lovelace", "LOVELACE/ADA"},
+		{"middle names", bcbpName, "Grace Brewster Hopper", "HOPPER/GRACE BREWSTER"},
+		{"single name", bcbpName, "Cher", "CHER"},
+		{"accents dropped, hyphens split", bcbpName, "José Silva-Santos", "SANTOS/JOS SILVA"},
+		{"empty name", bcbpName, "  ", ""},
+		{"reference", bcbpReference, "RDA1B2C3D", "A1B2C3D"},
+		{"short reference", bcbpReference, "ABC", "ABC"},
+		{"flight number", bcbpFlightNumber, "RA1001", "1001"},
+		{"short flight number", bcbpFlightNumber, "RA7", "0007"},
+		{"seat", bcbpSeat, " 7c ", "007C"},
+		{"wide seat", bcbpSeat, "123AB", "123A"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.encode(tt.value); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package boardingpass
+
+import (
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+// Pass holds what is printed on a boarding pass.
+type Pass struct {
+	PassengerName    string
+	BookingReference string
+	FlightNumber     string
+	Origin           string
+	Destination      string
+	Departure        time.Time
+	Gate             string
+	FareClass        model.FareClass
+	SeatNumber       string
+	Sequence         int
+}
+
+// New builds the boarding pass of a checked-in booking.
+func New(booking *model.Booking, flight *model.Flight, fare *model.Fare) (*Pass, error) {
+	if booking.BookingStatus != model.BookingStatusCheckedIn || booking.SeatNumber == nil || booking.CheckInSequence == nil {
+		return nil, apperror.Conflict("booking %s is not checked in", booking.BookingReference)
+	}
+
+	pass := &Pass{
+		PassengerName:    booking.PassengerName,
+		BookingReference: booking.BookingReference,
+		FlightNumber:     flight.FlightNumber,
+		Origin:           flight.Origin,
+		Destination:      flight.Destination,
+		Departure:        flight.EstimatedDeparture(),
+		FareClass:        fare.FareClass,
+		SeatNumber:       *booking.SeatNumber,
+		Sequence:         *booking.CheckInSequence,
+	}
+	if flight.Gate != nil {
+		pass.Gate = *flight.Gate
+	}
+	return pass, nil
+}
@@ -0,0 +1,85 @@
+package boardingpass
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/auth"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// Handler serves GET /boarding-passes/{reference}?token=...&format=png|pdf.
+// The token is a boarding pass token for the reference, so the link works
+// from a browser without an Authorization header and a reference alone does
+// not expose the pass. It grants nothing else, should the link leak.
+func Handler(store repository.Store, issuer *auth.Issuer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pass, err := loadPass(r, store, issuer)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var body []byte
+		switch format := r.URL.Query().Get("format"); format {
+		case "", "png":
+			body, err = pass.PNG()
+			w.Header().Set("Content-Type", "image/png")
+		case "pdf":
+			body, err = pass.PDF()
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Header().Set("Content-Disposition", `attachment; filename="boarding-pass-`+pass.BookingReference+`.pdf"`)
+		default:
+			err = apperror.Validation("unsupported format %q, use png or pdf", format)
+		}
+		if err != nil {
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Disposition")
+			writeError(w, err)
+			return
+		}
+
+		w.Header().Set("Cache-Control", "no-store")
+		if _, err := w.Write(body); err != nil {
+			log.Printf("Failed to write boarding pass: %v", err)
+		}
+	})
+}
+
+func loadPass(r *http.Request, store repository.Store, issuer *auth.Issuer) (*Pass, error) {
+	ctx := r.Context()
+	reference := r.PathValue("reference")
+
+	claims, err := issuer.ParseBoardingPassToken(r.URL.Query().Get("token"))
+	if err != nil {
+		return nil, apperror.Unauthenticated("invalid or expired boarding pass token")
+	}
+
+	booking, err := store.Bookings().GetByReference(ctx, reference)
+	if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+		return nil, err
+	}
+	if err != nil || booking.ID != claims.BookingID() {
+		return nil, apperror.NotFound("booking %s not found", reference)
+	}
+
+	flight, err := store.Flights().Get(ctx, booking.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	fare, err := store.Fares().Get(ctx, booking.FareID)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(booking, flight, fare)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := apperror.HTTPStatus(err)
+	if status == http.StatusInternalServerError {
+		log.Printf("Boarding pass request failed: %v", err)
+	}
+	http.Error(w, apperror.PublicMessage(err), status)
+}
@@ -0,0 +1,86 @@
+package boardingpass
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/pdf417"
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	barcodeWidth  = 600
+	barcodeHeight = 200
+)
+
+// PNG renders the BCBP string as a PDF417 barcode, the symbology accepted by
+// airport scanners for printed and mobile boarding passes.
+func (p *Pass) PNG() ([]byte, error) {
+	code, err := pdf417.Encode(p.BCBP(), 4)
+	if err != nil {
+		return nil, fmt.Errorf("encode barcode: %w", err)
+	}
+
+	scaled, err := barcode.Scale(code, barcodeWidth, barcodeHeight)
+	if err != nil {
+		return nil, fmt.Errorf("scale barcode: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, scaled); err != nil {
+		return nil, fmt.Errorf("encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// PDF renders a printable A4 boarding pass with the barcode.
+func (p *Pass) PDF() ([]byte, error) {
+	barcodePNG, err := p.PNG()
+	if err != nil {
+		return nil, err
+	}
+
+	gate := p.Gate
+	if gate == "" {
+		gate = "TBA"
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("Boarding pass "+p.BookingReference, false)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 22)
+	pdf.CellFormat(0, 12, "Red Airlines - Boarding Pass", "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	rows := [][2]string{
+		{"Passenger", p.PassengerName},
+		{"Booking reference", p.BookingReference},
+		{"Flight", p.FlightNumber},
+		{"From / To", p.Origin + " - " + p.Destination},
+		{"Departure", p.Departure.Format("Mon 02 Jan 2006 15:04")},
+		{"Gate", gate},
+		{"Seat", p.SeatNumber},
+		{"Fare", p.FareClass.String()},
+		{"Sequence", fmt.Sprintf("%03d", p.Sequence)},
+	}
+	for _, row := range rows {
+		pdf.SetFont("Helvetica", "", 12)
+		pdf.CellFormat(50, 8, row[0], "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.CellFormat(0, 8, pdf.UnicodeTranslatorFromDescriptor("")(row[1]), "", 1, "L", false, 0, "")
+	}
+
+	pdf.Ln(6)
+	options := fpdf.ImageOptions{ImageType: "PNG"}
+	pdf.RegisterImageOptionsReader("barcode", options, bytes.NewReader(barcodePNG))
+	pdf.ImageOptions("barcode", pdf.GetX(), pdf.GetY(), 150, 50, false, options, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("render pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,18 @@
+package booking
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// NewReference returns a random booking reference such as "RDA7K2M9QX".
+func NewReference() string {
+	const charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	const length = 7
+	result := make([]byte, length)
+	for i := range result {
+		num, _ := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+		result[i] = charset[num.Int64()]
+	}
+	return "RDA" + string(result)
+}
@@ -0,0 +1,161 @@
+package checkin
+
+import (
+	"context"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/document"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// Check-in opens WindowOpens before the estimated departure and closes
+// WindowCloses before it.
+const (
+	WindowOpens  = 24 * time.Hour
+	WindowCloses = 45 * time.Minute
+)
+
+// Result is a checked-in booking with the flight and fare needed to issue its
+// boarding pass.
+type Result struct {
+	Booking *model.Booking
+	Flight  *model.Flight
+	Fare    *model.Fare
+}
+
+// CheckIn checks in the passenger of bookingReference, assigning the first
+// free seat when the booking has none. Checking in an already checked-in
+// booking is a no-op so boarding passes can be reissued.
+//
+// authorized decides whether the caller may check in the booking. doc, when
+// given, is stored with the booking. International flights cannot be checked
+// in without a document.
+func CheckIn(ctx context.Context, store repository.Store, vault *document.Vault, bookingReference string, authorized func(*model.Booking) bool, doc *document.Document, now time.Time) (*Result, error) {
+	var result *Result
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		booking, err := tx.Bookings().GetByReferenceForUpdate(ctx, bookingReference)
+		if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+			return err
+		}
+		// An unknown reference and a booking of someone else are
+		// indistinguishable so the endpoint cannot be used to probe for valid
+		// references.
+		if err != nil || !authorized(booking) {
+			return apperror.NotFound("booking %s not found", bookingReference)
+		}
+
+		// Locking the flight serializes seat assignment and sequence numbers.
+		flight, err := tx.Flights().GetForUpdate(ctx, booking.FlightID)
+		if err != nil {
+			return err
+		}
+
+		fare, err := tx.Fares().Get(ctx, booking.FareID)
+		if err != nil {
+			return err
+		}
+
+		result = &Result{Booking: booking, Flight: flight, Fare: fare}
+
+		switch booking.BookingStatus {
+		case model.BookingStatusCheckedIn:
+			return nil
+		case model.BookingStatusConfirmed:
+		default:
+			return apperror.Conflict("booking %s is %s and cannot be checked in", booking.BookingReference, booking.BookingStatus)
+		}
+
+		if !flight.Status.IsOperable() {
+			return apperror.Conflict("flight %s is %s", flight.FlightNumber, flight.Status)
+		}
+
+		departure := flight.EstimatedDeparture()
+		if opensAt := departure.Add(-WindowOpens); now.Before(opensAt) {
+			return apperror.Validation("check-in opens at %s", opensAt.Format(time.RFC3339))
+		}
+		if closesAt := departure.Add(-WindowCloses); now.After(closesAt) {
+			return apperror.Validation("check-in closed at %s", closesAt.Format(time.RFC3339))
+		}
+
+		if doc != nil {
+			if err := vault.Save(ctx, tx.Documents(), booking, flight, doc, now); err != nil {
+				return err
+			}
+		} else if err := requireDocument(ctx, tx, booking, flight); err != nil {
+			return err
+		}
+
+		bookings, err := tx.Bookings().ListByFlight(ctx, flight.ID)
+		if err != nil {
+			return err
+		}
+
+		// Seats booked before they were validated may not exist on this
+		// aircraft; those passengers get a free seat like everyone without one.
+		seat := ""
+		if booking.SeatNumber != nil {
+			seat, _ = ValidateSeat(flight.TotalSeats, *booking.SeatNumber)
+		}
+		if seat == "" {
+			seat, err = pickFreeSeat(flight, bookings)
+			if err != nil {
+				return err
+			}
+		}
+
+		sequence := 1
+		for _, b := range bookings {
+			if b.CheckInSequence != nil {
+				sequence = max(sequence, *b.CheckInSequence+1)
+			}
+		}
+
+		booking.SeatNumber = &seat
+		booking.BookingStatus = model.BookingStatusCheckedIn
+		booking.CheckedInAt = &now
+		booking.CheckInSequence = &sequence
+		booking.UpdatedAt = now
+		return tx.Bookings().Update(ctx, booking)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func requireDocument(ctx context.Context, tx repository.Store, booking *model.Booking, flight *model.Flight) error {
+	international, err := document.IsInternational(ctx, tx.Airports(), flight)
+	if err != nil || !international {
+		return err
+	}
+	exists, err := document.Exists(ctx, tx.Documents(), booking.ID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return apperror.Validation("passport details are required to check in for an international flight")
+	}
+	return nil
+}
+
+func pickFreeSeat(flight *model.Flight, bookings []*model.Booking) (string, error) {
+	seat, ok := assignSeat(flight.TotalSeats, takenSeats(bookings))
+	if !ok {
+		return "", apperror.SoldOut("no free seat left on flight %s", flight.FlightNumber)
+	}
+	return seat, nil
+}
+
+// takenSeats returns the seats held by the bookings of a flight that are not
+// cancelled.
+func takenSeats(bookings []*model.Booking) map[string]bool {
+	taken := make(map[string]bool, len(bookings))
+	for _, b := range bookings {
+		if b.SeatNumber != nil && b.BookingStatus != model.BookingStatusCancelled {
+			taken[normalizeSeat(*b.SeatNumber)] = true
+		}
+	}
+	return taken
+}
@@ -0,0 +1,124 @@
+package checkin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/ancillary"
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+const seatLetters = "ABCDEF"
+
+// SeatLayout lists the seats of an aircraft with totalSeats seats in
+// boarding order: row by row, six abreast.
+func SeatLayout(totalSeats int) []string {
+	seats := make([]string, 0, totalSeats)
+	for i := range totalSeats {
+		row := i/len(seatLetters) + 1
+		seats = append(seats, fmt.Sprintf("%d%c", row, seatLetters[i%len(seatLetters)]))
+	}
+	return seats
+}
+
+// ValidateSeat returns seat normalized, or a validation error when an
+// aircraft with totalSeats seats has no such seat.
+func ValidateSeat(totalSeats int, seat string) (string, error) {
+	seat = normalizeSeat(seat)
+	if !slices.Contains(SeatLayout(totalSeats), seat) {
+		return "", apperror.Validation("seat %q does not exist on this aircraft", seat)
+	}
+	return seat, nil
+}
+
+// ReserveSeat validates the seat a passenger picked when booking flight: it
+// must exist and not be held by another booking. It returns the seat
+// normalized. The flight must be locked by tx.
+func ReserveSeat(ctx context.Context, tx repository.Store, flight *model.Flight, seat string) (string, error) {
+	seat, err := ValidateSeat(flight.TotalSeats, seat)
+	if err != nil {
+		return "", err
+	}
+
+	bookings, err := tx.Bookings().ListByFlight(ctx, flight.ID)
+	if err != nil {
+		return "", err
+	}
+	if takenSeats(bookings)[seat] {
+		return "", apperror.Conflict("seat %s is already taken on flight %s", seat, flight.FlightNumber)
+	}
+	return seat, nil
+}
+
+// SelectSeat moves the passenger of bookingReference to seat before check-in.
+// Only bookings that bought seat selection choose their seat; the others get
+// a free one at check-in. authorized decides whether the caller may change
+// the booking, like check-in.
+func SelectSeat(ctx context.Context, store repository.Store, bookingReference string, authorized func(*model.Booking) bool, seat string, now time.Time) (*model.Booking, error) {
+	var booking *model.Booking
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		var err error
+		booking, err = tx.Bookings().GetByReferenceForUpdate(ctx, bookingReference)
+		if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+			return err
+		}
+		if err != nil || !authorized(booking) {
+			return apperror.NotFound("booking %s not found", bookingReference)
+		}
+		if booking.BookingStatus != model.BookingStatusConfirmed {
+			return apperror.Conflict("booking %s is %s and its seat cannot be changed", booking.BookingReference, booking.BookingStatus)
+		}
+
+		bought, err := ancillary.HasSeatSelection(ctx, tx, booking.ID)
+		if err != nil {
+			return err
+		}
+		if !bought {
+			return apperror.Validation("buy seat selection to choose a seat")
+		}
+
+		// Locking the flight serializes seat assignment, like check-in.
+		flight, err := tx.Flights().GetForUpdate(ctx, booking.FlightID)
+		if err != nil {
+			return err
+		}
+		if !flight.Status.IsOperable() {
+			return apperror.Conflict("flight %s is %s", flight.FlightNumber, flight.Status)
+		}
+
+		if booking.SeatNumber != nil && normalizeSeat(*booking.SeatNumber) == normalizeSeat(seat) {
+			return nil
+		}
+		seat, err = ReserveSeat(ctx, tx, flight, seat)
+		if err != nil {
+			return err
+		}
+
+		booking.SeatNumber = &seat
+		booking.UpdatedAt = now
+		return tx.Bookings().Update(ctx, booking)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// assignSeat returns the first seat of the layout that is not taken.
+func assignSeat(totalSeats int, taken map[string]bool) (string, bool) {
+	for _, seat := range SeatLayout(totalSeats) {
+		if !taken[seat] {
+			return seat, true
+		}
+	}
+	return "", false
+}
+
+func normalizeSeat(seat string) string {
+	return strings.ToUpper(strings.TrimSpace(seat))
+}
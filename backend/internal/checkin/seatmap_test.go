@@ -0,0 +1,87 @@
+package checkin
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+func TestSeatLayout(t *testing.T) {
+	tests := []struct {
+		totalSeats int
+		want       []string
+	}{
+		{0, []string{}},
+		{3, []string{"1A", "1B", "1C"}},
+		{8, []string{"1A", "1B", "1C", "1D", "1E", "1F", "2A", "2B"}},
+	}
+	for _, tt := range tests {
+		if got := SeatLayout(tt.totalSeats); !slices.Equal(got, tt.want) {
+			t.Errorf("SeatLayout(%d): expected %v, got %v", tt.totalSeats, tt.want, got)
+		}
+	}
+
+	if last := SeatLayout(30)[29]; last != "5F" {
+		t.Errorf("expected the 30th seat to be 5F, got %s", last)
+	}
+}
+
+func TestValidateSeat(t *testing.T) {
+	tests := []struct {
+		name string
+		seat string
+		want string
+	}{
+		{"first seat", "1A", "1A"},
+		{"last seat", "5F", "5F"},
+		{"normalized", " 2c ", "2C"},
+		{"row past the aircraft", "6A", ""},
+		{"no such letter", "1G", ""},
+		{"row zero", "0A", ""},
+		{"leading zero", "01A", ""},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateSeat(30, tt.seat)
+			if tt.want == "" {
+				if apperror.CodeOf(err) != apperror.CodeValidation {
+					t.Errorf("expected a VALIDATION error, got %q, %v", got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("expected %s, got %q, %v", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestAssignSeat(t *testing.T) {
+	tests := []struct {
+		name  string
+		taken []string
+		want  string
+	}{
+		{"empty flight", nil, "1A"},
+		{"first seats taken", []string{"1A", "1B"}, "1C"},
+		{"gap", []string{"1A", "1C"}, "1B"},
+		{"next row", []string{"1A", "1B", "1C", "1D", "1E", "1F"}, "2A"},
+		{"invalid seats ignored", []string{"9Z"}, "1A"},
+		{"full", SeatLayout(12), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taken := map[string]bool{}
+			for _, seat := range tt.taken {
+				taken[seat] = true
+			}
+
+			got, ok := assignSeat(12, taken)
+			if ok != (tt.want != "") || got != tt.want {
+				t.Errorf("expected %q, got %q (ok %v)", tt.want, got, ok)
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package database
 
 import (
 	"database/sql"
+	"errors"
+	"log"
 	"os"
+	"time"
 
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 func getConnStr() string {
@@ -35,3 +38,18 @@ func ConnectSQLX() (*sqlx.DB, error) {
 	db.SetMaxIdleConns(5)
 	return db, nil
 }
+
+// NewListener returns a pq.Listener with its own connection for LISTEN/NOTIFY
+func NewListener() *pq.Listener {
+	return pq.NewListener(getConnStr(), 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Database listener event %d: %v", event, err)
+		}
+	})
+}
+
+// IsUniqueViolation reports whether err is a Postgres unique constraint violation
+func IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
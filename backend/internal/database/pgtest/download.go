@@ -0,0 +1,189 @@
+package pgtest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ulikunitz/xz"
+)
+
+// Version is the Postgres release downloaded when none is installed.
+const Version = "17.2.0"
+
+// defaultMirror is Maven Central, where the zonky embedded-postgres
+// binaries used by the embedded-postgres libraries are published.
+const defaultMirror = "https://repo1.maven.org/maven2"
+
+// archNames maps GOARCH to the architecture of the zonky artifacts.
+var archNames = map[string]string{
+	"amd64":   "amd64",
+	"arm64":   "arm64v8",
+	"arm":     "arm32v7",
+	"386":     "i386",
+	"ppc64le": "ppc64le",
+}
+
+// download returns the bin directory of the Version binaries for this
+// platform, fetching and unpacking them into the cache on first use.
+func download() (string, error) {
+	arch, ok := archNames[runtime.GOARCH]
+	if !ok {
+		return "", fmt.Errorf("%w: no Postgres binaries are published for %s/%s", ErrUnavailable, runtime.GOOS, runtime.GOARCH)
+	}
+	platform := runtime.GOOS + "-" + arch
+
+	cache, err := cacheDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(cache, Version+"-"+platform)
+	bin := filepath.Join(dir, "bin")
+	if _, err := os.Stat(bin); err == nil {
+		return bin, nil
+	}
+
+	mirror := os.Getenv(MirrorEnv)
+	if mirror == "" {
+		mirror = defaultMirror
+	}
+	artifact := "embedded-postgres-binaries-" + platform
+	url := fmt.Sprintf("%s/io/zonky/test/postgres/%s/%s/%s-%s.jar", strings.TrimSuffix(mirror, "/"), artifact, Version, artifact, Version)
+
+	jar, err := fetch(url)
+	if err != nil {
+		return "", fmt.Errorf("%w: download %s: %v", ErrUnavailable, url, err)
+	}
+
+	// Unpacking next to the final directory and renaming it lets concurrent
+	// test binaries race for the download without seeing a partial tree.
+	tmp, err := os.MkdirTemp(cache, "download-")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	if err := os.Chmod(tmp, 0o755); err != nil {
+		return "", err
+	}
+	if err := unpack(jar, tmp); err != nil {
+		return "", fmt.Errorf("unpack %s: %w", url, err)
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		if _, statErr := os.Stat(bin); statErr != nil {
+			return "", err
+		}
+	}
+	return bin, nil
+}
+
+// cacheDir returns the directory downloads are kept in. As root the server
+// runs as another user, who cannot read root's home, so the downloads go to
+// the temporary directory instead.
+func cacheDir() (string, error) {
+	base := os.TempDir()
+	if os.Geteuid() != 0 {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		base = dir
+	}
+	dir := filepath.Join(base, "pgtest")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+func fetch(url string) ([]byte, error) {
+	client := http.Client{Timeout: 5 * time.Minute}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// unpack extracts the xz-compressed tarball inside jar into dir.
+func unpack(jar []byte, dir string) error {
+	archive, err := zip.NewReader(bytes.NewReader(jar), int64(len(jar)))
+	if err != nil {
+		return err
+	}
+	for _, f := range archive.File {
+		if !strings.HasSuffix(f.Name, ".txz") {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer func() { _ = r.Close() }()
+		return untar(r, dir)
+	}
+	return errors.New("no .txz archive in the jar")
+}
+
+func untar(r io.Reader, dir string) error {
+	xzr, err := xz.NewReader(r)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(xzr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, header.Name)
+		if path == filepath.Clean(dir) {
+			continue
+		}
+		if !strings.HasPrefix(path, filepath.Clean(dir)+string(filepath.Separator)) {
+			return fmt.Errorf("%s escapes the archive", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(path, 0o755)
+		case tar.TypeSymlink:
+			if err = os.MkdirAll(filepath.Dir(path), 0o755); err == nil {
+				err = os.Symlink(header.Linkname, path)
+			}
+		case tar.TypeReg:
+			err = writeFile(path, tr, header.FileInfo().Mode().Perm()|0o444)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func writeFile(path string, r io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	return errors.Join(err, f.Close())
+}
@@ -0,0 +1,18 @@
+//go:build !unix
+
+package pgtest
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+type owner struct {
+	uid, gid int
+}
+
+func unprivileged() (*owner, error) {
+	return nil, fmt.Errorf("%w: running as root is only supported on unix", ErrUnavailable)
+}
+
+func (o *owner) apply(*exec.Cmd) {}
@@ -0,0 +1,48 @@
+//go:build unix
+
+package pgtest
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// owner is the unprivileged user the server runs as.
+type owner struct {
+	uid, gid int
+}
+
+// unprivileged looks up the user named by UserEnv, nobody by default.
+func unprivileged() (*owner, error) {
+	name := os.Getenv(UserEnv)
+	if name == "" {
+		name = "nobody"
+	}
+	u, err := user.Lookup(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: running as root and %v, set %s to an unprivileged user", ErrUnavailable, err, UserEnv)
+	}
+	uid, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return nil, fmt.Errorf("user %s has uid %q: %w", name, u.Uid, err)
+	}
+	gid, err := strconv.Atoi(u.Gid)
+	if err != nil {
+		return nil, fmt.Errorf("user %s has gid %q: %w", name, u.Gid, err)
+	}
+	return &owner{uid: uid, gid: gid}, nil
+}
+
+// apply makes cmd run as o. A nil owner leaves cmd unchanged.
+func (o *owner) apply(cmd *exec.Cmd) {
+	if o == nil {
+		return
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{Uid: uint32(o.uid), Gid: uint32(o.gid)},
+	}
+}
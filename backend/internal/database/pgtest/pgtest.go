@@ -0,0 +1,202 @@
+// Package pgtest runs a throwaway Postgres server for tests, without Docker.
+// It uses the binaries installed on the machine, or downloads them once like
+// the embedded-postgres libraries do. The cluster lives in a temporary
+// directory and is removed by Stop.
+package pgtest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	// BinEnv names the directory holding initdb and pg_ctl. When it is unset
+	// they are looked up in PATH and in the usual package install locations,
+	// and downloaded when none is installed.
+	BinEnv = "PG_BIN"
+	// MirrorEnv replaces Maven Central as the repository binaries are
+	// downloaded from.
+	MirrorEnv = "PGTEST_MIRROR"
+	// UserEnv names the user the server runs as when the tests run as root,
+	// which Postgres refuses. It defaults to nobody.
+	UserEnv = "PGTEST_USER"
+	// SkipEnv lets the tests skip Postgres when it cannot run, such as
+	// offline without binaries installed. It is a failure otherwise.
+	SkipEnv = "PGTEST_SKIP"
+)
+
+// ErrUnavailable is returned by Start when Postgres cannot run here; tests
+// fail unless Skippable.
+var ErrUnavailable = errors.New("postgres is unavailable")
+
+// Skippable reports whether SkipEnv allows the tests to skip an unavailable
+// Postgres.
+func Skippable() bool {
+	return os.Getenv(SkipEnv) != ""
+}
+
+var binDirPatterns = []string{
+	"/usr/lib/postgresql/*/bin",
+	"/usr/pgsql-*/bin",
+	"/usr/local/opt/postgresql*/bin",
+	"/opt/homebrew/opt/postgresql*/bin",
+}
+
+// Server is a running Postgres cluster accepting trusted connections from
+// the postgres user on localhost.
+type Server struct {
+	bin  string
+	dir  string
+	port int
+	// owner runs the server when the tests run as root; nil otherwise.
+	owner *owner
+}
+
+// Start creates a cluster in a temporary directory and starts it on a free
+// port.
+func Start() (*Server, error) {
+	bin, err := findBin()
+	if err != nil {
+		return nil, err
+	}
+	port, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp("", "pgtest-")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{bin: bin, dir: dir, port: port}
+	if os.Geteuid() == 0 {
+		if s.owner, err = unprivileged(); err == nil {
+			err = os.Chown(dir, s.owner.uid, s.owner.gid)
+		}
+		if err != nil {
+			_ = os.RemoveAll(dir)
+			return nil, err
+		}
+	}
+
+	if err := s.run("initdb", "-D", s.data(), "-U", "postgres", "-A", "trust", "-E", "UTF8", "--no-locale", "--no-sync"); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	options := fmt.Sprintf("-p %d -k %s -c listen_addresses=127.0.0.1 -c timezone=UTC -c fsync=off -c synchronous_commit=off -c full_page_writes=off", port, dir)
+	if err := s.run("pg_ctl", "start", "-w", "-D", s.data(), "-l", filepath.Join(dir, "postgres.log"), "-o", options); err != nil {
+		if log, readErr := os.ReadFile(filepath.Join(dir, "postgres.log")); readErr == nil {
+			err = fmt.Errorf("%w\n%s", err, log)
+		}
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Stop shuts the server down and removes its files.
+func (s *Server) Stop() error {
+	err := s.run("pg_ctl", "stop", "-w", "-D", s.data(), "-m", "immediate")
+	return errors.Join(err, os.RemoveAll(s.dir))
+}
+
+// URL returns the connection string of database name.
+func (s *Server) URL(name string) string {
+	return fmt.Sprintf("host=127.0.0.1 port=%d user=postgres dbname=%s sslmode=disable", s.port, name)
+}
+
+// CreateDatabase creates database name, as a copy of template when it is not
+// empty, and returns its connection string. The template must have no open
+// connections.
+func (s *Server) CreateDatabase(name, template string) (string, error) {
+	db, err := sqlx.Connect("postgres", s.URL("postgres"))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = db.Close() }()
+
+	query := "CREATE DATABASE " + pq.QuoteIdentifier(name)
+	if template != "" {
+		query += " TEMPLATE " + pq.QuoteIdentifier(template)
+	}
+	if _, err := db.Exec(query); err != nil {
+		return "", fmt.Errorf("create database %s: %w", name, err)
+	}
+	return s.URL(name), nil
+}
+
+func (s *Server) data() string {
+	return filepath.Join(s.dir, "data")
+}
+
+func (s *Server) run(name string, args ...string) error {
+	var output bytes.Buffer
+	cmd := exec.Command(filepath.Join(s.bin, name), args...)
+	// The working directory of the tests may not be readable by the owner.
+	cmd.Dir = s.dir
+	s.owner.apply(cmd)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w\n%s", name, err, output.String())
+	}
+	return nil
+}
+
+// findBin returns the directory of initdb and pg_ctl, preferring BinEnv, then
+// PATH, then the newest version in the usual install locations, then a
+// download.
+func findBin() (string, error) {
+	if dir := os.Getenv(BinEnv); dir != "" {
+		return dir, nil
+	}
+	if path, err := exec.LookPath("pg_ctl"); err == nil {
+		return filepath.Dir(path), nil
+	}
+
+	var dirs []string
+	for _, pattern := range binDirPatterns {
+		matches, _ := filepath.Glob(pattern)
+		dirs = append(dirs, matches...)
+	}
+	slices.SortFunc(dirs, func(a, b string) int { return version(b) - version(a) })
+	for _, dir := range dirs {
+		if _, err := os.Stat(filepath.Join(dir, "pg_ctl")); err == nil {
+			return dir, nil
+		}
+	}
+	return download()
+}
+
+// version extracts the major version from a directory such as
+// /usr/lib/postgresql/17/bin or /opt/homebrew/opt/postgresql@16/bin.
+func version(dir string) int {
+	name := filepath.Base(filepath.Dir(dir))
+	start := len(name)
+	for start > 0 && name[start-1] >= '0' && name[start-1] <= '9' {
+		start--
+	}
+	n, _ := strconv.Atoi(name[start:])
+	return n
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = l.Close() }()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
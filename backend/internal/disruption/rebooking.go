@@ -0,0 +1,204 @@
+package disruption
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/ancillary"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// rebookingWindow bounds how far after the cancelled departure an
+// alternative flight is still considered acceptable.
+const rebookingWindow = 72 * time.Hour
+
+type affectedBooking struct {
+	*model.Booking
+	fareClass model.FareClass
+}
+
+type candidateFare struct {
+	*model.Fare
+	departureTime time.Time
+}
+
+// Result lists what happened to every booking of a cancelled flight.
+type Result struct {
+	Rebookings        []*model.Rebooking
+	BookingReferences []string
+}
+
+// RebookCancelledFlight re-accommodates the active bookings of flight on the
+// next scheduled flights of the same route. Passengers are processed by fare
+// class (PRO first) and then by booking time, and are only moved to a fare of
+// the same or a higher class. Bookings that cannot be moved are marked
+// DISRUPTED for an agent to handle. It must run in the transaction that
+// cancels the flight.
+func RebookCancelledFlight(ctx context.Context, tx repository.Store, flight *model.Flight) (*Result, error) {
+	bookings, err := loadAffectedBookings(ctx, tx, flight.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	if len(bookings) == 0 {
+		return result, nil
+	}
+
+	candidates, err := loadCandidateFares(ctx, tx, flight)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, booking := range bookings {
+		rebooking := &model.Rebooking{
+			BookingID:        booking.ID,
+			OriginalFlightID: booking.FlightID,
+			OriginalFareID:   booking.FareID,
+			Outcome:          model.RebookingOutcomeUnaccommodated,
+			ProcessedAt:      now,
+		}
+
+		if fare := pickFare(candidates, booking.fareClass); fare != nil {
+			fare.AvailableSeats--
+			rebooking.Outcome = model.RebookingOutcomeRebooked
+			rebooking.NewFlightID = &fare.FlightID
+			rebooking.NewFareID = &fare.ID
+
+			if err := moveBooking(ctx, tx, booking, fare, now); err != nil {
+				return nil, err
+			}
+		} else if err := markDisrupted(ctx, tx, booking, now); err != nil {
+			return nil, err
+		}
+
+		if err := tx.Rebookings().Create(ctx, rebooking); err != nil {
+			return nil, err
+		}
+
+		result.Rebookings = append(result.Rebookings, rebooking)
+		result.BookingReferences = append(result.BookingReferences, booking.BookingReference)
+	}
+
+	return result, nil
+}
+
+func loadAffectedBookings(ctx context.Context, tx repository.Store, flightID string) ([]*affectedBooking, error) {
+	bookings, err := tx.Bookings().ListByFlightForUpdate(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+	fares, err := tx.Fares().ListByFlight(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+	classes := make(map[string]model.FareClass, len(fares))
+	for _, fare := range fares {
+		classes[fare.ID] = fare.FareClass
+	}
+
+	var affected []*affectedBooking
+	for _, b := range bookings {
+		if b.BookingStatus.HoldsSeat() {
+			affected = append(affected, &affectedBooking{Booking: b, fareClass: classes[b.FareID]})
+		}
+	}
+
+	// Stable sort keeps booking time order within the same fare class.
+	slices.SortStableFunc(affected, func(a, b *affectedBooking) int {
+		return b.fareClass.Rank() - a.fareClass.Rank()
+	})
+
+	return affected, nil
+}
+
+func loadCandidateFares(ctx context.Context, tx repository.Store, flight *model.Flight) ([]*candidateFare, error) {
+	status := model.FlightStatusScheduled
+	latest := flight.DepartureTime.Add(rebookingWindow)
+	flights, err := tx.Flights().List(ctx, repository.FlightFilter{
+		Origin:       &flight.Origin,
+		Destination:  &flight.Destination,
+		Status:       &status,
+		DepartsAfter: &flight.DepartureTime,
+		DepartsBy:    &latest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	departures := make(map[string]time.Time, len(flights))
+	ids := make([]string, 0, len(flights))
+	for _, f := range flights {
+		if f.ID != flight.ID {
+			departures[f.ID] = f.DepartureTime
+			ids = append(ids, f.ID)
+		}
+	}
+
+	fares, err := tx.Fares().ListByFlightsForUpdate(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*candidateFare
+	for _, fare := range fares {
+		if fare.AvailableSeats > 0 {
+			candidates = append(candidates, &candidateFare{Fare: fare, departureTime: departures[fare.FlightID]})
+		}
+	}
+
+	// Earliest flight first and, within a flight, the lowest class first so a
+	// passenger is only upgraded when their own class is sold out.
+	slices.SortStableFunc(candidates, func(a, b *candidateFare) int {
+		return cmp.Or(
+			a.departureTime.Compare(b.departureTime),
+			strings.Compare(a.FlightID, b.FlightID),
+			a.FareClass.Rank()-b.FareClass.Rank(),
+		)
+	})
+
+	return candidates, nil
+}
+
+func pickFare(candidates []*candidateFare, class model.FareClass) *candidateFare {
+	for _, fare := range candidates {
+		if fare.AvailableSeats > 0 && fare.FareClass.Rank() >= class.Rank() {
+			return fare
+		}
+	}
+	return nil
+}
+
+func moveBooking(ctx context.Context, tx repository.Store, booking *affectedBooking, fare *candidateFare, now time.Time) error {
+	fromFlightID := booking.FlightID
+
+	// The seat belonged to the cancelled aircraft and the passenger has to
+	// check in again, so the booking goes back to CONFIRMED without a seat
+	// or a place in the boarding sequence.
+	booking.FlightID = fare.FlightID
+	booking.FareID = fare.ID
+	booking.SeatNumber = nil
+	booking.BookingStatus = model.BookingStatusConfirmed
+	booking.CheckedInAt = nil
+	booking.CheckInSequence = nil
+	booking.UpdatedAt = now
+
+	if err := ancillary.MoveInventory(ctx, tx, booking.Booking, fromFlightID); err != nil {
+		return err
+	}
+	if err := tx.Bookings().Update(ctx, booking.Booking); err != nil {
+		return err
+	}
+	return tx.Fares().TakeSeat(ctx, fare.ID)
+}
+
+func markDisrupted(ctx context.Context, tx repository.Store, booking *affectedBooking, now time.Time) error {
+	booking.BookingStatus = model.BookingStatusDisrupted
+	booking.UpdatedAt = now
+	return tx.Bookings().Update(ctx, booking.Booking)
+}
@@ -0,0 +1,150 @@
+package document
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+const dateLayout = time.DateOnly
+
+var (
+	documentNumberPattern = regexp.MustCompile(`^[A-Z0-9]{1,20}$`)
+	countryCodePattern    = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// Document holds the identity document of a passenger. It is only persisted
+// encrypted, see Vault.
+type Document struct {
+	Type           model.DocumentType `json:"type"`
+	Number         string             `json:"number"`
+	IssuingCountry string             `json:"issuingCountry"`
+	Nationality    string             `json:"nationality"`
+	Surname        string             `json:"surname"`
+	GivenNames     string             `json:"givenNames"`
+	DateOfBirth    time.Time          `json:"dateOfBirth"`
+	ExpiryDate     time.Time          `json:"expiryDate"`
+	// Sex is M, F or X.
+	Sex string `json:"sex"`
+}
+
+// Input is a document as entered by the passenger, either as a passport MRZ
+// or as individual fields.
+type Input struct {
+	MRZ            *string
+	Type           *model.DocumentType
+	Number         *string
+	IssuingCountry *string
+	Nationality    *string
+	DateOfBirth    *string
+	ExpiryDate     *string
+	Sex            *string
+}
+
+// Parse turns in into a Document. Names are left empty for the caller to
+// fill from the booking when no MRZ is given.
+func (in *Input) Parse(now time.Time) (*Document, error) {
+	if in.MRZ != nil && strings.TrimSpace(*in.MRZ) != "" {
+		return ParseMRZ(*in.MRZ, now)
+	}
+
+	doc := &Document{
+		Type:           model.DocumentTypePassport,
+		Number:         upper(in.Number),
+		IssuingCountry: upper(in.IssuingCountry),
+		Nationality:    upper(in.Nationality),
+		Sex:            upper(in.Sex),
+	}
+	if in.Type != nil {
+		doc.Type = *in.Type
+	}
+	if doc.IssuingCountry == "" {
+		doc.IssuingCountry = doc.Nationality
+	}
+
+	var err error
+	if doc.DateOfBirth, err = parseDate("dateOfBirth", in.DateOfBirth); err != nil {
+		return nil, err
+	}
+	if doc.ExpiryDate, err = parseDate("expiryDate", in.ExpiryDate); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Validate checks the document for travel on flight. It must not expire
+// before the flight arrives.
+func (d *Document) Validate(flight *model.Flight, now time.Time) error {
+	if !d.Type.IsValid() {
+		return apperror.Validation("documentType is invalid")
+	}
+	if !documentNumberPattern.MatchString(d.Number) {
+		return apperror.Validation("documentNumber must be 1 to 20 letters or digits")
+	}
+	if !countryCodePattern.MatchString(d.Nationality) {
+		return apperror.Validation("nationality must be an ISO 3166 alpha-3 code")
+	}
+	if !countryCodePattern.MatchString(d.IssuingCountry) {
+		return apperror.Validation("issuingCountry must be an ISO 3166 alpha-3 code")
+	}
+	switch d.Sex {
+	case "", "M", "F", "X":
+	default:
+		return apperror.Validation("sex must be M, F or X")
+	}
+	if !d.DateOfBirth.Before(now) {
+		return apperror.Validation("dateOfBirth must be in the past")
+	}
+
+	arrival := flight.ArrivalTime
+	travelDate := time.Date(arrival.Year(), arrival.Month(), arrival.Day(), 0, 0, 0, 0, time.UTC)
+	if d.ExpiryDate.Before(travelDate) {
+		return apperror.Validation("document expires on %s, before the travel date %s",
+			d.ExpiryDate.Format(dateLayout), travelDate.Format(dateLayout))
+	}
+
+	return nil
+}
+
+// fillNames takes the passenger names from the booking when the document did
+// not provide them.
+func (d *Document) fillNames(booking *model.Booking) {
+	if d.Surname != "" {
+		return
+	}
+	d.Surname = strings.ToUpper(booking.LastName())
+	fields := strings.Fields(booking.PassengerName)
+	if len(fields) > 1 {
+		d.GivenNames = strings.ToUpper(strings.Join(fields[:len(fields)-1], " "))
+	}
+}
+
+// MaskedNumber hides all but the last three characters of the number.
+func (d *Document) MaskedNumber() string {
+	if len(d.Number) <= 3 {
+		return strings.Repeat("*", len(d.Number))
+	}
+	return strings.Repeat("*", len(d.Number)-3) + d.Number[len(d.Number)-3:]
+}
+
+func upper(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return strings.ToUpper(strings.TrimSpace(*value))
+}
+
+func parseDate(field string, value *string) (time.Time, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return time.Time{}, apperror.Validation("%s is required", field)
+	}
+	date, err := time.Parse(dateLayout, strings.TrimSpace(*value))
+	if err != nil {
+		return time.Time{}, apperror.Validation("%s must be a YYYY-MM-DD date", field)
+	}
+	return date, nil
+}
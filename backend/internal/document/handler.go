@@ -0,0 +1,89 @@
+package document
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// MissingDocumentsHeader reports how many passengers were left out of the
+// manifest because no document was captured for them.
+const MissingDocumentsHeader = "X-Missing-Documents"
+
+// APISHandler serves GET /flights/{flightId}/apis, the PAXLST manifest of
+// the passengers holding a seat on the flight.
+func APISHandler(store repository.Store, vault *Vault) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flight, passengers, missing, err := loadManifest(r.Context(), store, vault, r.PathValue("flightId"))
+		if err != nil {
+			status := apperror.HTTPStatus(err)
+			if status == http.StatusInternalServerError {
+				log.Printf("APIS export failed: %v", err)
+			}
+			http.Error(w, apperror.PublicMessage(err), status)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/edifact")
+		w.Header().Set("Content-Disposition", `attachment; filename="apis-`+flight.FlightNumber+`.edi"`)
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set(MissingDocumentsHeader, strconv.Itoa(missing))
+		if _, err := w.Write(PAXLST(flight, passengers, time.Now())); err != nil {
+			log.Printf("Failed to write APIS manifest: %v", err)
+		}
+	})
+}
+
+func loadManifest(ctx context.Context, store repository.Store, vault *Vault, flightID string) (*model.Flight, []*Passenger, int, error) {
+	if vault == nil {
+		return nil, nil, 0, errNotConfigured
+	}
+
+	flight, err := store.Flights().Get(ctx, flightID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	bookings, err := store.Bookings().ListByFlight(ctx, flight.ID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	bookings = slices.DeleteFunc(bookings, func(b *model.Booking) bool { return !b.BookingStatus.HoldsSeat() })
+	slices.SortFunc(bookings, func(a, b *model.Booking) int {
+		return cmp.Or(cmp.Compare(a.PassengerName, b.PassengerName), cmp.Compare(a.ID, b.ID))
+	})
+
+	ids := make([]string, 0, len(bookings))
+	for _, b := range bookings {
+		ids = append(ids, b.ID)
+	}
+	documents, err := store.Documents().ListByBookings(ctx, ids)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	passengers := make([]*Passenger, 0, len(bookings))
+	missing := 0
+	for _, b := range bookings {
+		ciphertext, ok := documents[b.ID]
+		if !ok {
+			missing++
+			continue
+		}
+		doc, err := vault.Open(b.ID, ciphertext)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		passengers = append(passengers, &Passenger{Booking: b, Document: doc})
+	}
+
+	return flight, passengers, missing, nil
+}
@@ -0,0 +1,131 @@
+package document
+
+import (
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+// td3LineLength is the length of each of the two MRZ lines of a passport
+// (ICAO 9303 TD3).
+const td3LineLength = 44
+
+// ParseMRZ reads a passport machine readable zone. The two lines may be
+// separated by a newline or concatenated. Every check digit is verified.
+func ParseMRZ(mrz string, now time.Time) (*Document, error) {
+	lines := strings.Fields(strings.ToUpper(mrz))
+	if len(lines) == 1 && len(lines[0]) == 2*td3LineLength {
+		lines = []string{lines[0][:td3LineLength], lines[0][td3LineLength:]}
+	}
+	if len(lines) != 2 || len(lines[0]) != td3LineLength || len(lines[1]) != td3LineLength {
+		return nil, apperror.Validation("mrz must be two lines of %d characters", td3LineLength)
+	}
+	line1, line2 := lines[0], lines[1]
+	for _, line := range lines {
+		for _, c := range line {
+			if _, ok := mrzValue(c); !ok {
+				return nil, apperror.Validation("mrz contains invalid character %q", c)
+			}
+		}
+	}
+
+	if line1[0] != 'P' {
+		return nil, apperror.Validation("mrz is not a passport")
+	}
+
+	fields := []struct {
+		name  string
+		value string
+		check byte
+	}{
+		{"document number", line2[0:9], line2[9]},
+		{"date of birth", line2[13:19], line2[19]},
+		{"expiry date", line2[21:27], line2[27]},
+		{"composite", line2[0:10] + line2[13:20] + line2[21:43], line2[43]},
+	}
+	for _, field := range fields {
+		if checkDigit(field.value) != field.check {
+			return nil, apperror.Validation("mrz %s check digit does not match", field.name)
+		}
+	}
+	// The personal number check digit may be '<' when the field is empty.
+	if personal := line2[28:42]; line2[42] != '<' || strings.Trim(personal, "<") != "" {
+		if checkDigit(personal) != line2[42] {
+			return nil, apperror.Validation("mrz personal number check digit does not match")
+		}
+	}
+
+	birth, err := parseMRZDate(line2[13:19])
+	if err != nil {
+		return nil, err
+	}
+	// Two-digit years in the future are birth years of the previous century.
+	if birth.After(now) {
+		birth = birth.AddDate(-100, 0, 0)
+	}
+	expiry, err := parseMRZDate(line2[21:27])
+	if err != nil {
+		return nil, err
+	}
+
+	surname, givenNames, _ := strings.Cut(line1[5:], "<<")
+
+	doc := &Document{
+		Type:           model.DocumentTypePassport,
+		Number:         strings.TrimRight(line2[0:9], "<"),
+		IssuingCountry: strings.TrimRight(line1[2:5], "<"),
+		Nationality:    strings.TrimRight(line2[10:13], "<"),
+		Surname:        mrzName(surname),
+		GivenNames:     mrzName(givenNames),
+		DateOfBirth:    birth,
+		ExpiryDate:     expiry,
+	}
+	if sex := line2[20]; sex != '<' {
+		doc.Sex = string(sex)
+	} else {
+		doc.Sex = "X"
+	}
+
+	return doc, nil
+}
+
+// checkDigit computes the ICAO 9303 check digit of value.
+func checkDigit(value string) byte {
+	weights := [3]int{7, 3, 1}
+	sum := 0
+	for i, c := range value {
+		v, _ := mrzValue(c)
+		sum += v * weights[i%3]
+	}
+	return byte('0' + sum%10)
+}
+
+func mrzValue(c rune) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10, true
+	case c == '<':
+		return 0, true
+	}
+	return 0, false
+}
+
+// parseMRZDate parses YYMMDD as a date in 2000-2099.
+func parseMRZDate(value string) (time.Time, error) {
+	date, err := time.Parse("060102", value)
+	if err != nil {
+		return time.Time{}, apperror.Validation("mrz contains invalid date %q", value)
+	}
+	if date.Year() < 2000 {
+		date = date.AddDate(100, 0, 0)
+	}
+	return date, nil
+}
+
+func mrzName(value string) string {
+	return strings.Join(strings.FieldsFunc(value, func(c rune) bool { return c == '<' }), " ")
+}
@@ -0,0 +1,106 @@
+package document
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+// The specimen passport of ICAO 9303 part 4.
+const (
+	specimenLine1 = "P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<"
+	specimenLine2 = "L898902C36UTO7408122F1204159ZE184226B<<<<<10"
+)
+
+func TestParseMRZ(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	doc, err := ParseMRZ(specimenLine1+"\n"+specimenLine2, now)
+	if err != nil {
+		t.Fatalf("parse specimen: %v", err)
+	}
+
+	tests := []struct {
+		field string
+		got   string
+		want  string
+	}{
+		{"number", doc.Number, "L898902C3"},
+		{"issuing country", doc.IssuingCountry, "UTO"},
+		{"nationality", doc.Nationality, "UTO"},
+		{"surname", doc.Surname, "ERIKSSON"},
+		{"given names", doc.GivenNames, "ANNA MARIA"},
+		{"sex", doc.Sex, "F"},
+		{"date of birth", doc.DateOfBirth.Format(time.DateOnly), "1974-08-12"},
+		{"expiry date", doc.ExpiryDate.Format(time.DateOnly), "2012-04-15"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("expected %s %q, got %q", tt.field, tt.want, tt.got)
+		}
+	}
+}
+
+func TestParseMRZInvalid(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	replace := func(line string, i int, c string) string {
+		return line[:i] + c + line[i+1:]
+	}
+	// recomposite fixes the composite check digit of line 2 so that the
+	// check digit of a single field is the only mismatch.
+	recomposite := func(line string) string {
+		return replace(line, 43, string(checkDigit(line[0:10]+line[13:20]+line[21:43])))
+	}
+
+	tests := []struct {
+		name string
+		mrz  string
+		want string
+	}{
+		{"concatenated", specimenLine1 + specimenLine2, ""},
+		{"lowercase", strings.ToLower(specimenLine1 + "\n" + specimenLine2), ""},
+		{"one line", specimenLine1, "two lines"},
+		{"short line", specimenLine1 + "\n" + specimenLine2[:43], "two lines"},
+		{"invalid character", replace(specimenLine1, 10, "-") + "\n" + specimenLine2, "invalid character"},
+		{"not a passport", replace(specimenLine1, 0, "V") + "\n" + specimenLine2, "not a passport"},
+		{"document number", specimenLine1 + "\n" + replace(specimenLine2, 9, "7"), "document number check digit"},
+		{"date of birth", specimenLine1 + "\n" + replace(specimenLine2, 19, "3"), "date of birth check digit"},
+		{"expiry date", specimenLine1 + "\n" + replace(specimenLine2, 27, "0"), "expiry date check digit"},
+		{"personal number", specimenLine1 + "\n" + recomposite(replace(specimenLine2, 42, "2")), "personal number check digit"},
+		{"composite", specimenLine1 + "\n" + replace(specimenLine2, 43, "1"), "composite check digit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseMRZ(tt.mrz, now)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("expected the mrz to parse, got %v", err)
+				}
+				return
+			}
+			if apperror.CodeOf(err) != apperror.CodeValidation || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected a VALIDATION error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	tests := []struct {
+		value string
+		want  byte
+	}{
+		{"L898902C3", '6'},
+		{"740812", '2'},
+		{"120415", '9'},
+		{"<<<<<<", '0'},
+		{"", '0'},
+	}
+	for _, tt := range tests {
+		if got := checkDigit(tt.value); got != tt.want {
+			t.Errorf("checkDigit(%q): expected %c, got %c", tt.value, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package document
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+const (
+	senderID   = "REDAIRLINES"
+	receiverID = "APIS"
+	// carrierCode matches the carrier printed on boarding passes.
+	carrierCode = "RA"
+)
+
+// Passenger is a manifest line: a booking and its decrypted document.
+type Passenger struct {
+	Booking  *model.Booking
+	Document *Document
+}
+
+// PAXLST renders an UN/EDIFACT PAXLST (D:05B) passenger manifest, the format
+// border agencies accept for Advance Passenger Information.
+func PAXLST(flight *model.Flight, passengers []*Passenger, now time.Time) []byte {
+	w := &edifactWriter{}
+	reference := now.UTC().Format("0601021504")
+	prepared := now.UTC().Format("060102:1504")
+
+	w.raw("UNA:+.? '")
+	w.segment("UNB", "UNOA:4", senderID, receiverID, prepared, reference)
+	w.segment("UNG", "PAXLST", senderID, receiverID, prepared, reference, "UN", "D:05B")
+
+	w.count = 0
+	w.segment("UNH", "1", "PAXLST:D:05B:UN:IATA", flight.FlightNumber+flight.DepartureTime.Format("060102"), "01:F")
+	w.segment("BGM", "745")
+	w.segment("NAD", "MS", "", "", "RED AIRLINES")
+	w.segment("TDT", "20", flight.FlightNumber, "", "", carrierCode)
+	w.segment("LOC", "125", flight.Origin)
+	w.segment("DTM", "189:"+flight.EstimatedDeparture().Format("0601021504")+":201")
+	w.segment("LOC", "87", flight.Destination)
+	w.segment("DTM", "232:"+flight.ArrivalTime.Format("0601021504")+":201")
+
+	for _, p := range passengers {
+		doc := p.Document
+		w.segment("NAD", "FL", "", "", escape(doc.Surname)+":"+escape(doc.GivenNames))
+		if doc.Sex != "" {
+			w.segment("ATT", "2", "", doc.Sex)
+		}
+		w.segment("DTM", "329:"+doc.DateOfBirth.Format("060102"))
+		w.segment("LOC", "178", flight.Origin)
+		w.segment("LOC", "179", flight.Destination)
+		w.segment("NAT", "2", doc.Nationality)
+		w.segment("RFF", "AVF:"+escape(p.Booking.BookingReference))
+		if p.Booking.SeatNumber != nil {
+			w.segment("RFF", "SEA:"+escape(*p.Booking.SeatNumber))
+		}
+		w.segment("DOC", documentCode(doc.Type)+":110:111", escape(doc.Number))
+		w.segment("DTM", "36:"+doc.ExpiryDate.Format("060102"))
+		w.segment("LOC", "91", doc.IssuingCountry)
+	}
+
+	w.segment("CNT", fmt.Sprintf("42:%d", len(passengers)))
+	w.segment("UNT", fmt.Sprint(w.count+1), "1")
+	w.segment("UNE", "1", reference)
+	w.segment("UNZ", "1", reference)
+
+	return []byte(w.String())
+}
+
+func documentCode(t model.DocumentType) string {
+	if t == model.DocumentTypeIDCard {
+		return "I"
+	}
+	return "P"
+}
+
+// edifactWriter writes one segment per line and counts the segments of the
+// current message for UNT.
+type edifactWriter struct {
+	strings.Builder
+	count int
+}
+
+func (w *edifactWriter) raw(s string) {
+	w.WriteString(s)
+	w.WriteString("\n")
+}
+
+// segment writes tag and its data elements. Elements must already be
+// escaped when they contain user data.
+func (w *edifactWriter) segment(tag string, elements ...string) {
+	w.count++
+	w.WriteString(tag)
+	for _, element := range elements {
+		w.WriteString("+")
+		w.WriteString(element)
+	}
+	w.WriteString("'\n")
+}
+
+// escape prefixes EDIFACT separators with the release character.
+func escape(value string) string {
+	return strings.NewReplacer("?", "??", "'", "?'", "+", "?+", ":", "?:").Replace(value)
+}
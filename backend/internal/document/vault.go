@@ -0,0 +1,172 @@
+package document
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// KeyEnv holds the base64 encoded 32 byte AES key used to encrypt documents.
+const KeyEnv = "DOCUMENT_ENCRYPTION_KEY"
+
+// Vault stores documents encrypted with AES-256-GCM. The booking ID is bound
+// as additional data so a ciphertext cannot be moved to another booking.
+// A nil Vault rejects every operation with an UNAVAILABLE error.
+type Vault struct {
+	aead cipher.AEAD
+}
+
+func NewVault(key []byte) (*Vault, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("document key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Vault{aead: aead}, nil
+}
+
+// NewVaultFromEnv reads the key from KeyEnv. It returns nil, nil when the
+// variable is not set.
+func NewVaultFromEnv() (*Vault, error) {
+	encoded := os.Getenv(KeyEnv)
+	if encoded == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not valid base64: %w", KeyEnv, err)
+	}
+	return NewVault(key)
+}
+
+func (v *Vault) seal(bookingID string, doc *Document) ([]byte, error) {
+	plaintext, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, v.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return v.aead.Seal(nonce, nonce, plaintext, []byte(bookingID)), nil
+}
+
+// Open decrypts a ciphertext stored for bookingID.
+func (v *Vault) Open(bookingID string, ciphertext []byte) (*Document, error) {
+	if v == nil {
+		return nil, errNotConfigured
+	}
+	size := v.aead.NonceSize()
+	if len(ciphertext) < size {
+		return nil, apperror.Internal(errors.New("ciphertext too short"), "failed to decrypt document")
+	}
+	plaintext, err := v.aead.Open(nil, ciphertext[:size], ciphertext[size:], []byte(bookingID))
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to decrypt document")
+	}
+
+	var doc Document
+	if err := json.Unmarshal(plaintext, &doc); err != nil {
+		return nil, apperror.Internal(err, "failed to decode document")
+	}
+	return &doc, nil
+}
+
+var errNotConfigured = apperror.Unavailable("passenger documents are not configured on this server")
+
+// Save validates doc for the booking's flight and stores it, replacing any
+// previous document of the booking.
+func (v *Vault) Save(ctx context.Context, documents repository.DocumentRepository, booking *model.Booking, flight *model.Flight, doc *Document, now time.Time) error {
+	if v == nil {
+		return errNotConfigured
+	}
+	if err := doc.Validate(flight, now); err != nil {
+		return err
+	}
+	doc.fillNames(booking)
+
+	ciphertext, err := v.seal(booking.ID, doc)
+	if err != nil {
+		return apperror.Internal(err, "failed to encrypt document")
+	}
+	return documents.Save(ctx, booking.ID, ciphertext, now)
+}
+
+// Load returns the document of a booking, or nil when it has none.
+func (v *Vault) Load(ctx context.Context, documents repository.DocumentRepository, bookingID string) (*Document, error) {
+	ciphertext, err := documents.Get(ctx, bookingID)
+	if err != nil || ciphertext == nil {
+		return nil, err
+	}
+	return v.Open(bookingID, ciphertext)
+}
+
+// Exists reports whether a document was captured for the booking.
+func Exists(ctx context.Context, documents repository.DocumentRepository, bookingID string) (bool, error) {
+	ciphertext, err := documents.Get(ctx, bookingID)
+	if err != nil {
+		return false, err
+	}
+	return ciphertext != nil, nil
+}
+
+// IsInternational reports whether flight crosses a border. Airports missing
+// from the airports table are treated as domestic.
+func IsInternational(ctx context.Context, airports repository.AirportRepository, flight *model.Flight) (bool, error) {
+	countries, err := airports.Countries(ctx, flight.Origin, flight.Destination)
+	if err != nil {
+		return false, err
+	}
+	origin, ok := countries[flight.Origin]
+	if !ok {
+		return false, nil
+	}
+	destination, ok := countries[flight.Destination]
+	return ok && origin != destination, nil
+}
+
+// Attach stores doc on the booking identified by reference if authorized
+// accepts the booking.
+func (v *Vault) Attach(ctx context.Context, store repository.Store, bookingReference string, authorized func(*model.Booking) bool, doc *Document, now time.Time) (*model.Booking, error) {
+	var booking *model.Booking
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		var err error
+		booking, err = tx.Bookings().GetByReferenceForUpdate(ctx, bookingReference)
+		if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+			return err
+		}
+		if err != nil || !authorized(booking) {
+			return apperror.NotFound("booking %s not found", bookingReference)
+		}
+		if !booking.BookingStatus.HoldsSeat() {
+			return apperror.Conflict("booking is %s and cannot be changed", booking.BookingStatus)
+		}
+
+		flight, err := tx.Flights().Get(ctx, booking.FlightID)
+		if err != nil {
+			return err
+		}
+		return v.Save(ctx, tx.Documents(), booking, flight, doc, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
@@ -0,0 +1,88 @@
+package dataloader
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+func newLoader[K comparable, V any](batch dataloader.BatchFunc[K, V]) *dataloader.Loader[K, V] {
+	return dataloader.NewBatchedLoader(batch, dataloader.WithWait[K, V](batchWindow))
+}
+
+// fetchFunc returns the records of keys, in any order.
+type fetchFunc[K comparable, V any] func(ctx context.Context, keys []K) ([]*V, error)
+
+// byID loads one record per key. fetch returns the records of the keys, and
+// key returns the key a record answers. Keys without a record resolve to nil.
+func byID[K comparable, V any](fetch fetchFunc[K, V], key func(*V) K) dataloader.BatchFunc[K, *V] {
+	return func(ctx context.Context, keys []K) []*dataloader.Result[*V] {
+		records, err := fetch(ctx, keys)
+		if err != nil {
+			return failAll[K, *V](keys, err)
+		}
+
+		byKey := make(map[K]*V, len(records))
+		for _, record := range records {
+			byKey[key(record)] = record
+		}
+
+		results := make([]*dataloader.Result[*V], len(keys))
+		for i, k := range keys {
+			results[i] = &dataloader.Result[*V]{Data: byKey[k]}
+		}
+		return results
+	}
+}
+
+// groupBy loads the records belonging to each key, typically by a foreign
+// key, in the order fetch returns them. Keys without records resolve to an
+// empty slice.
+func groupBy[K comparable, V any](fetch fetchFunc[K, V], key func(*V) K) dataloader.BatchFunc[K, []*V] {
+	return func(ctx context.Context, keys []K) []*dataloader.Result[[]*V] {
+		records, err := fetch(ctx, keys)
+		if err != nil {
+			return failAll[K, []*V](keys, err)
+		}
+
+		byKey := make(map[K][]*V)
+		for _, record := range records {
+			byKey[key(record)] = append(byKey[key(record)], record)
+		}
+
+		results := make([]*dataloader.Result[[]*V], len(keys))
+		for i, k := range keys {
+			group, ok := byKey[k]
+			if !ok {
+				group = []*V{}
+			}
+			results[i] = &dataloader.Result[[]*V]{Data: group}
+		}
+		return results
+	}
+}
+
+// byKey loads a value per key from a fetch that returns them by key. Keys
+// missing from the map resolve to the zero value.
+func byKey[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) dataloader.BatchFunc[K, V] {
+	return func(ctx context.Context, keys []K) []*dataloader.Result[V] {
+		values, err := fetch(ctx, keys)
+		if err != nil {
+			return failAll[K, V](keys, err)
+		}
+
+		results := make([]*dataloader.Result[V], len(keys))
+		for i, k := range keys {
+			results[i] = &dataloader.Result[V]{Data: values[k]}
+		}
+		return results
+	}
+}
+
+func failAll[K comparable, V any](keys []K, err error) []*dataloader.Result[V] {
+	results := make([]*dataloader.Result[V], len(keys))
+	for i := range results {
+		results[i] = &dataloader.Result[V]{Error: err}
+	}
+	return results
+}
@@ -0,0 +1,91 @@
+package dataloader
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+// cacheSize bounds the entries kept per kind of record.
+const cacheSize = 10_000
+
+// Cache keeps flights, fares and ancillary products between requests, so
+// popular listings are not read again for every request. Entries expire after
+// a TTL and are purged after every mutation served by this instance; writes
+// made elsewhere (other instances, background jobs) show up once the TTL has
+// passed. Bookings and passenger data are never shared between requests.
+type Cache struct {
+	flights  *expirable.LRU[string, model.Flight]
+	fares    *expirable.LRU[string, model.Fare]
+	products *expirable.LRU[string, model.AncillaryProduct]
+}
+
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		flights:  expirable.NewLRU[string, model.Flight](cacheSize, nil, ttl),
+		fares:    expirable.NewLRU[string, model.Fare](cacheSize, nil, ttl),
+		products: expirable.NewLRU[string, model.AncillaryProduct](cacheSize, nil, ttl),
+	}
+}
+
+// CacheFromEnv returns a Cache with the TTL of DATALOADER_CACHE_TTL (a Go
+// duration such as 30s), or nil when it is unset or zero.
+func CacheFromEnv() (*Cache, error) {
+	value := os.Getenv("DATALOADER_CACHE_TTL")
+	if value == "" {
+		return nil, nil
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil || ttl < 0 {
+		return nil, fmt.Errorf("DATALOADER_CACHE_TTL must be a non-negative duration")
+	}
+	if ttl == 0 {
+		return nil, nil
+	}
+	return NewCache(ttl), nil
+}
+
+// Purge drops every entry.
+func (c *Cache) Purge() {
+	c.flights.Purge()
+	c.fares.Purge()
+	c.products.Purge()
+}
+
+// cached serves the keys found in cache and only batches the others. Records
+// are stored by value and every request gets its own copy. Errors and missing
+// records are not cached.
+func cached[V any](cache *expirable.LRU[string, V], batch dataloader.BatchFunc[string, *V]) dataloader.BatchFunc[string, *V] {
+	return func(ctx context.Context, keys []string) []*dataloader.Result[*V] {
+		results := make([]*dataloader.Result[*V], len(keys))
+
+		var missing []string
+		var missingAt []int
+		for i, key := range keys {
+			if value, ok := cache.Get(key); ok {
+				results[i] = &dataloader.Result[*V]{Data: &value}
+				continue
+			}
+			missing = append(missing, key)
+			missingAt = append(missingAt, i)
+		}
+		if len(missing) == 0 {
+			return results
+		}
+
+		for j, result := range batch(ctx, missing) {
+			if result.Error == nil && result.Data != nil {
+				cache.Add(missing[j], *result.Data)
+			}
+			results[missingAt[j]] = result
+		}
+
+		return results
+	}
+}
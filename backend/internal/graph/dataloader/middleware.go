@@ -2,16 +2,44 @@ package dataloader
 
 import (
 	"context"
-	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 type loadersKey struct{}
 
-func Middleware(loaders *Loaders, next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := context.WithValue(r.Context(), loadersKey{}, loaders)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+// Extension gives every response fresh Loaders: one per query or mutation,
+// and one per event of a subscription, so nothing is cached for the lifetime
+// of a WebSocket connection. Queries and subscriptions read through Cache
+// when it is set; mutations bypass it, so they see their own writes, and purge
+// it once they are done.
+type Extension struct {
+	Store repository.Store
+	Cache *Cache
+}
+
+var (
+	_ graphql.HandlerExtension    = Extension{}
+	_ graphql.ResponseInterceptor = Extension{}
+)
+
+func (Extension) ExtensionName() string {
+	return "Dataloader"
+}
+
+func (Extension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if e.Cache == nil || graphql.GetOperationContext(ctx).Operation.Operation != ast.Mutation {
+		return next(context.WithValue(ctx, loadersKey{}, NewLoaders(e.Store, e.Cache)))
+	}
+
+	defer e.Cache.Purge()
+	return next(context.WithValue(ctx, loadersKey{}, NewLoaders(e.Store, nil)))
 }
 
 func FromContext(ctx context.Context) *Loaders {
@@ -14,10 +14,11 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/davidalecrim/red-airlines/internal/auth"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/manifest"
 	gqlparser "github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
-
-	"github.com/davidalecrim/red-airlines/internal/graph/model"
 )
 
 // region    ************************** generated!.gotpl **************************
@@ -41,30 +42,110 @@ type Config struct {
 
 type ResolverRoot interface {
 	Booking() BookingResolver
+	BookingAncillary() BookingAncillaryResolver
 	Fare() FareResolver
 	Flight() FlightResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Rebooking() RebookingResolver
+	Subscription() SubscriptionResolver
+	User() UserResolver
+	WaitlistEntry() WaitlistEntryResolver
 }
 
 type DirectiveRoot struct {
+	HasRole func(ctx context.Context, obj any, next graphql.Resolver, role model.Role) (res any, err error)
 }
 
 type ComplexityRoot struct {
+	AcceptWaitlistOfferPayload struct {
+		Booking   func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		Token     func(childComplexity int) int
+	}
+
+	AncillaryOffer struct {
+		Price     func(childComplexity int) int
+		Product   func(childComplexity int) int
+		Remaining func(childComplexity int) int
+	}
+
+	AncillaryProduct struct {
+		AllowedFareClasses func(childComplexity int) int
+		BasePrice          func(childComplexity int) int
+		Category           func(childComplexity int) int
+		Code               func(childComplexity int) int
+		Description        func(childComplexity int) int
+		ID                 func(childComplexity int) int
+		MaxPerBooking      func(childComplexity int) int
+		Name               func(childComplexity int) int
+	}
+
+	AuthPayload struct {
+		AccessToken          func(childComplexity int) int
+		AccessTokenExpiresAt func(childComplexity int) int
+		RefreshToken         func(childComplexity int) int
+		User                 func(childComplexity int) int
+	}
+
+	BoardingPass struct {
+		Barcode        func(childComplexity int) int
+		Booking        func(childComplexity int) int
+		DownloadURL    func(childComplexity int) int
+		Flight         func(childComplexity int) int
+		SeatNumber     func(childComplexity int) int
+		SequenceNumber func(childComplexity int) int
+	}
+
 	Booking struct {
-		BookedAt         func(childComplexity int) int
-		BookingReference func(childComplexity int) int
-		BookingStatus    func(childComplexity int) int
-		Fare             func(childComplexity int) int
-		FareID           func(childComplexity int) int
-		Flight           func(childComplexity int) int
-		FlightID         func(childComplexity int) int
-		ID               func(childComplexity int) int
-		PassengerEmail   func(childComplexity int) int
-		PassengerName    func(childComplexity int) int
-		PassengerPhone   func(childComplexity int) int
-		SeatNumber       func(childComplexity int) int
-		TotalPrice       func(childComplexity int) int
+		Ancillaries             func(childComplexity int) int
+		BookedAt                func(childComplexity int) int
+		BookingReference        func(childComplexity int) int
+		BookingStatus           func(childComplexity int) int
+		CheckedInAt             func(childComplexity int) int
+		DeniedBoardingVolunteer func(childComplexity int) int
+		Document                func(childComplexity int) int
+		Fare                    func(childComplexity int) int
+		FareID                  func(childComplexity int) int
+		Flight                  func(childComplexity int) int
+		FlightID                func(childComplexity int) int
+		ID                      func(childComplexity int) int
+		PassengerEmail          func(childComplexity int) int
+		PassengerName           func(childComplexity int) int
+		PassengerPhone          func(childComplexity int) int
+		SeatNumber              func(childComplexity int) int
+		SpecialRequests         func(childComplexity int) int
+		TotalPrice              func(childComplexity int) int
+	}
+
+	BookingAncillary struct {
+		CreatedAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Product    func(childComplexity int) int
+		Quantity   func(childComplexity int) int
+		TotalPrice func(childComplexity int) int
+		UnitPrice  func(childComplexity int) int
+	}
+
+	CreateBookingPayload struct {
+		Booking   func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		Token     func(childComplexity int) int
+	}
+
+	DeniedBoardingList struct {
+		Candidates    func(childComplexity int) int
+		Flight        func(childComplexity int) int
+		OversoldSeats func(childComplexity int) int
+		Volunteers    func(childComplexity int) int
+	}
+
+	DisruptionReport struct {
+		Flight              func(childComplexity int) int
+		RebookedCount       func(childComplexity int) int
+		Rebookings          func(childComplexity int) int
+		TotalAffected       func(childComplexity int) int
+		UnaccommodatedCount func(childComplexity int) int
 	}
 
 	Fare struct {
@@ -77,59 +158,228 @@ type ComplexityRoot struct {
 		ID               func(childComplexity int) int
 		IsChangeable     func(childComplexity int) int
 		IsRefundable     func(childComplexity int) int
+		OverbookingLimit func(childComplexity int) int
+		OversoldSeats    func(childComplexity int) int
 		Price            func(childComplexity int) int
+		WaitlistCount    func(childComplexity int) int
 	}
 
 	Flight struct {
-		AircraftType   func(childComplexity int) int
-		ArrivalTime    func(childComplexity int) int
-		AvailableSeats func(childComplexity int) int
-		Bookings       func(childComplexity int) int
-		DepartureTime  func(childComplexity int) int
-		Destination    func(childComplexity int) int
-		Fares          func(childComplexity int) int
-		FlightNumber   func(childComplexity int) int
+		AircraftType     func(childComplexity int) int
+		ArrivalTime      func(childComplexity int) int
+		AvailableSeats   func(childComplexity int) int
+		BookedSeats      func(childComplexity int) int
+		Bookings         func(childComplexity int) int
+		DelayMinutes     func(childComplexity int) int
+		DepartureTime    func(childComplexity int) int
+		Destination      func(childComplexity int) int
+		Fares            func(childComplexity int) int
+		FlightNumber     func(childComplexity int) int
+		Gate             func(childComplexity int) int
+		ID               func(childComplexity int) int
+		Origin           func(childComplexity int) int
+		OverbookingLimit func(childComplexity int) int
+		OversoldSeats    func(childComplexity int) int
+		Status           func(childComplexity int) int
+		StatusHistory    func(childComplexity int) int
+		TotalSeats       func(childComplexity int) int
+	}
+
+	FlightEvent struct {
+		Actor          func(childComplexity int) int
+		DelayMinutes   func(childComplexity int) int
+		EventType      func(childComplexity int) int
+		FlightID       func(childComplexity int) int
+		Gate           func(childComplexity int) int
 		ID             func(childComplexity int) int
-		Origin         func(childComplexity int) int
-		Status         func(childComplexity int) int
-		TotalSeats     func(childComplexity int) int
+		NewStatus      func(childComplexity int) int
+		OccurredAt     func(childComplexity int) int
+		PreviousStatus func(childComplexity int) int
+		Reason         func(childComplexity int) int
+	}
+
+	FlightManifest struct {
+		DownloadURL func(childComplexity int) int
+		Entries     func(childComplexity int) int
+		Flight      func(childComplexity int) int
+	}
+
+	ManagedBooking struct {
+		Booking   func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		Token     func(childComplexity int) int
+	}
+
+	ManifestEntry struct {
+		Booking          func(childComplexity int) int
+		BookingReference func(childComplexity int) int
+		BookingStatus    func(childComplexity int) int
+		FareClass        func(childComplexity int) int
+		PassengerName    func(childComplexity int) int
+		SeatNumber       func(childComplexity int) int
+		SpecialRequests  func(childComplexity int) int
 	}
 
 	Mutation struct {
-		CreateBooking func(childComplexity int, input CreateBookingInput) int
+		AcceptWaitlistOffer        func(childComplexity int, entryID string, passengerEmail string) int
+		AddAncillary               func(childComplexity int, bookingReference string, productID string, quantity int) int
+		AddPassengerDocument       func(childComplexity int, bookingReference string, document PassengerDocumentInput) int
+		AssignGate                 func(childComplexity int, input AssignGateInput) int
+		CancelBooking              func(childComplexity int, bookingReference string) int
+		CancelBookingOnBehalf      func(childComplexity int, bookingReference string) int
+		CheckIn                    func(childComplexity int, bookingReference string, document *PassengerDocumentInput) int
+		CreateBooking              func(childComplexity int, input CreateBookingInput) int
+		DelayFlight                func(childComplexity int, input DelayFlightInput) int
+		JoinWaitlist               func(childComplexity int, fareID string, passenger PassengerInput) int
+		Login                      func(childComplexity int, email string, password string) int
+		Logout                     func(childComplexity int, refreshToken string) int
+		ManageBooking              func(childComplexity int, bookingReference string, lastName string) int
+		RefreshToken               func(childComplexity int, refreshToken string) int
+		SelectSeat                 func(childComplexity int, bookingReference string, seatNumber string) int
+		SetOverbookingLimit        func(childComplexity int, input SetOverbookingLimitInput) int
+		Signup                     func(childComplexity int, input SignupInput) int
+		UpdateFlightStatus         func(childComplexity int, input UpdateFlightStatusInput) int
+		VolunteerForDeniedBoarding func(childComplexity int, bookingReference string, volunteer bool) int
+	}
+
+	PassengerDocument struct {
+		DocumentType   func(childComplexity int) int
+		ExpiryDate     func(childComplexity int) int
+		IssuingCountry func(childComplexity int) int
+		MaskedNumber   func(childComplexity int) int
+		Nationality    func(childComplexity int) int
 	}
 
 	Query struct {
-		Airports func(childComplexity int) int
-		Booking  func(childComplexity int, bookingReference string) int
-		Bookings func(childComplexity int, passengerEmail *string, limit *int) int
-		Flight   func(childComplexity int, id string) int
-		Flights  func(childComplexity int, origin *string, destination *string, limit *int) int
+		Airports           func(childComplexity int) int
+		AncillaryOffers    func(childComplexity int, flightID string) int
+		Booking            func(childComplexity int, bookingReference string) int
+		Bookings           func(childComplexity int, passengerEmail *string, limit *int) int
+		DeniedBoardingList func(childComplexity int, flightID string) int
+		DisruptionReport   func(childComplexity int, flightID string) int
+		Flight             func(childComplexity int, id string) int
+		FlightManifest     func(childComplexity int, flightID string) int
+		Flights            func(childComplexity int, origin *string, destination *string, limit *int) int
+		Me                 func(childComplexity int) int
+	}
+
+	Rebooking struct {
+		Booking        func(childComplexity int) int
+		ID             func(childComplexity int) int
+		NewFare        func(childComplexity int) int
+		NewFlight      func(childComplexity int) int
+		OriginalFare   func(childComplexity int) int
+		OriginalFlight func(childComplexity int) int
+		Outcome        func(childComplexity int) int
+		ProcessedAt    func(childComplexity int) int
+	}
+
+	Subscription struct {
+		BookingUpdated      func(childComplexity int, bookingReference string) int
+		FlightStatusChanged func(childComplexity int, flightID string) int
+	}
+
+	User struct {
+		Bookings  func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Email     func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Roles     func(childComplexity int) int
+	}
+
+	WaitlistEntry struct {
+		Booking        func(childComplexity int) int
+		CreatedAt      func(childComplexity int) int
+		Fare           func(childComplexity int) int
+		ID             func(childComplexity int) int
+		OfferExpiresAt func(childComplexity int) int
+		PassengerEmail func(childComplexity int) int
+		PassengerName  func(childComplexity int) int
+		PassengerPhone func(childComplexity int) int
+		Position       func(childComplexity int) int
+		Status         func(childComplexity int) int
 	}
 }
 
 type BookingResolver interface {
+	Ancillaries(ctx context.Context, obj *model.Booking) ([]*model.BookingAncillary, error)
+	Document(ctx context.Context, obj *model.Booking) (*PassengerDocument, error)
 	Flight(ctx context.Context, obj *model.Booking) (*model.Flight, error)
 	Fare(ctx context.Context, obj *model.Booking) (*model.Fare, error)
 }
+type BookingAncillaryResolver interface {
+	Product(ctx context.Context, obj *model.BookingAncillary) (*model.AncillaryProduct, error)
+}
 type FareResolver interface {
+	AvailableSeats(ctx context.Context, obj *model.Fare) (int, error)
+	OverbookingLimit(ctx context.Context, obj *model.Fare) (int, error)
+
+	WaitlistCount(ctx context.Context, obj *model.Fare) (int, error)
 	Flight(ctx context.Context, obj *model.Fare) (*model.Flight, error)
 	Bookings(ctx context.Context, obj *model.Fare) ([]*model.Booking, error)
 }
 type FlightResolver interface {
+	BookedSeats(ctx context.Context, obj *model.Flight) (int, error)
+	OversoldSeats(ctx context.Context, obj *model.Flight) (*int, error)
+	OverbookingLimit(ctx context.Context, obj *model.Flight) (*int, error)
+	StatusHistory(ctx context.Context, obj *model.Flight) ([]*model.FlightEvent, error)
 	Fares(ctx context.Context, obj *model.Flight) ([]*model.Fare, error)
 	Bookings(ctx context.Context, obj *model.Flight) ([]*model.Booking, error)
 }
 type MutationResolver interface {
-	CreateBooking(ctx context.Context, input CreateBookingInput) (*model.Booking, error)
+	Signup(ctx context.Context, input SignupInput) (*auth.Session, error)
+	Login(ctx context.Context, email string, password string) (*auth.Session, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*auth.Session, error)
+	Logout(ctx context.Context, refreshToken string) (bool, error)
+	ManageBooking(ctx context.Context, bookingReference string, lastName string) (*auth.BookingSession, error)
+	CreateBooking(ctx context.Context, input CreateBookingInput) (*CreateBookingPayload, error)
+	CancelBooking(ctx context.Context, bookingReference string) (*model.Booking, error)
+	CancelBookingOnBehalf(ctx context.Context, bookingReference string) (*model.Booking, error)
+	JoinWaitlist(ctx context.Context, fareID string, passenger PassengerInput) (*model.WaitlistEntry, error)
+	AcceptWaitlistOffer(ctx context.Context, entryID string, passengerEmail string) (*AcceptWaitlistOfferPayload, error)
+	UpdateFlightStatus(ctx context.Context, input UpdateFlightStatusInput) (*model.Flight, error)
+	DelayFlight(ctx context.Context, input DelayFlightInput) (*model.Flight, error)
+	AssignGate(ctx context.Context, input AssignGateInput) (*model.Flight, error)
+	CheckIn(ctx context.Context, bookingReference string, document *PassengerDocumentInput) (*BoardingPass, error)
+	AddPassengerDocument(ctx context.Context, bookingReference string, document PassengerDocumentInput) (*model.Booking, error)
+	SetOverbookingLimit(ctx context.Context, input SetOverbookingLimitInput) (*model.Flight, error)
+	AddAncillary(ctx context.Context, bookingReference string, productID string, quantity int) (*model.Booking, error)
+	SelectSeat(ctx context.Context, bookingReference string, seatNumber string) (*model.Booking, error)
+	VolunteerForDeniedBoarding(ctx context.Context, bookingReference string, volunteer bool) (*model.Booking, error)
 }
-
 type QueryResolver interface {
 	Flights(ctx context.Context, origin *string, destination *string, limit *int) ([]*model.Flight, error)
 	Flight(ctx context.Context, id string) (*model.Flight, error)
+	Me(ctx context.Context) (*model.User, error)
 	Booking(ctx context.Context, bookingReference string) (*model.Booking, error)
 	Bookings(ctx context.Context, passengerEmail *string, limit *int) ([]*model.Booking, error)
 	Airports(ctx context.Context) ([]string, error)
+	DisruptionReport(ctx context.Context, flightID string) (*DisruptionReport, error)
+	DeniedBoardingList(ctx context.Context, flightID string) (*DeniedBoardingList, error)
+	AncillaryOffers(ctx context.Context, flightID string) ([]*AncillaryOffer, error)
+	FlightManifest(ctx context.Context, flightID string) (*FlightManifest, error)
+}
+type RebookingResolver interface {
+	Booking(ctx context.Context, obj *model.Rebooking) (*model.Booking, error)
+	OriginalFlight(ctx context.Context, obj *model.Rebooking) (*model.Flight, error)
+	OriginalFare(ctx context.Context, obj *model.Rebooking) (*model.Fare, error)
+	NewFlight(ctx context.Context, obj *model.Rebooking) (*model.Flight, error)
+	NewFare(ctx context.Context, obj *model.Rebooking) (*model.Fare, error)
+}
+type SubscriptionResolver interface {
+	FlightStatusChanged(ctx context.Context, flightID string) (<-chan *model.Flight, error)
+	BookingUpdated(ctx context.Context, bookingReference string) (<-chan *model.Booking, error)
+}
+type UserResolver interface {
+	Bookings(ctx context.Context, obj *model.User) ([]*model.Booking, error)
+}
+type WaitlistEntryResolver interface {
+	Fare(ctx context.Context, obj *model.WaitlistEntry) (*model.Fare, error)
+
+	Position(ctx context.Context, obj *model.WaitlistEntry) (*int, error)
+
+	Booking(ctx context.Context, obj *model.WaitlistEntry) (*model.Booking, error)
 }
 
 type executableSchema struct {
@@ -151,6 +401,161 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AcceptWaitlistOfferPayload.booking":
+		if e.complexity.AcceptWaitlistOfferPayload.Booking == nil {
+			break
+		}
+
+		return e.complexity.AcceptWaitlistOfferPayload.Booking(childComplexity), true
+	case "AcceptWaitlistOfferPayload.expiresAt":
+		if e.complexity.AcceptWaitlistOfferPayload.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.AcceptWaitlistOfferPayload.ExpiresAt(childComplexity), true
+	case "AcceptWaitlistOfferPayload.token":
+		if e.complexity.AcceptWaitlistOfferPayload.Token == nil {
+			break
+		}
+
+		return e.complexity.AcceptWaitlistOfferPayload.Token(childComplexity), true
+
+	case "AncillaryOffer.price":
+		if e.complexity.AncillaryOffer.Price == nil {
+			break
+		}
+
+		return e.complexity.AncillaryOffer.Price(childComplexity), true
+	case "AncillaryOffer.product":
+		if e.complexity.AncillaryOffer.Product == nil {
+			break
+		}
+
+		return e.complexity.AncillaryOffer.Product(childComplexity), true
+	case "AncillaryOffer.remaining":
+		if e.complexity.AncillaryOffer.Remaining == nil {
+			break
+		}
+
+		return e.complexity.AncillaryOffer.Remaining(childComplexity), true
+
+	case "AncillaryProduct.allowedFareClasses":
+		if e.complexity.AncillaryProduct.AllowedFareClasses == nil {
+			break
+		}
+
+		return e.complexity.AncillaryProduct.AllowedFareClasses(childComplexity), true
+	case "AncillaryProduct.basePrice":
+		if e.complexity.AncillaryProduct.BasePrice == nil {
+			break
+		}
+
+		return e.complexity.AncillaryProduct.BasePrice(childComplexity), true
+	case "AncillaryProduct.category":
+		if e.complexity.AncillaryProduct.Category == nil {
+			break
+		}
+
+		return e.complexity.AncillaryProduct.Category(childComplexity), true
+	case "AncillaryProduct.code":
+		if e.complexity.AncillaryProduct.Code == nil {
+			break
+		}
+
+		return e.complexity.AncillaryProduct.Code(childComplexity), true
+	case "AncillaryProduct.description":
+		if e.complexity.AncillaryProduct.Description == nil {
+			break
+		}
+
+		return e.complexity.AncillaryProduct.Description(childComplexity), true
+	case "AncillaryProduct.id":
+		if e.complexity.AncillaryProduct.ID == nil {
+			break
+		}
+
+		return e.complexity.AncillaryProduct.ID(childComplexity), true
+	case "AncillaryProduct.maxPerBooking":
+		if e.complexity.AncillaryProduct.MaxPerBooking == nil {
+			break
+		}
+
+		return e.complexity.AncillaryProduct.MaxPerBooking(childComplexity), true
+	case "AncillaryProduct.name":
+		if e.complexity.AncillaryProduct.Name == nil {
+			break
+		}
+
+		return e.complexity.AncillaryProduct.Name(childComplexity), true
+
+	case "AuthPayload.accessToken":
+		if e.complexity.AuthPayload.AccessToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.AccessToken(childComplexity), true
+	case "AuthPayload.accessTokenExpiresAt":
+		if e.complexity.AuthPayload.AccessTokenExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.AccessTokenExpiresAt(childComplexity), true
+	case "AuthPayload.refreshToken":
+		if e.complexity.AuthPayload.RefreshToken == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.RefreshToken(childComplexity), true
+	case "AuthPayload.user":
+		if e.complexity.AuthPayload.User == nil {
+			break
+		}
+
+		return e.complexity.AuthPayload.User(childComplexity), true
+
+	case "BoardingPass.barcode":
+		if e.complexity.BoardingPass.Barcode == nil {
+			break
+		}
+
+		return e.complexity.BoardingPass.Barcode(childComplexity), true
+	case "BoardingPass.booking":
+		if e.complexity.BoardingPass.Booking == nil {
+			break
+		}
+
+		return e.complexity.BoardingPass.Booking(childComplexity), true
+	case "BoardingPass.downloadUrl":
+		if e.complexity.BoardingPass.DownloadURL == nil {
+			break
+		}
+
+		return e.complexity.BoardingPass.DownloadURL(childComplexity), true
+	case "BoardingPass.flight":
+		if e.complexity.BoardingPass.Flight == nil {
+			break
+		}
+
+		return e.complexity.BoardingPass.Flight(childComplexity), true
+	case "BoardingPass.seatNumber":
+		if e.complexity.BoardingPass.SeatNumber == nil {
+			break
+		}
+
+		return e.complexity.BoardingPass.SeatNumber(childComplexity), true
+	case "BoardingPass.sequenceNumber":
+		if e.complexity.BoardingPass.SequenceNumber == nil {
+			break
+		}
+
+		return e.complexity.BoardingPass.SequenceNumber(childComplexity), true
+
+	case "Booking.ancillaries":
+		if e.complexity.Booking.Ancillaries == nil {
+			break
+		}
+
+		return e.complexity.Booking.Ancillaries(childComplexity), true
 	case "Booking.bookedAt":
 		if e.complexity.Booking.BookedAt == nil {
 			break
@@ -169,6 +574,24 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Booking.BookingStatus(childComplexity), true
+	case "Booking.checkedInAt":
+		if e.complexity.Booking.CheckedInAt == nil {
+			break
+		}
+
+		return e.complexity.Booking.CheckedInAt(childComplexity), true
+	case "Booking.deniedBoardingVolunteer":
+		if e.complexity.Booking.DeniedBoardingVolunteer == nil {
+			break
+		}
+
+		return e.complexity.Booking.DeniedBoardingVolunteer(childComplexity), true
+	case "Booking.document":
+		if e.complexity.Booking.Document == nil {
+			break
+		}
+
+		return e.complexity.Booking.Document(childComplexity), true
 	case "Booking.fare":
 		if e.complexity.Booking.Fare == nil {
 			break
@@ -223,6 +646,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Booking.SeatNumber(childComplexity), true
+	case "Booking.specialRequests":
+		if e.complexity.Booking.SpecialRequests == nil {
+			break
+		}
+
+		return e.complexity.Booking.SpecialRequests(childComplexity), true
 	case "Booking.totalPrice":
 		if e.complexity.Booking.TotalPrice == nil {
 			break
@@ -230,6 +659,118 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Booking.TotalPrice(childComplexity), true
 
+	case "BookingAncillary.createdAt":
+		if e.complexity.BookingAncillary.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.BookingAncillary.CreatedAt(childComplexity), true
+	case "BookingAncillary.id":
+		if e.complexity.BookingAncillary.ID == nil {
+			break
+		}
+
+		return e.complexity.BookingAncillary.ID(childComplexity), true
+	case "BookingAncillary.product":
+		if e.complexity.BookingAncillary.Product == nil {
+			break
+		}
+
+		return e.complexity.BookingAncillary.Product(childComplexity), true
+	case "BookingAncillary.quantity":
+		if e.complexity.BookingAncillary.Quantity == nil {
+			break
+		}
+
+		return e.complexity.BookingAncillary.Quantity(childComplexity), true
+	case "BookingAncillary.totalPrice":
+		if e.complexity.BookingAncillary.TotalPrice == nil {
+			break
+		}
+
+		return e.complexity.BookingAncillary.TotalPrice(childComplexity), true
+	case "BookingAncillary.unitPrice":
+		if e.complexity.BookingAncillary.UnitPrice == nil {
+			break
+		}
+
+		return e.complexity.BookingAncillary.UnitPrice(childComplexity), true
+
+	case "CreateBookingPayload.booking":
+		if e.complexity.CreateBookingPayload.Booking == nil {
+			break
+		}
+
+		return e.complexity.CreateBookingPayload.Booking(childComplexity), true
+	case "CreateBookingPayload.expiresAt":
+		if e.complexity.CreateBookingPayload.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.CreateBookingPayload.ExpiresAt(childComplexity), true
+	case "CreateBookingPayload.token":
+		if e.complexity.CreateBookingPayload.Token == nil {
+			break
+		}
+
+		return e.complexity.CreateBookingPayload.Token(childComplexity), true
+
+	case "DeniedBoardingList.candidates":
+		if e.complexity.DeniedBoardingList.Candidates == nil {
+			break
+		}
+
+		return e.complexity.DeniedBoardingList.Candidates(childComplexity), true
+	case "DeniedBoardingList.flight":
+		if e.complexity.DeniedBoardingList.Flight == nil {
+			break
+		}
+
+		return e.complexity.DeniedBoardingList.Flight(childComplexity), true
+	case "DeniedBoardingList.oversoldSeats":
+		if e.complexity.DeniedBoardingList.OversoldSeats == nil {
+			break
+		}
+
+		return e.complexity.DeniedBoardingList.OversoldSeats(childComplexity), true
+	case "DeniedBoardingList.volunteers":
+		if e.complexity.DeniedBoardingList.Volunteers == nil {
+			break
+		}
+
+		return e.complexity.DeniedBoardingList.Volunteers(childComplexity), true
+
+	case "DisruptionReport.flight":
+		if e.complexity.DisruptionReport.Flight == nil {
+			break
+		}
+
+		return e.complexity.DisruptionReport.Flight(childComplexity), true
+	case "DisruptionReport.rebookedCount":
+		if e.complexity.DisruptionReport.RebookedCount == nil {
+			break
+		}
+
+		return e.complexity.DisruptionReport.RebookedCount(childComplexity), true
+	case "DisruptionReport.rebookings":
+		if e.complexity.DisruptionReport.Rebookings == nil {
+			break
+		}
+
+		return e.complexity.DisruptionReport.Rebookings(childComplexity), true
+	case "DisruptionReport.totalAffected":
+		if e.complexity.DisruptionReport.TotalAffected == nil {
+			break
+		}
+
+		return e.complexity.DisruptionReport.TotalAffected(childComplexity), true
+	case "DisruptionReport.unaccommodatedCount":
+		if e.complexity.DisruptionReport.UnaccommodatedCount == nil {
+			break
+		}
+
+		return e.complexity.DisruptionReport.UnaccommodatedCount(childComplexity), true
+
 	case "Fare.availableSeats":
 		if e.complexity.Fare.AvailableSeats == nil {
 			break
@@ -284,12 +825,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Fare.IsRefundable(childComplexity), true
+	case "Fare.overbookingLimit":
+		if e.complexity.Fare.OverbookingLimit == nil {
+			break
+		}
+
+		return e.complexity.Fare.OverbookingLimit(childComplexity), true
+	case "Fare.oversoldSeats":
+		if e.complexity.Fare.OversoldSeats == nil {
+			break
+		}
+
+		return e.complexity.Fare.OversoldSeats(childComplexity), true
 	case "Fare.price":
 		if e.complexity.Fare.Price == nil {
 			break
 		}
 
 		return e.complexity.Fare.Price(childComplexity), true
+	case "Fare.waitlistCount":
+		if e.complexity.Fare.WaitlistCount == nil {
+			break
+		}
+
+		return e.complexity.Fare.WaitlistCount(childComplexity), true
 
 	case "Flight.aircraftType":
 		if e.complexity.Flight.AircraftType == nil {
@@ -309,12 +868,24 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Flight.AvailableSeats(childComplexity), true
+	case "Flight.bookedSeats":
+		if e.complexity.Flight.BookedSeats == nil {
+			break
+		}
+
+		return e.complexity.Flight.BookedSeats(childComplexity), true
 	case "Flight.bookings":
 		if e.complexity.Flight.Bookings == nil {
 			break
 		}
 
 		return e.complexity.Flight.Bookings(childComplexity), true
+	case "Flight.delayMinutes":
+		if e.complexity.Flight.DelayMinutes == nil {
+			break
+		}
+
+		return e.complexity.Flight.DelayMinutes(childComplexity), true
 	case "Flight.departureTime":
 		if e.complexity.Flight.DepartureTime == nil {
 			break
@@ -339,6 +910,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Flight.FlightNumber(childComplexity), true
+	case "Flight.gate":
+		if e.complexity.Flight.Gate == nil {
+			break
+		}
+
+		return e.complexity.Flight.Gate(childComplexity), true
 	case "Flight.id":
 		if e.complexity.Flight.ID == nil {
 			break
@@ -351,12 +928,30 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.complexity.Flight.Origin(childComplexity), true
+	case "Flight.overbookingLimit":
+		if e.complexity.Flight.OverbookingLimit == nil {
+			break
+		}
+
+		return e.complexity.Flight.OverbookingLimit(childComplexity), true
+	case "Flight.oversoldSeats":
+		if e.complexity.Flight.OversoldSeats == nil {
+			break
+		}
+
+		return e.complexity.Flight.OversoldSeats(childComplexity), true
 	case "Flight.status":
 		if e.complexity.Flight.Status == nil {
 			break
 		}
 
 		return e.complexity.Flight.Status(childComplexity), true
+	case "Flight.statusHistory":
+		if e.complexity.Flight.StatusHistory == nil {
+			break
+		}
+
+		return e.complexity.Flight.StatusHistory(childComplexity), true
 	case "Flight.totalSeats":
 		if e.complexity.Flight.TotalSeats == nil {
 			break
@@ -364,2234 +959,1754 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Flight.TotalSeats(childComplexity), true
 
-	case "Mutation.createBooking":
-		if e.complexity.Mutation.CreateBooking == nil {
+	case "FlightEvent.actor":
+		if e.complexity.FlightEvent.Actor == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createBooking_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FlightEvent.Actor(childComplexity), true
+	case "FlightEvent.delayMinutes":
+		if e.complexity.FlightEvent.DelayMinutes == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateBooking(childComplexity, args["input"].(CreateBookingInput)), true
+		return e.complexity.FlightEvent.DelayMinutes(childComplexity), true
+	case "FlightEvent.eventType":
+		if e.complexity.FlightEvent.EventType == nil {
+			break
+		}
 
-	case "Query.airports":
-		if e.complexity.Query.Airports == nil {
+		return e.complexity.FlightEvent.EventType(childComplexity), true
+	case "FlightEvent.flightId":
+		if e.complexity.FlightEvent.FlightID == nil {
 			break
 		}
 
-		return e.complexity.Query.Airports(childComplexity), true
-	case "Query.booking":
-		if e.complexity.Query.Booking == nil {
+		return e.complexity.FlightEvent.FlightID(childComplexity), true
+	case "FlightEvent.gate":
+		if e.complexity.FlightEvent.Gate == nil {
 			break
 		}
 
-		args, err := ec.field_Query_booking_args(ctx, rawArgs)
+		return e.complexity.FlightEvent.Gate(childComplexity), true
+	case "FlightEvent.id":
+		if e.complexity.FlightEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.FlightEvent.ID(childComplexity), true
+	case "FlightEvent.newStatus":
+		if e.complexity.FlightEvent.NewStatus == nil {
+			break
+		}
+
+		return e.complexity.FlightEvent.NewStatus(childComplexity), true
+	case "FlightEvent.occurredAt":
+		if e.complexity.FlightEvent.OccurredAt == nil {
+			break
+		}
+
+		return e.complexity.FlightEvent.OccurredAt(childComplexity), true
+	case "FlightEvent.previousStatus":
+		if e.complexity.FlightEvent.PreviousStatus == nil {
+			break
+		}
+
+		return e.complexity.FlightEvent.PreviousStatus(childComplexity), true
+	case "FlightEvent.reason":
+		if e.complexity.FlightEvent.Reason == nil {
+			break
+		}
+
+		return e.complexity.FlightEvent.Reason(childComplexity), true
+
+	case "FlightManifest.downloadUrl":
+		if e.complexity.FlightManifest.DownloadURL == nil {
+			break
+		}
+
+		return e.complexity.FlightManifest.DownloadURL(childComplexity), true
+	case "FlightManifest.entries":
+		if e.complexity.FlightManifest.Entries == nil {
+			break
+		}
+
+		return e.complexity.FlightManifest.Entries(childComplexity), true
+	case "FlightManifest.flight":
+		if e.complexity.FlightManifest.Flight == nil {
+			break
+		}
+
+		return e.complexity.FlightManifest.Flight(childComplexity), true
+
+	case "ManagedBooking.booking":
+		if e.complexity.ManagedBooking.Booking == nil {
+			break
+		}
+
+		return e.complexity.ManagedBooking.Booking(childComplexity), true
+	case "ManagedBooking.expiresAt":
+		if e.complexity.ManagedBooking.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.ManagedBooking.ExpiresAt(childComplexity), true
+	case "ManagedBooking.token":
+		if e.complexity.ManagedBooking.Token == nil {
+			break
+		}
+
+		return e.complexity.ManagedBooking.Token(childComplexity), true
+
+	case "ManifestEntry.booking":
+		if e.complexity.ManifestEntry.Booking == nil {
+			break
+		}
+
+		return e.complexity.ManifestEntry.Booking(childComplexity), true
+	case "ManifestEntry.bookingReference":
+		if e.complexity.ManifestEntry.BookingReference == nil {
+			break
+		}
+
+		return e.complexity.ManifestEntry.BookingReference(childComplexity), true
+	case "ManifestEntry.bookingStatus":
+		if e.complexity.ManifestEntry.BookingStatus == nil {
+			break
+		}
+
+		return e.complexity.ManifestEntry.BookingStatus(childComplexity), true
+	case "ManifestEntry.fareClass":
+		if e.complexity.ManifestEntry.FareClass == nil {
+			break
+		}
+
+		return e.complexity.ManifestEntry.FareClass(childComplexity), true
+	case "ManifestEntry.passengerName":
+		if e.complexity.ManifestEntry.PassengerName == nil {
+			break
+		}
+
+		return e.complexity.ManifestEntry.PassengerName(childComplexity), true
+	case "ManifestEntry.seatNumber":
+		if e.complexity.ManifestEntry.SeatNumber == nil {
+			break
+		}
+
+		return e.complexity.ManifestEntry.SeatNumber(childComplexity), true
+	case "ManifestEntry.specialRequests":
+		if e.complexity.ManifestEntry.SpecialRequests == nil {
+			break
+		}
+
+		return e.complexity.ManifestEntry.SpecialRequests(childComplexity), true
+
+	case "Mutation.acceptWaitlistOffer":
+		if e.complexity.Mutation.AcceptWaitlistOffer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_acceptWaitlistOffer_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Booking(childComplexity, args["bookingReference"].(string)), true
-	case "Query.bookings":
-		if e.complexity.Query.Bookings == nil {
+		return e.complexity.Mutation.AcceptWaitlistOffer(childComplexity, args["entryId"].(string), args["passengerEmail"].(string)), true
+	case "Mutation.addAncillary":
+		if e.complexity.Mutation.AddAncillary == nil {
 			break
 		}
 
-		args, err := ec.field_Query_bookings_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_addAncillary_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Bookings(childComplexity, args["passengerEmail"].(*string), args["limit"].(*int)), true
-	case "Query.flight":
-		if e.complexity.Query.Flight == nil {
+		return e.complexity.Mutation.AddAncillary(childComplexity, args["bookingReference"].(string), args["productId"].(string), args["quantity"].(int)), true
+	case "Mutation.addPassengerDocument":
+		if e.complexity.Mutation.AddPassengerDocument == nil {
 			break
 		}
 
-		args, err := ec.field_Query_flight_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_addPassengerDocument_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Flight(childComplexity, args["id"].(string)), true
-	case "Query.flights":
-		if e.complexity.Query.Flights == nil {
+		return e.complexity.Mutation.AddPassengerDocument(childComplexity, args["bookingReference"].(string), args["document"].(PassengerDocumentInput)), true
+	case "Mutation.assignGate":
+		if e.complexity.Mutation.AssignGate == nil {
 			break
 		}
 
-		args, err := ec.field_Query_flights_args(ctx, rawArgs)
+		args, err := ec.field_Mutation_assignGate_args(ctx, rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Flights(childComplexity, args["origin"].(*string), args["destination"].(*string), args["limit"].(*int)), true
+		return e.complexity.Mutation.AssignGate(childComplexity, args["input"].(AssignGateInput)), true
+	case "Mutation.cancelBooking":
+		if e.complexity.Mutation.CancelBooking == nil {
+			break
+		}
 
-	}
-	return 0, false
-}
+		args, err := ec.field_Mutation_cancelBooking_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
-		ec.unmarshalInputCreateBookingInput,
-	)
-	first := true
+		return e.complexity.Mutation.CancelBooking(childComplexity, args["bookingReference"].(string)), true
+	case "Mutation.cancelBookingOnBehalf":
+		if e.complexity.Mutation.CancelBookingOnBehalf == nil {
+			break
+		}
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+		args, err := ec.field_Mutation_cancelBookingOnBehalf_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-			return &response
+		return e.complexity.Mutation.CancelBookingOnBehalf(childComplexity, args["bookingReference"].(string)), true
+	case "Mutation.checkIn":
+		if e.complexity.Mutation.CheckIn == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		args, err := ec.field_Mutation_checkIn_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.Mutation.CheckIn(childComplexity, args["bookingReference"].(string), args["document"].(*PassengerDocumentInput)), true
+	case "Mutation.createBooking":
+		if e.complexity.Mutation.CreateBooking == nil {
+			break
+		}
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+		args, err := ec.field_Mutation_createBooking_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+		return e.complexity.Mutation.CreateBooking(childComplexity, args["input"].(CreateBookingInput)), true
+	case "Mutation.delayFlight":
+		if e.complexity.Mutation.DelayFlight == nil {
+			break
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
+
+		args, err := ec.field_Mutation_delayFlight_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		return e.complexity.Mutation.DelayFlight(childComplexity, args["input"].(DelayFlightInput)), true
+	case "Mutation.joinWaitlist":
+		if e.complexity.Mutation.JoinWaitlist == nil {
+			break
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+		args, err := ec.field_Mutation_joinWaitlist_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-var sources = []*ast.Source{
-	{Name: "../schema.graphqls", Input: `type Flight {
-  id: ID!
-  flightNumber: String!
-  origin: String!
-  destination: String!
-  departureTime: Time!
-  arrivalTime: Time!
-  aircraftType: String!
-  totalSeats: Int!
-  availableSeats: Int!
-  status: String!
-  fares: [Fare!]!
-  bookings: [Booking!]!
-}
+		return e.complexity.Mutation.JoinWaitlist(childComplexity, args["fareId"].(string), args["passenger"].(PassengerInput)), true
+	case "Mutation.login":
+		if e.complexity.Mutation.Login == nil {
+			break
+		}
 
-type Fare {
-  id: ID!
-  flightId: ID!
-  fareClass: String!
-  price: Float!
-  baggageAllowance: Int!
-  isRefundable: Boolean!
-  isChangeable: Boolean!
-  availableSeats: Int!
-  flight: Flight!
-  bookings: [Booking!]!
-}
+		args, err := ec.field_Mutation_login_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-type Booking {
-  id: ID!
-  bookingReference: String!
-  flightId: ID!
-  fareId: ID!
-  passengerName: String!
-  passengerEmail: String!
-  passengerPhone: String
-  seatNumber: String
-  bookingStatus: String!
-  totalPrice: Float!
-  bookedAt: Time!
-  flight: Flight!
-  fare: Fare!
-}
+		return e.complexity.Mutation.Login(childComplexity, args["email"].(string), args["password"].(string)), true
+	case "Mutation.logout":
+		if e.complexity.Mutation.Logout == nil {
+			break
+		}
 
-type Query {
-  flights(origin: String, destination: String, limit: Int): [Flight!]!
-  flight(id: ID!): Flight
-  booking(bookingReference: String!): Booking
-  bookings(passengerEmail: String, limit: Int): [Booking!]!
-  airports: [String!]!
-}
+		args, err := ec.field_Mutation_logout_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-input CreateBookingInput {
-  flightId: ID!
-  fareId: ID!
-  passengerName: String!
-  passengerEmail: String!
-  passengerPhone: String
-  seatNumber: String
-}
-
-type Mutation {
-  createBooking(input: CreateBookingInput!): Booking!
-}
+		return e.complexity.Mutation.Logout(childComplexity, args["refreshToken"].(string)), true
+	case "Mutation.manageBooking":
+		if e.complexity.Mutation.ManageBooking == nil {
+			break
+		}
 
-scalar Time
-`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		args, err := ec.field_Mutation_manageBooking_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-// endregion ************************** generated!.gotpl **************************
+		return e.complexity.Mutation.ManageBooking(childComplexity, args["bookingReference"].(string), args["lastName"].(string)), true
+	case "Mutation.refreshToken":
+		if e.complexity.Mutation.RefreshToken == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		args, err := ec.field_Mutation_refreshToken_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Mutation_createBooking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateBookingInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐCreateBookingInput)
-	if err != nil {
-		return nil, err
-	}
-	args["input"] = arg0
-	return args, nil
-}
+		return e.complexity.Mutation.RefreshToken(childComplexity, args["refreshToken"].(string)), true
+	case "Mutation.selectSeat":
+		if e.complexity.Mutation.SelectSeat == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_selectSeat_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_booking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["bookingReference"] = arg0
-	return args, nil
-}
+		return e.complexity.Mutation.SelectSeat(childComplexity, args["bookingReference"].(string), args["seatNumber"].(string)), true
+	case "Mutation.setOverbookingLimit":
+		if e.complexity.Mutation.SetOverbookingLimit == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_bookings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "passengerEmail", ec.unmarshalOString2ᚖstring)
-	if err != nil {
-		return nil, err
-	}
-	args["passengerEmail"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
-	if err != nil {
-		return nil, err
-	}
-	args["limit"] = arg1
-	return args, nil
-}
+		args, err := ec.field_Mutation_setOverbookingLimit_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field_Query_flight_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.Mutation.SetOverbookingLimit(childComplexity, args["input"].(SetOverbookingLimitInput)), true
+	case "Mutation.signup":
+		if e.complexity.Mutation.Signup == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_flights_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "origin", ec.unmarshalOString2ᚖstring)
-	if err != nil {
-		return nil, err
-	}
-	args["origin"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "destination", ec.unmarshalOString2ᚖstring)
-	if err != nil {
-		return nil, err
-	}
-	args["destination"] = arg1
-	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
-	if err != nil {
-		return nil, err
-	}
-	args["limit"] = arg2
-	return args, nil
-}
+		args, err := ec.field_Mutation_signup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Mutation.Signup(childComplexity, args["input"].(SignupInput)), true
+	case "Mutation.updateFlightStatus":
+		if e.complexity.Mutation.UpdateFlightStatus == nil {
+			break
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_updateFlightStatus_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Mutation.UpdateFlightStatus(childComplexity, args["input"].(UpdateFlightStatusInput)), true
+	case "Mutation.volunteerForDeniedBoarding":
+		if e.complexity.Mutation.VolunteerForDeniedBoarding == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		args, err := ec.field_Mutation_volunteerForDeniedBoarding_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.Mutation.VolunteerForDeniedBoarding(childComplexity, args["bookingReference"].(string), args["volunteer"].(bool)), true
 
-// region    ************************** directives.gotpl **************************
+	case "PassengerDocument.documentType":
+		if e.complexity.PassengerDocument.DocumentType == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.PassengerDocument.DocumentType(childComplexity), true
+	case "PassengerDocument.expiryDate":
+		if e.complexity.PassengerDocument.ExpiryDate == nil {
+			break
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.complexity.PassengerDocument.ExpiryDate(childComplexity), true
+	case "PassengerDocument.issuingCountry":
+		if e.complexity.PassengerDocument.IssuingCountry == nil {
+			break
+		}
 
-func (ec *executionContext) _Booking_id(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.PassengerDocument.IssuingCountry(childComplexity), true
+	case "PassengerDocument.maskedNumber":
+		if e.complexity.PassengerDocument.MaskedNumber == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PassengerDocument.MaskedNumber(childComplexity), true
+	case "PassengerDocument.nationality":
+		if e.complexity.PassengerDocument.Nationality == nil {
+			break
+		}
 
-func (ec *executionContext) _Booking_bookingReference(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_bookingReference,
-		func(ctx context.Context) (any, error) {
-			return obj.BookingReference, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.PassengerDocument.Nationality(childComplexity), true
 
-func (ec *executionContext) fieldContext_Booking_bookingReference(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "Query.airports":
+		if e.complexity.Query.Airports == nil {
+			break
+		}
 
-func (ec *executionContext) _Booking_flightId(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_flightId,
-		func(ctx context.Context) (any, error) {
-			return obj.FlightID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Airports(childComplexity), true
+	case "Query.ancillaryOffers":
+		if e.complexity.Query.AncillaryOffers == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_flightId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_ancillaryOffers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Booking_fareId(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_fareId,
-		func(ctx context.Context) (any, error) {
-			return obj.FareID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.AncillaryOffers(childComplexity, args["flightId"].(string)), true
+	case "Query.booking":
+		if e.complexity.Query.Booking == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_fareId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_booking_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Booking_passengerName(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_passengerName,
-		func(ctx context.Context) (any, error) {
-			return obj.PassengerName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Booking(childComplexity, args["bookingReference"].(string)), true
+	case "Query.bookings":
+		if e.complexity.Query.Bookings == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_passengerName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_bookings_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Booking_passengerEmail(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_passengerEmail,
-		func(ctx context.Context) (any, error) {
-			return obj.PassengerEmail, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Bookings(childComplexity, args["passengerEmail"].(*string), args["limit"].(*int)), true
+	case "Query.deniedBoardingList":
+		if e.complexity.Query.DeniedBoardingList == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_passengerEmail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_deniedBoardingList_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Booking_passengerPhone(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_passengerPhone,
-		func(ctx context.Context) (any, error) {
-			return obj.PassengerPhone, nil
-		},
-		nil,
-		ec.marshalOString2string,
-		true,
-		false,
-	)
-}
+		return e.complexity.Query.DeniedBoardingList(childComplexity, args["flightId"].(string)), true
+	case "Query.disruptionReport":
+		if e.complexity.Query.DisruptionReport == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_passengerPhone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_disruptionReport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Booking_seatNumber(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_seatNumber,
-		func(ctx context.Context) (any, error) {
-			return obj.SeatNumber, nil
-		},
-		nil,
-		ec.marshalOString2string,
-		true,
-		false,
-	)
-}
+		return e.complexity.Query.DisruptionReport(childComplexity, args["flightId"].(string)), true
+	case "Query.flight":
+		if e.complexity.Query.Flight == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_seatNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_flight_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Booking_bookingStatus(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_bookingStatus,
-		func(ctx context.Context) (any, error) {
-			return obj.BookingStatus, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Flight(childComplexity, args["id"].(string)), true
+	case "Query.flightManifest":
+		if e.complexity.Query.FlightManifest == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_bookingStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_flightManifest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Booking_totalPrice(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_totalPrice,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalPrice, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.FlightManifest(childComplexity, args["flightId"].(string)), true
+	case "Query.flights":
+		if e.complexity.Query.Flights == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_totalPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Query_flights_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Booking_bookedAt(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_bookedAt,
-		func(ctx context.Context) (any, error) {
-			return obj.BookedAt, nil
-		},
-		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Flights(childComplexity, args["origin"].(*string), args["destination"].(*string), args["limit"].(*int)), true
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_bookedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.Me(childComplexity), true
 
-func (ec *executionContext) _Booking_flight(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_flight,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Booking().Flight(ctx, obj)
-		},
-		nil,
-		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
-		true,
-		true,
-	)
-}
+	case "Rebooking.booking":
+		if e.complexity.Rebooking.Booking == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_flight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Flight_id(ctx, field)
-			case "flightNumber":
-				return ec.fieldContext_Flight_flightNumber(ctx, field)
-			case "origin":
-				return ec.fieldContext_Flight_origin(ctx, field)
-			case "destination":
-				return ec.fieldContext_Flight_destination(ctx, field)
-			case "departureTime":
-				return ec.fieldContext_Flight_departureTime(ctx, field)
-			case "arrivalTime":
-				return ec.fieldContext_Flight_arrivalTime(ctx, field)
-			case "aircraftType":
-				return ec.fieldContext_Flight_aircraftType(ctx, field)
-			case "totalSeats":
-				return ec.fieldContext_Flight_totalSeats(ctx, field)
-			case "availableSeats":
-				return ec.fieldContext_Flight_availableSeats(ctx, field)
-			case "status":
-				return ec.fieldContext_Flight_status(ctx, field)
-			case "fares":
-				return ec.fieldContext_Flight_fares(ctx, field)
-			case "bookings":
-				return ec.fieldContext_Flight_bookings(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Rebooking.Booking(childComplexity), true
+	case "Rebooking.id":
+		if e.complexity.Rebooking.ID == nil {
+			break
+		}
 
-func (ec *executionContext) _Booking_fare(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Booking_fare,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Booking().Fare(ctx, obj)
-		},
-		nil,
-		ec.marshalNFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare,
-		true,
-		true,
-	)
-}
+		return e.complexity.Rebooking.ID(childComplexity), true
+	case "Rebooking.newFare":
+		if e.complexity.Rebooking.NewFare == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Booking_fare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Booking",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Fare_id(ctx, field)
-			case "flightId":
-				return ec.fieldContext_Fare_flightId(ctx, field)
-			case "fareClass":
-				return ec.fieldContext_Fare_fareClass(ctx, field)
-			case "price":
-				return ec.fieldContext_Fare_price(ctx, field)
-			case "baggageAllowance":
-				return ec.fieldContext_Fare_baggageAllowance(ctx, field)
-			case "isRefundable":
-				return ec.fieldContext_Fare_isRefundable(ctx, field)
-			case "isChangeable":
-				return ec.fieldContext_Fare_isChangeable(ctx, field)
-			case "availableSeats":
-				return ec.fieldContext_Fare_availableSeats(ctx, field)
-			case "flight":
-				return ec.fieldContext_Fare_flight(ctx, field)
-			case "bookings":
-				return ec.fieldContext_Fare_bookings(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Fare", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Rebooking.NewFare(childComplexity), true
+	case "Rebooking.newFlight":
+		if e.complexity.Rebooking.NewFlight == nil {
+			break
+		}
 
-func (ec *executionContext) _Fare_id(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Rebooking.NewFlight(childComplexity), true
+	case "Rebooking.originalFare":
+		if e.complexity.Rebooking.OriginalFare == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Fare_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Rebooking.OriginalFare(childComplexity), true
+	case "Rebooking.originalFlight":
+		if e.complexity.Rebooking.OriginalFlight == nil {
+			break
+		}
 
-func (ec *executionContext) _Fare_flightId(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_flightId,
-		func(ctx context.Context) (any, error) {
-			return obj.FlightID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Rebooking.OriginalFlight(childComplexity), true
+	case "Rebooking.outcome":
+		if e.complexity.Rebooking.Outcome == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Fare_flightId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Rebooking.Outcome(childComplexity), true
+	case "Rebooking.processedAt":
+		if e.complexity.Rebooking.ProcessedAt == nil {
+			break
+		}
 
-func (ec *executionContext) _Fare_fareClass(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_fareClass,
-		func(ctx context.Context) (any, error) {
-			return obj.FareClass, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Rebooking.ProcessedAt(childComplexity), true
 
-func (ec *executionContext) fieldContext_Fare_fareClass(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "Subscription.bookingUpdated":
+		if e.complexity.Subscription.BookingUpdated == nil {
+			break
+		}
 
-func (ec *executionContext) _Fare_price(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_price,
-		func(ctx context.Context) (any, error) {
-			return obj.Price, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Subscription_bookingUpdated_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Fare_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Subscription.BookingUpdated(childComplexity, args["bookingReference"].(string)), true
+	case "Subscription.flightStatusChanged":
+		if e.complexity.Subscription.FlightStatusChanged == nil {
+			break
+		}
 
-func (ec *executionContext) _Fare_baggageAllowance(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_baggageAllowance,
-		func(ctx context.Context) (any, error) {
-			return obj.BaggageAllowance, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Subscription_flightStatusChanged_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.FlightStatusChanged(childComplexity, args["flightId"].(string)), true
+
+	case "User.bookings":
+		if e.complexity.User.Bookings == nil {
+			break
+		}
+
+		return e.complexity.User.Bookings(childComplexity), true
+	case "User.createdAt":
+		if e.complexity.User.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.User.CreatedAt(childComplexity), true
+	case "User.email":
+		if e.complexity.User.Email == nil {
+			break
+		}
+
+		return e.complexity.User.Email(childComplexity), true
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+	case "User.name":
+		if e.complexity.User.Name == nil {
+			break
+		}
+
+		return e.complexity.User.Name(childComplexity), true
+	case "User.roles":
+		if e.complexity.User.Roles == nil {
+			break
+		}
+
+		return e.complexity.User.Roles(childComplexity), true
+
+	case "WaitlistEntry.booking":
+		if e.complexity.WaitlistEntry.Booking == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.Booking(childComplexity), true
+	case "WaitlistEntry.createdAt":
+		if e.complexity.WaitlistEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.CreatedAt(childComplexity), true
+	case "WaitlistEntry.fare":
+		if e.complexity.WaitlistEntry.Fare == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.Fare(childComplexity), true
+	case "WaitlistEntry.id":
+		if e.complexity.WaitlistEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.ID(childComplexity), true
+	case "WaitlistEntry.offerExpiresAt":
+		if e.complexity.WaitlistEntry.OfferExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.OfferExpiresAt(childComplexity), true
+	case "WaitlistEntry.passengerEmail":
+		if e.complexity.WaitlistEntry.PassengerEmail == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.PassengerEmail(childComplexity), true
+	case "WaitlistEntry.passengerName":
+		if e.complexity.WaitlistEntry.PassengerName == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.PassengerName(childComplexity), true
+	case "WaitlistEntry.passengerPhone":
+		if e.complexity.WaitlistEntry.PassengerPhone == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.PassengerPhone(childComplexity), true
+	case "WaitlistEntry.position":
+		if e.complexity.WaitlistEntry.Position == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.Position(childComplexity), true
+	case "WaitlistEntry.status":
+		if e.complexity.WaitlistEntry.Status == nil {
+			break
+		}
+
+		return e.complexity.WaitlistEntry.Status(childComplexity), true
 
-func (ec *executionContext) fieldContext_Fare_baggageAllowance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
 	}
-	return fc, nil
+	return 0, false
 }
 
-func (ec *executionContext) _Fare_isRefundable(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_isRefundable,
-		func(ctx context.Context) (any, error) {
-			return obj.IsRefundable, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAssignGateInput,
+		ec.unmarshalInputCreateBookingInput,
+		ec.unmarshalInputDelayFlightInput,
+		ec.unmarshalInputPassengerDocumentInput,
+		ec.unmarshalInputPassengerInput,
+		ec.unmarshalInputSetOverbookingLimitInput,
+		ec.unmarshalInputSignupInput,
+		ec.unmarshalInputUpdateFlightStatusInput,
 	)
-}
+	first := true
 
-func (ec *executionContext) fieldContext_Fare_isRefundable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
 	}
-	return fc, nil
 }
 
-func (ec *executionContext) _Fare_isChangeable(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_isChangeable,
-		func(ctx context.Context) (any, error) {
-			return obj.IsChangeable, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
 }
 
-func (ec *executionContext) fieldContext_Fare_isChangeable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
 }
 
-func (ec *executionContext) _Fare_availableSeats(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_availableSeats,
-		func(ctx context.Context) (any, error) {
-			return obj.AvailableSeats, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
 }
 
-func (ec *executionContext) fieldContext_Fare_availableSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
 	}
-	return fc, nil
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
 }
 
-func (ec *executionContext) _Fare_flight(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_flight,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Fare().Flight(ctx, obj)
-		},
-		nil,
-		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
-		true,
-		true,
-	)
+var sources = []*ast.Source{
+	{Name: "../schema.graphqls", Input: `"Requires the caller to hold role."
+directive @hasRole(role: Role!) on FIELD_DEFINITION
+
+enum Role {
+  CUSTOMER
+  "Customer service, acts on bookings on behalf of passengers."
+  AGENT
+  "Operations, manages flights."
+  OPS
 }
 
-func (ec *executionContext) fieldContext_Fare_flight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Flight_id(ctx, field)
-			case "flightNumber":
-				return ec.fieldContext_Flight_flightNumber(ctx, field)
-			case "origin":
-				return ec.fieldContext_Flight_origin(ctx, field)
-			case "destination":
-				return ec.fieldContext_Flight_destination(ctx, field)
-			case "departureTime":
-				return ec.fieldContext_Flight_departureTime(ctx, field)
-			case "arrivalTime":
-				return ec.fieldContext_Flight_arrivalTime(ctx, field)
-			case "aircraftType":
-				return ec.fieldContext_Flight_aircraftType(ctx, field)
-			case "totalSeats":
-				return ec.fieldContext_Flight_totalSeats(ctx, field)
-			case "availableSeats":
-				return ec.fieldContext_Flight_availableSeats(ctx, field)
-			case "status":
-				return ec.fieldContext_Flight_status(ctx, field)
-			case "fares":
-				return ec.fieldContext_Flight_fares(ctx, field)
-			case "bookings":
-				return ec.fieldContext_Flight_bookings(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
-		},
-	}
-	return fc, nil
+enum FlightStatus {
+  SCHEDULED
+  BOARDING
+  DEPARTED
+  ARRIVED
+  CANCELLED
 }
 
-func (ec *executionContext) _Fare_bookings(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Fare_bookings,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Fare().Bookings(ctx, obj)
-		},
-		nil,
-		ec.marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
-		true,
-		true,
-	)
+enum FlightEventType {
+  STATUS_CHANGED
+  DELAYED
+  GATE_ASSIGNED
 }
 
-func (ec *executionContext) fieldContext_Fare_bookings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Fare",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Booking_id(ctx, field)
-			case "bookingReference":
-				return ec.fieldContext_Booking_bookingReference(ctx, field)
-			case "flightId":
-				return ec.fieldContext_Booking_flightId(ctx, field)
-			case "fareId":
-				return ec.fieldContext_Booking_fareId(ctx, field)
-			case "passengerName":
-				return ec.fieldContext_Booking_passengerName(ctx, field)
-			case "passengerEmail":
-				return ec.fieldContext_Booking_passengerEmail(ctx, field)
-			case "passengerPhone":
-				return ec.fieldContext_Booking_passengerPhone(ctx, field)
-			case "seatNumber":
-				return ec.fieldContext_Booking_seatNumber(ctx, field)
-			case "bookingStatus":
-				return ec.fieldContext_Booking_bookingStatus(ctx, field)
-			case "totalPrice":
-				return ec.fieldContext_Booking_totalPrice(ctx, field)
-			case "bookedAt":
-				return ec.fieldContext_Booking_bookedAt(ctx, field)
-			case "flight":
-				return ec.fieldContext_Booking_flight(ctx, field)
-			case "fare":
-				return ec.fieldContext_Booking_fare(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
-		},
-	}
-	return fc, nil
+enum FareClass {
+  PROMO
+  BASIC
+  PRO
 }
 
-func (ec *executionContext) _Flight_id(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
+enum BookingStatus {
+  CONFIRMED
+  CANCELLED
+  CHECKED_IN
+  COMPLETED
+  DISRUPTED
 }
 
-func (ec *executionContext) fieldContext_Flight_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
+enum WaitlistStatus {
+  WAITING
+  OFFERED
+  BOOKED
+  EXPIRED
 }
 
-func (ec *executionContext) _Flight_flightNumber(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_flightNumber,
-		func(ctx context.Context) (any, error) {
-			return obj.FlightNumber, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+enum AncillaryCategory {
+  BAGGAGE
+  MEAL
+  PRIORITY_BOARDING
+  SEAT_SELECTION
+  LOUNGE_PASS
 }
 
-func (ec *executionContext) fieldContext_Flight_flightNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+enum DocumentType {
+  PASSPORT
+  ID_CARD
 }
 
-func (ec *executionContext) _Flight_origin(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_origin,
-		func(ctx context.Context) (any, error) {
-			return obj.Origin, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+enum RebookingOutcome {
+  REBOOKED
+  UNACCOMMODATED
 }
 
-func (ec *executionContext) fieldContext_Flight_origin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type Flight {
+  id: ID!
+  flightNumber: String!
+  origin: String!
+  destination: String!
+  departureTime: Time!
+  arrivalTime: Time!
+  aircraftType: String!
+  totalSeats: Int!
+  availableSeats: Int!
+  status: FlightStatus!
+  gate: String
+  delayMinutes: Int!
+  "Bookings currently holding a seat."
+  bookedSeats: Int!
+  "Seats sold beyond totalSeats; null with an error for callers without the OPS role."
+  oversoldSeats: Int @hasRole(role: OPS)
+  "Seats the flight may be sold beyond totalSeats, null when only fare limits apply."
+  overbookingLimit: Int
+  statusHistory: [FlightEvent!]!
+  fares: [Fare!]!
+  "Every passenger of the flight; null with an error for callers without the AGENT role."
+  bookings: [Booking!] @hasRole(role: AGENT)
 }
 
-func (ec *executionContext) _Flight_destination(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_destination,
-		func(ctx context.Context) (any, error) {
-			return obj.Destination, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+type FlightEvent {
+  id: ID!
+  flightId: ID!
+  eventType: FlightEventType!
+  previousStatus: FlightStatus
+  newStatus: FlightStatus
+  gate: String
+  delayMinutes: Int
+  reason: String
+  "Email of the operations user who made the change."
+  actor: String!
+  occurredAt: Time!
 }
 
-func (ec *executionContext) fieldContext_Flight_destination(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type Fare {
+  id: ID!
+  flightId: ID!
+  fareClass: FareClass!
+  price: Float!
+  baggageAllowance: Int!
+  isRefundable: Boolean!
+  isChangeable: Boolean!
+  "Seats left in the allocation, 0 once the fare is overbooked."
+  availableSeats: Int!
+  "Seats this fare may be sold beyond its allocation."
+  overbookingLimit: Int!
+  "Seats sold beyond the allocation; null with an error for callers without the OPS role."
+  oversoldSeats: Int @hasRole(role: OPS)
+  "Passengers waiting for or holding an offer on this fare."
+  waitlistCount: Int!
+  flight: Flight!
+  "Every passenger of the fare; null with an error for callers without the AGENT role."
+  bookings: [Booking!] @hasRole(role: AGENT)
 }
 
-func (ec *executionContext) _Flight_departureTime(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_departureTime,
-		func(ctx context.Context) (any, error) {
-			return obj.DepartureTime, nil
-		},
-		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
-		true,
-	)
+type Booking {
+  id: ID!
+  bookingReference: String!
+  flightId: ID!
+  fareId: ID!
+  passengerName: String!
+  passengerEmail: String!
+  passengerPhone: String
+  seatNumber: String
+  specialRequests: String
+  bookingStatus: BookingStatus!
+  totalPrice: Float!
+  bookedAt: Time!
+  checkedInAt: Time
+  deniedBoardingVolunteer: Boolean!
+  ancillaries: [BookingAncillary!]!
+  document: PassengerDocument
+  flight: Flight!
+  fare: Fare!
 }
 
-func (ec *executionContext) fieldContext_Flight_departureTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
+"Identity document of a passenger, without the sensitive details."
+type PassengerDocument {
+  documentType: DocumentType!
+  "Document number with all but the last three characters masked."
+  maskedNumber: String!
+  nationality: String!
+  issuingCountry: String!
+  "YYYY-MM-DD"
+  expiryDate: String!
 }
 
-func (ec *executionContext) _Flight_arrivalTime(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_arrivalTime,
-		func(ctx context.Context) (any, error) {
-			return obj.ArrivalTime, nil
-		},
-		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
-		true,
-	)
+type AncillaryProduct {
+  id: ID!
+  code: String!
+  name: String!
+  category: AncillaryCategory!
+  description: String!
+  basePrice: Float!
+  maxPerBooking: Int!
+  allowedFareClasses: [FareClass!]!
+}
+
+type AncillaryOffer {
+  product: AncillaryProduct!
+  "Price on the flight's route."
+  price: Float!
+  "Units left on the flight, null when the product has no inventory."
+  remaining: Int
 }
 
-func (ec *executionContext) fieldContext_Flight_arrivalTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
-		},
-	}
-	return fc, nil
+type BookingAncillary {
+  id: ID!
+  product: AncillaryProduct!
+  quantity: Int!
+  unitPrice: Float!
+  totalPrice: Float!
+  createdAt: Time!
 }
 
-func (ec *executionContext) _Flight_aircraftType(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_aircraftType,
-		func(ctx context.Context) (any, error) {
-			return obj.AircraftType, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+type WaitlistEntry {
+  id: ID!
+  fare: Fare!
+  passengerName: String!
+  passengerEmail: String!
+  passengerPhone: String
+  status: WaitlistStatus!
+  "1-based place in the queue while WAITING."
+  position: Int
+  offerExpiresAt: Time
+  booking: Booking
+  createdAt: Time!
 }
 
-func (ec *executionContext) fieldContext_Flight_aircraftType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type User {
+  id: ID!
+  email: String!
+  name: String!
+  roles: [Role!]!
+  createdAt: Time!
+  "Bookings made while signed in, the 50 most recent first."
+  bookings: [Booking!]!
 }
 
-func (ec *executionContext) _Flight_totalSeats(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_totalSeats,
-		func(ctx context.Context) (any, error) {
-			return obj.TotalSeats, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type AuthPayload {
+  "Bearer token for the Authorization header."
+  accessToken: String!
+  accessTokenExpiresAt: Time!
+  "Single-use token for refreshToken; a new one is returned every time."
+  refreshToken: String!
+  user: User!
 }
 
-func (ec *executionContext) fieldContext_Flight_totalSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+"Guest access to a booking, returned by manageBooking."
+type ManagedBooking {
+  booking: Booking!
+  "Bearer token for the booking mutations on this booking."
+  token: String!
+  expiresAt: Time!
 }
 
-func (ec *executionContext) _Flight_availableSeats(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_availableSeats,
-		func(ctx context.Context) (any, error) {
-			return obj.AvailableSeats, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
+type CreateBookingPayload {
+  booking: Booking!
+  "Bearer token for the booking mutations on the new booking, as manageBooking returns; null when signed in."
+  token: String
+  expiresAt: Time
 }
 
-func (ec *executionContext) fieldContext_Flight_availableSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
+type AcceptWaitlistOfferPayload {
+  booking: Booking!
+  "Bearer token for the booking mutations on the new booking, as createBooking returns; null when the waitlist was joined signed in."
+  token: String
+  expiresAt: Time
 }
 
-func (ec *executionContext) _Flight_status(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_status,
-		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+type BoardingPass {
+  booking: Booking!
+  flight: Flight!
+  seatNumber: String!
+  sequenceNumber: Int!
+  "IATA Bar Coded Boarding Pass (BCBP) string, as encoded in the PDF417 barcode."
+  barcode: String!
+  "Relative URL of the PNG barcode, valid for 15 minutes; append format=pdf for a printable pass."
+  downloadUrl: String!
 }
 
-func (ec *executionContext) fieldContext_Flight_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
+type Rebooking {
+  id: ID!
+  booking: Booking!
+  originalFlight: Flight!
+  originalFare: Fare!
+  newFlight: Flight
+  newFare: Fare
+  outcome: RebookingOutcome!
+  processedAt: Time!
 }
 
-func (ec *executionContext) _Flight_fares(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_fares,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Flight().Fares(ctx, obj)
-		},
-		nil,
-		ec.marshalNFare2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareᚄ,
-		true,
-		true,
-	)
+type DisruptionReport {
+  flight: Flight!
+  totalAffected: Int!
+  rebookedCount: Int!
+  unaccommodatedCount: Int!
+  rebookings: [Rebooking!]!
 }
 
-func (ec *executionContext) fieldContext_Flight_fares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Fare_id(ctx, field)
-			case "flightId":
-				return ec.fieldContext_Fare_flightId(ctx, field)
-			case "fareClass":
-				return ec.fieldContext_Fare_fareClass(ctx, field)
-			case "price":
-				return ec.fieldContext_Fare_price(ctx, field)
-			case "baggageAllowance":
-				return ec.fieldContext_Fare_baggageAllowance(ctx, field)
-			case "isRefundable":
-				return ec.fieldContext_Fare_isRefundable(ctx, field)
-			case "isChangeable":
-				return ec.fieldContext_Fare_isChangeable(ctx, field)
-			case "availableSeats":
-				return ec.fieldContext_Fare_availableSeats(ctx, field)
-			case "flight":
-				return ec.fieldContext_Fare_flight(ctx, field)
-			case "bookings":
-				return ec.fieldContext_Fare_bookings(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Fare", field.Name)
-		},
-	}
-	return fc, nil
+type DeniedBoardingList {
+  flight: Flight!
+  oversoldSeats: Int!
+  "Passengers who offered to give up their seat, in booking order."
+  volunteers: [Booking!]!
+  "Remaining passengers in the order they are denied boarding involuntarily."
+  candidates: [Booking!]!
 }
 
-func (ec *executionContext) _Flight_bookings(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Flight_bookings,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Flight().Bookings(ctx, obj)
-		},
-		nil,
-		ec.marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
-		true,
-		true,
-	)
+type ManifestEntry {
+  seatNumber: String
+  passengerName: String!
+  bookingReference: String!
+  fareClass: FareClass!
+  bookingStatus: BookingStatus!
+  specialRequests: String
+  booking: Booking!
 }
 
-func (ec *executionContext) fieldContext_Flight_bookings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Flight",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Booking_id(ctx, field)
-			case "bookingReference":
-				return ec.fieldContext_Booking_bookingReference(ctx, field)
-			case "flightId":
-				return ec.fieldContext_Booking_flightId(ctx, field)
-			case "fareId":
-				return ec.fieldContext_Booking_fareId(ctx, field)
-			case "passengerName":
-				return ec.fieldContext_Booking_passengerName(ctx, field)
-			case "passengerEmail":
-				return ec.fieldContext_Booking_passengerEmail(ctx, field)
-			case "passengerPhone":
-				return ec.fieldContext_Booking_passengerPhone(ctx, field)
-			case "seatNumber":
-				return ec.fieldContext_Booking_seatNumber(ctx, field)
-			case "bookingStatus":
-				return ec.fieldContext_Booking_bookingStatus(ctx, field)
-			case "totalPrice":
-				return ec.fieldContext_Booking_totalPrice(ctx, field)
-			case "bookedAt":
-				return ec.fieldContext_Booking_bookedAt(ctx, field)
-			case "flight":
-				return ec.fieldContext_Booking_flight(ctx, field)
-			case "fare":
-				return ec.fieldContext_Booking_fare(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
-		},
-	}
-	return fc, nil
+type FlightManifest {
+  flight: Flight!
+  "Bookings that are not cancelled, sorted by seat row; passengers without a seat come last."
+  entries: [ManifestEntry!]!
+  """
+  Relative URL of the CSV download, which needs the same access token; append
+  format=pdf for a printable manifest.
+  """
+  downloadUrl: String!
 }
 
-func (ec *executionContext) _Mutation_createBooking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Mutation_createBooking,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Mutation().CreateBooking(ctx, fc.Args["input"].(CreateBookingInput))
-		},
-		nil,
-		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
-		true,
-		true,
-	)
+type Query {
+  "limit defaults to 50 and is capped at 100, like bookings."
+  flights(origin: String, destination: String, limit: Int): [Flight!]!
+  flight(id: ID!): Flight
+  "The signed-in user, null for anonymous requests."
+  me: User
+  """
+  A booking of the signed-in user or of the caller's booking token; agents can
+  read any booking.
+  """
+  booking(bookingReference: String!): Booking
+  "Bookings of the signed-in user, optionally filtered by passenger email."
+  bookings(passengerEmail: String, limit: Int): [Booking!]!
+  airports: [String!]!
+  disruptionReport(flightId: ID!): DisruptionReport @hasRole(role: AGENT)
+  deniedBoardingList(flightId: ID!): DeniedBoardingList @hasRole(role: AGENT)
+  ancillaryOffers(flightId: ID!): [AncillaryOffer!]!
+  flightManifest(flightId: ID!): FlightManifest @hasRole(role: AGENT)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createBooking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Booking_id(ctx, field)
-			case "bookingReference":
-				return ec.fieldContext_Booking_bookingReference(ctx, field)
-			case "flightId":
-				return ec.fieldContext_Booking_flightId(ctx, field)
-			case "fareId":
-				return ec.fieldContext_Booking_fareId(ctx, field)
-			case "passengerName":
-				return ec.fieldContext_Booking_passengerName(ctx, field)
-			case "passengerEmail":
-				return ec.fieldContext_Booking_passengerEmail(ctx, field)
-			case "passengerPhone":
-				return ec.fieldContext_Booking_passengerPhone(ctx, field)
-			case "seatNumber":
-				return ec.fieldContext_Booking_seatNumber(ctx, field)
-			case "bookingStatus":
-				return ec.fieldContext_Booking_bookingStatus(ctx, field)
-			case "totalPrice":
-				return ec.fieldContext_Booking_totalPrice(ctx, field)
-			case "bookedAt":
-				return ec.fieldContext_Booking_bookedAt(ctx, field)
-			case "flight":
-				return ec.fieldContext_Booking_flight(ctx, field)
-			case "fare":
-				return ec.fieldContext_Booking_fare(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createBooking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
+input CreateBookingInput {
+  flightId: ID!
+  fareId: ID!
+  passengerName: String!
+  passengerEmail: String!
+  passengerPhone: String
+  "Picks the seat and buys seat selection with the booking; PROMO fares cannot."
+  seatNumber: String
+  specialRequests: String
+  document: PassengerDocumentInput
+}
+
+"""
+Identity document of a passenger. Either the two lines of a passport machine
+readable zone (mrz) or the individual fields; dates are YYYY-MM-DD and country
+codes ISO 3166 alpha-3.
+"""
+input PassengerDocumentInput {
+  mrz: String
+  documentType: DocumentType
+  documentNumber: String
+  nationality: String
+  issuingCountry: String
+  dateOfBirth: String
+  expiryDate: String
+  sex: String
+}
+
+input SignupInput {
+  email: String!
+  name: String!
+  "8 to 72 bytes."
+  password: String!
+}
+
+input PassengerInput {
+  name: String!
+  email: String!
+  phone: String
+}
+
+"""
+Overbooking limit of a flight, or of one of its fares when fareClass is set.
+Give either seats or a percentage of totalSeats; leave both out to clear it.
+"""
+input SetOverbookingLimitInput {
+  flightId: ID!
+  fareClass: FareClass
+  seats: Int
+  percent: Float
 }
 
-func (ec *executionContext) _Query_flights(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_flights,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Flights(ctx, fc.Args["origin"].(*string), fc.Args["destination"].(*string), fc.Args["limit"].(*int))
-		},
-		nil,
-		ec.marshalNFlight2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightᚄ,
-		true,
-		true,
-	)
+input UpdateFlightStatusInput {
+  flightId: ID!
+  status: FlightStatus!
+  reason: String
 }
 
-func (ec *executionContext) fieldContext_Query_flights(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Flight_id(ctx, field)
-			case "flightNumber":
-				return ec.fieldContext_Flight_flightNumber(ctx, field)
-			case "origin":
-				return ec.fieldContext_Flight_origin(ctx, field)
-			case "destination":
-				return ec.fieldContext_Flight_destination(ctx, field)
-			case "departureTime":
-				return ec.fieldContext_Flight_departureTime(ctx, field)
-			case "arrivalTime":
-				return ec.fieldContext_Flight_arrivalTime(ctx, field)
-			case "aircraftType":
-				return ec.fieldContext_Flight_aircraftType(ctx, field)
-			case "totalSeats":
-				return ec.fieldContext_Flight_totalSeats(ctx, field)
-			case "availableSeats":
-				return ec.fieldContext_Flight_availableSeats(ctx, field)
-			case "status":
-				return ec.fieldContext_Flight_status(ctx, field)
-			case "fares":
-				return ec.fieldContext_Flight_fares(ctx, field)
-			case "bookings":
-				return ec.fieldContext_Flight_bookings(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_flights_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
+input DelayFlightInput {
+  flightId: ID!
+  newDeparture: Time!
+  reason: String
 }
 
-func (ec *executionContext) _Query_flight(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_flight,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Flight(ctx, fc.Args["id"].(string))
-		},
-		nil,
-		ec.marshalOFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
-		true,
-		false,
-	)
+input AssignGateInput {
+  flightId: ID!
+  gate: String!
 }
 
-func (ec *executionContext) fieldContext_Query_flight(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Flight_id(ctx, field)
-			case "flightNumber":
-				return ec.fieldContext_Flight_flightNumber(ctx, field)
-			case "origin":
-				return ec.fieldContext_Flight_origin(ctx, field)
-			case "destination":
-				return ec.fieldContext_Flight_destination(ctx, field)
-			case "departureTime":
-				return ec.fieldContext_Flight_departureTime(ctx, field)
-			case "arrivalTime":
-				return ec.fieldContext_Flight_arrivalTime(ctx, field)
-			case "aircraftType":
-				return ec.fieldContext_Flight_aircraftType(ctx, field)
-			case "totalSeats":
-				return ec.fieldContext_Flight_totalSeats(ctx, field)
-			case "availableSeats":
-				return ec.fieldContext_Flight_availableSeats(ctx, field)
-			case "status":
-				return ec.fieldContext_Flight_status(ctx, field)
-			case "fares":
-				return ec.fieldContext_Flight_fares(ctx, field)
-			case "bookings":
-				return ec.fieldContext_Flight_bookings(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
-		},
+"""
+Booking mutations act on bookings of the signed-in user or of the booking token
+returned by manageBooking, sent as the bearer token.
+"""
+type Mutation {
+  signup(input: SignupInput!): AuthPayload!
+  login(email: String!, password: String!): AuthPayload!
+  refreshToken(refreshToken: String!): AuthPayload!
+  logout(refreshToken: String!): Boolean!
+  "Looks up a booking by reference and passenger last name and returns a booking token, valid for 15 minutes."
+  manageBooking(bookingReference: String!, lastName: String!): ManagedBooking!
+  createBooking(input: CreateBookingInput!): CreateBookingPayload!
+  cancelBooking(bookingReference: String!): Booking!
+  "Cancels a booking for a passenger without their last name."
+  cancelBookingOnBehalf(bookingReference: String!): Booking! @hasRole(role: AGENT)
+  joinWaitlist(fareId: ID!, passenger: PassengerInput!): WaitlistEntry!
+  acceptWaitlistOffer(entryId: ID!, passengerEmail: String!): AcceptWaitlistOfferPayload!
+  updateFlightStatus(input: UpdateFlightStatusInput!): Flight! @hasRole(role: OPS)
+  delayFlight(input: DelayFlightInput!): Flight! @hasRole(role: OPS)
+  assignGate(input: AssignGateInput!): Flight! @hasRole(role: OPS)
+  checkIn(bookingReference: String!, document: PassengerDocumentInput): BoardingPass!
+  addPassengerDocument(bookingReference: String!, document: PassengerDocumentInput!): Booking!
+  setOverbookingLimit(input: SetOverbookingLimitInput!): Flight! @hasRole(role: OPS)
+  addAncillary(bookingReference: String!, productId: ID!, quantity: Int!): Booking!
+  "Changes the seat of a booking that bought seat selection, before check-in."
+  selectSeat(bookingReference: String!, seatNumber: String!): Booking!
+  volunteerForDeniedBoarding(bookingReference: String!, volunteer: Boolean!): Booking!
+}
+
+type Subscription {
+  flightStatusChanged(flightId: ID!): Flight!
+  bookingUpdated(bookingReference: String!): Booking!
+}
+
+scalar Time
+`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) dir_hasRole_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "role", ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole)
+	if err != nil {
+		return nil, err
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_flight_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	args["role"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_acceptWaitlistOffer_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "entryId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["entryId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "passengerEmail", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["passengerEmail"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Query_booking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_booking,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Booking(ctx, fc.Args["bookingReference"].(string))
-		},
-		nil,
-		ec.marshalOBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
-		true,
-		false,
-	)
+func (ec *executionContext) field_Mutation_addAncillary_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["bookingReference"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "productId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["productId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "quantity", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["quantity"] = arg2
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_Query_booking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Booking_id(ctx, field)
-			case "bookingReference":
-				return ec.fieldContext_Booking_bookingReference(ctx, field)
-			case "flightId":
-				return ec.fieldContext_Booking_flightId(ctx, field)
-			case "fareId":
-				return ec.fieldContext_Booking_fareId(ctx, field)
-			case "passengerName":
-				return ec.fieldContext_Booking_passengerName(ctx, field)
-			case "passengerEmail":
-				return ec.fieldContext_Booking_passengerEmail(ctx, field)
-			case "passengerPhone":
-				return ec.fieldContext_Booking_passengerPhone(ctx, field)
-			case "seatNumber":
-				return ec.fieldContext_Booking_seatNumber(ctx, field)
-			case "bookingStatus":
-				return ec.fieldContext_Booking_bookingStatus(ctx, field)
-			case "totalPrice":
-				return ec.fieldContext_Booking_totalPrice(ctx, field)
-			case "bookedAt":
-				return ec.fieldContext_Booking_bookedAt(ctx, field)
-			case "flight":
-				return ec.fieldContext_Booking_flight(ctx, field)
-			case "fare":
-				return ec.fieldContext_Booking_fare(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
-		},
+func (ec *executionContext) field_Mutation_addPassengerDocument_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_booking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	args["bookingReference"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "document", ec.unmarshalNPassengerDocumentInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerDocumentInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["document"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Query_bookings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_bookings,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Bookings(ctx, fc.Args["passengerEmail"].(*string), fc.Args["limit"].(*int))
-		},
-		nil,
-		ec.marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_assignGate_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNAssignGateInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAssignGateInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_Query_bookings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Booking_id(ctx, field)
-			case "bookingReference":
-				return ec.fieldContext_Booking_bookingReference(ctx, field)
-			case "flightId":
-				return ec.fieldContext_Booking_flightId(ctx, field)
-			case "fareId":
-				return ec.fieldContext_Booking_fareId(ctx, field)
-			case "passengerName":
-				return ec.fieldContext_Booking_passengerName(ctx, field)
-			case "passengerEmail":
-				return ec.fieldContext_Booking_passengerEmail(ctx, field)
-			case "passengerPhone":
-				return ec.fieldContext_Booking_passengerPhone(ctx, field)
-			case "seatNumber":
-				return ec.fieldContext_Booking_seatNumber(ctx, field)
-			case "bookingStatus":
-				return ec.fieldContext_Booking_bookingStatus(ctx, field)
-			case "totalPrice":
-				return ec.fieldContext_Booking_totalPrice(ctx, field)
-			case "bookedAt":
-				return ec.fieldContext_Booking_bookedAt(ctx, field)
-			case "flight":
-				return ec.fieldContext_Booking_flight(ctx, field)
-			case "fare":
-				return ec.fieldContext_Booking_fare(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_bookings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+func (ec *executionContext) field_Mutation_cancelBookingOnBehalf_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["bookingReference"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _Query_airports(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_airports,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Airports(ctx)
-		},
-		nil,
-		ec.marshalNString2ᚕstringᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_cancelBooking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["bookingReference"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_Query_airports(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_checkIn_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["bookingReference"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "document", ec.unmarshalOPassengerDocumentInput2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerDocumentInput)
+	if err != nil {
+		return nil, err
+	}
+	args["document"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___type,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+func (ec *executionContext) field_Mutation_createBooking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNCreateBookingInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐCreateBookingInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+func (ec *executionContext) field_Mutation_delayFlight_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNDelayFlightInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐDelayFlightInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___schema,
-		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
-		},
-		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
-		true,
-		false,
-	)
+func (ec *executionContext) field_Mutation_joinWaitlist_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "fareId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["fareId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "passenger", ec.unmarshalNPassengerInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerInput)
+	if err != nil {
+		return nil, err
+	}
+	args["passenger"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-		},
+func (ec *executionContext) field_Mutation_login_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "email", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["email"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "password", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["password"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_logout_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "refreshToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["refreshToken"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_manageBooking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["bookingReference"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "lastName", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["lastName"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+func (ec *executionContext) field_Mutation_refreshToken_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "refreshToken", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["refreshToken"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_selectSeat_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["bookingReference"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "seatNumber", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["seatNumber"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_isRepeatable,
-		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_setOverbookingLimit_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSetOverbookingLimitInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐSetOverbookingLimitInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_signup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNSignupInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐSignupInput)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_locations,
-		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
-		},
-		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Mutation_updateFlightStatus_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNUpdateFlightStatusInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐUpdateFlightStatusInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
-		},
+func (ec *executionContext) field_Mutation_volunteerForDeniedBoarding_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["bookingReference"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "volunteer", ec.unmarshalNBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["volunteer"] = arg1
+	return args, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+func (ec *executionContext) field_Query_ancillaryOffers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "flightId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["flightId"] = arg0
+	return args, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) field_Query_booking_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["bookingReference"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_bookings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "passengerEmail", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["passengerEmail"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_deniedBoardingList_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "flightId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["flightId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_disruptionReport_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "flightId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["flightId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_flightManifest_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "flightId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["flightId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_flight_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_flights_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "origin", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["origin"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "destination", ec.unmarshalOString2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["destination"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_bookingUpdated_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "bookingReference", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["bookingReference"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_flightStatusChanged_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "flightId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["flightId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AcceptWaitlistOfferPayload_booking(ctx context.Context, field graphql.CollectedField, obj *AcceptWaitlistOfferPayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_name,
+		ec.fieldContext_AcceptWaitlistOfferPayload_booking,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Booking, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AcceptWaitlistOfferPayload_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AcceptWaitlistOfferPayload",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AcceptWaitlistOfferPayload_token(ctx context.Context, field graphql.CollectedField, obj *AcceptWaitlistOfferPayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_description,
+		ec.fieldContext_AcceptWaitlistOfferPayload_token,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Token, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -2600,11 +2715,11 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AcceptWaitlistOfferPayload_token(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AcceptWaitlistOfferPayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -2613,295 +2728,264 @@ func (ec *executionContext) fieldContext___EnumValue_description(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AcceptWaitlistOfferPayload_expiresAt(ctx context.Context, field graphql.CollectedField, obj *AcceptWaitlistOfferPayload) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_isDeprecated,
+		ec.fieldContext_AcceptWaitlistOfferPayload_expiresAt,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.ExpiresAt, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AcceptWaitlistOfferPayload_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AcceptWaitlistOfferPayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryOffer_product(ctx context.Context, field graphql.CollectedField, obj *AncillaryOffer) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___EnumValue_deprecationReason,
+		ec.fieldContext_AncillaryOffer_product,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.Product, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNAncillaryProduct2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐAncillaryProduct,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryOffer_product(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "AncillaryOffer",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AncillaryProduct_id(ctx, field)
+			case "code":
+				return ec.fieldContext_AncillaryProduct_code(ctx, field)
+			case "name":
+				return ec.fieldContext_AncillaryProduct_name(ctx, field)
+			case "category":
+				return ec.fieldContext_AncillaryProduct_category(ctx, field)
+			case "description":
+				return ec.fieldContext_AncillaryProduct_description(ctx, field)
+			case "basePrice":
+				return ec.fieldContext_AncillaryProduct_basePrice(ctx, field)
+			case "maxPerBooking":
+				return ec.fieldContext_AncillaryProduct_maxPerBooking(ctx, field)
+			case "allowedFareClasses":
+				return ec.fieldContext_AncillaryProduct_allowedFareClasses(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AncillaryProduct", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryOffer_price(ctx context.Context, field graphql.CollectedField, obj *AncillaryOffer) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_name,
+		ec.fieldContext_AncillaryOffer_price,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Price, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNFloat2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryOffer_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AncillaryOffer",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryOffer_remaining(ctx context.Context, field graphql.CollectedField, obj *AncillaryOffer) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_description,
+		ec.fieldContext_AncillaryOffer_remaining,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.Remaining, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOInt2ᚖint,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryOffer_remaining(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AncillaryOffer",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryProduct_id(ctx context.Context, field graphql.CollectedField, obj *model.AncillaryProduct) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_args,
+		ec.fieldContext_AncillaryProduct_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryProduct_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AncillaryProduct",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryProduct_code(ctx context.Context, field graphql.CollectedField, obj *model.AncillaryProduct) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_type,
+		ec.fieldContext_AncillaryProduct_code,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.Code, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryProduct_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AncillaryProduct",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryProduct_name(ctx context.Context, field graphql.CollectedField, obj *model.AncillaryProduct) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_isDeprecated,
+		ec.fieldContext_AncillaryProduct_name,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.Name, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryProduct_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AncillaryProduct",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryProduct_category(ctx context.Context, field graphql.CollectedField, obj *model.AncillaryProduct) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Field_deprecationReason,
+		ec.fieldContext_AncillaryProduct_category,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.Category, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNAncillaryCategory2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐAncillaryCategory,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryProduct_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "AncillaryProduct",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type AncillaryCategory does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryProduct_description(ctx context.Context, field graphql.CollectedField, obj *model.AncillaryProduct) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_name,
+		ec.fieldContext_AncillaryProduct_description,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Description, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2910,9 +2994,9 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryProduct_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AncillaryProduct",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2923,196 +3007,172 @@ func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryProduct_basePrice(ctx context.Context, field graphql.CollectedField, obj *model.AncillaryProduct) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_description,
+		ec.fieldContext_AncillaryProduct_basePrice,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.BasePrice, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNFloat2float64,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryProduct_basePrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AncillaryProduct",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryProduct_maxPerBooking(ctx context.Context, field graphql.CollectedField, obj *model.AncillaryProduct) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_type,
+		ec.fieldContext_AncillaryProduct_maxPerBooking,
 		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
+			return obj.MaxPerBooking, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryProduct_maxPerBooking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AncillaryProduct",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AncillaryProduct_allowedFareClasses(ctx context.Context, field graphql.CollectedField, obj *model.AncillaryProduct) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_defaultValue,
+		ec.fieldContext_AncillaryProduct_allowedFareClasses,
 		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
+			return obj.AllowedFareClasses(), nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNFareClass2ᚕgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClassᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AncillaryProduct_allowedFareClasses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AncillaryProduct",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type FareClass does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuthPayload_accessToken(ctx context.Context, field graphql.CollectedField, obj *auth.Session) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_isDeprecated,
+		ec.fieldContext_AuthPayload_accessToken,
 		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
+			return obj.AccessToken, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuthPayload_accessToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AuthPayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuthPayload_accessTokenExpiresAt(ctx context.Context, field graphql.CollectedField, obj *auth.Session) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___InputValue_deprecationReason,
+		ec.fieldContext_AuthPayload_accessTokenExpiresAt,
 		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
+			return obj.AccessTokenExpiresAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNTime2timeᚐTime,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuthPayload_accessTokenExpiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "AuthPayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuthPayload_refreshToken(ctx context.Context, field graphql.CollectedField, obj *auth.Session) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_description,
+		ec.fieldContext_AuthPayload_refreshToken,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.RefreshToken, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuthPayload_refreshToken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "AuthPayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -3121,309 +3181,291 @@ func (ec *executionContext) fieldContext___Schema_description(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _AuthPayload_user(ctx context.Context, field graphql.CollectedField, obj *auth.Session) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_types,
+		ec.fieldContext_AuthPayload_user,
 		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
+			return obj.User, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNUser2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐUser,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AuthPayload_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "AuthPayload",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				return ec.fieldContext_User_name(ctx, field)
+			case "roles":
+				return ec.fieldContext_User_roles(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "bookings":
+				return ec.fieldContext_User_bookings(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _BoardingPass_booking(ctx context.Context, field graphql.CollectedField, obj *BoardingPass) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_queryType,
+		ec.fieldContext_BoardingPass_booking,
 		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
+			return obj.Booking, nil
 		},
 		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BoardingPass_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "BoardingPass",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _BoardingPass_flight(ctx context.Context, field graphql.CollectedField, obj *BoardingPass) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_mutationType,
+		ec.fieldContext_BoardingPass_flight,
 		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
+			return obj.Flight, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BoardingPass_flight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "BoardingPass",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _BoardingPass_seatNumber(ctx context.Context, field graphql.CollectedField, obj *BoardingPass) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_subscriptionType,
+		ec.fieldContext_BoardingPass_seatNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
+			return obj.SeatNumber, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BoardingPass_seatNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "BoardingPass",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _BoardingPass_sequenceNumber(ctx context.Context, field graphql.CollectedField, obj *BoardingPass) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Schema_directives,
+		ec.fieldContext_BoardingPass_sequenceNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
+			return obj.SequenceNumber, nil
 		},
 		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BoardingPass_sequenceNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "BoardingPass",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _BoardingPass_barcode(ctx context.Context, field graphql.CollectedField, obj *BoardingPass) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_kind,
+		ec.fieldContext_BoardingPass_barcode,
 		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
+			return obj.Barcode, nil
 		},
 		nil,
-		ec.marshalN__TypeKind2string,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BoardingPass_barcode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "BoardingPass",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _BoardingPass_downloadUrl(ctx context.Context, field graphql.CollectedField, obj *BoardingPass) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_name,
+		ec.fieldContext_BoardingPass_downloadUrl,
 		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
+			return obj.DownloadURL, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_BoardingPass_downloadUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "BoardingPass",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -3432,56 +3474,56 @@ func (ec *executionContext) fieldContext___Type_name(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Booking_id(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_description,
+		ec.fieldContext_Booking_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Booking_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Booking",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Booking_bookingReference(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_specifiedByURL,
+		ec.fieldContext_Booking_bookingReference,
 		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
+			return obj.BookingReference, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Booking_bookingReference(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Booking",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -3490,478 +3532,10538 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Booking_flightId(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_fields,
+		ec.fieldContext_Booking_flightId,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+			return obj.FlightID, nil
 		},
 		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Booking_flightId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Booking",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Booking_fareId(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_interfaces,
+		ec.fieldContext_Booking_fareId,
 		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
+			return obj.FareID, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_fareId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_passengerName(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_passengerName,
+		func(ctx context.Context) (any, error) {
+			return obj.PassengerName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_passengerName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_passengerEmail(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_passengerEmail,
+		func(ctx context.Context) (any, error) {
+			return obj.PassengerEmail, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_passengerEmail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_passengerPhone(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_passengerPhone,
+		func(ctx context.Context) (any, error) {
+			return obj.PassengerPhone, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Booking_passengerPhone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Booking",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Booking_seatNumber(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext___Type_possibleTypes,
+		ec.fieldContext_Booking_seatNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
+			return obj.SeatNumber, nil
 		},
 		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		ec.marshalOString2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Booking_seatNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Booking",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_specialRequests(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_specialRequests,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecialRequests, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_specialRequests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_bookingStatus(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_bookingStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.BookingStatus, nil
+		},
+		nil,
+		ec.marshalNBookingStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_bookingStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BookingStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_totalPrice(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_totalPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPrice, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_totalPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_bookedAt(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_bookedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.BookedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_bookedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_checkedInAt(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_checkedInAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CheckedInAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_checkedInAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_deniedBoardingVolunteer(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_deniedBoardingVolunteer,
+		func(ctx context.Context) (any, error) {
+			return obj.DeniedBoardingVolunteer, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_deniedBoardingVolunteer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_ancillaries(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_ancillaries,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Booking().Ancillaries(ctx, obj)
+		},
+		nil,
+		ec.marshalNBookingAncillary2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingAncillaryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_ancillaries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_BookingAncillary_id(ctx, field)
+			case "product":
+				return ec.fieldContext_BookingAncillary_product(ctx, field)
+			case "quantity":
+				return ec.fieldContext_BookingAncillary_quantity(ctx, field)
+			case "unitPrice":
+				return ec.fieldContext_BookingAncillary_unitPrice(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_BookingAncillary_totalPrice(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_BookingAncillary_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BookingAncillary", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_document(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_document,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Booking().Document(ctx, obj)
+		},
+		nil,
+		ec.marshalOPassengerDocument2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerDocument,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_document(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "documentType":
+				return ec.fieldContext_PassengerDocument_documentType(ctx, field)
+			case "maskedNumber":
+				return ec.fieldContext_PassengerDocument_maskedNumber(ctx, field)
+			case "nationality":
+				return ec.fieldContext_PassengerDocument_nationality(ctx, field)
+			case "issuingCountry":
+				return ec.fieldContext_PassengerDocument_issuingCountry(ctx, field)
+			case "expiryDate":
+				return ec.fieldContext_PassengerDocument_expiryDate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PassengerDocument", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_flight(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_flight,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Booking().Flight(ctx, obj)
+		},
+		nil,
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_flight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Booking_fare(ctx context.Context, field graphql.CollectedField, obj *model.Booking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Booking_fare,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Booking().Fare(ctx, obj)
+		},
+		nil,
+		ec.marshalNFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Booking_fare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Booking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Fare_id(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Fare_flightId(ctx, field)
+			case "fareClass":
+				return ec.fieldContext_Fare_fareClass(ctx, field)
+			case "price":
+				return ec.fieldContext_Fare_price(ctx, field)
+			case "baggageAllowance":
+				return ec.fieldContext_Fare_baggageAllowance(ctx, field)
+			case "isRefundable":
+				return ec.fieldContext_Fare_isRefundable(ctx, field)
+			case "isChangeable":
+				return ec.fieldContext_Fare_isChangeable(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Fare_availableSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Fare_overbookingLimit(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Fare_oversoldSeats(ctx, field)
+			case "waitlistCount":
+				return ec.fieldContext_Fare_waitlistCount(ctx, field)
+			case "flight":
+				return ec.fieldContext_Fare_flight(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Fare_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Fare", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BookingAncillary_id(ctx context.Context, field graphql.CollectedField, obj *model.BookingAncillary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BookingAncillary_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BookingAncillary_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BookingAncillary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BookingAncillary_product(ctx context.Context, field graphql.CollectedField, obj *model.BookingAncillary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BookingAncillary_product,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.BookingAncillary().Product(ctx, obj)
+		},
+		nil,
+		ec.marshalNAncillaryProduct2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐAncillaryProduct,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BookingAncillary_product(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BookingAncillary",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AncillaryProduct_id(ctx, field)
+			case "code":
+				return ec.fieldContext_AncillaryProduct_code(ctx, field)
+			case "name":
+				return ec.fieldContext_AncillaryProduct_name(ctx, field)
+			case "category":
+				return ec.fieldContext_AncillaryProduct_category(ctx, field)
+			case "description":
+				return ec.fieldContext_AncillaryProduct_description(ctx, field)
+			case "basePrice":
+				return ec.fieldContext_AncillaryProduct_basePrice(ctx, field)
+			case "maxPerBooking":
+				return ec.fieldContext_AncillaryProduct_maxPerBooking(ctx, field)
+			case "allowedFareClasses":
+				return ec.fieldContext_AncillaryProduct_allowedFareClasses(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AncillaryProduct", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BookingAncillary_quantity(ctx context.Context, field graphql.CollectedField, obj *model.BookingAncillary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BookingAncillary_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BookingAncillary_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BookingAncillary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BookingAncillary_unitPrice(ctx context.Context, field graphql.CollectedField, obj *model.BookingAncillary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BookingAncillary_unitPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.UnitPrice, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BookingAncillary_unitPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BookingAncillary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BookingAncillary_totalPrice(ctx context.Context, field graphql.CollectedField, obj *model.BookingAncillary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BookingAncillary_totalPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPrice, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BookingAncillary_totalPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BookingAncillary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BookingAncillary_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.BookingAncillary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BookingAncillary_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BookingAncillary_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BookingAncillary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateBookingPayload_booking(ctx context.Context, field graphql.CollectedField, obj *CreateBookingPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateBookingPayload_booking,
+		func(ctx context.Context) (any, error) {
+			return obj.Booking, nil
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateBookingPayload_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateBookingPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateBookingPayload_token(ctx context.Context, field graphql.CollectedField, obj *CreateBookingPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateBookingPayload_token,
+		func(ctx context.Context) (any, error) {
+			return obj.Token, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateBookingPayload_token(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateBookingPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CreateBookingPayload_expiresAt(ctx context.Context, field graphql.CollectedField, obj *CreateBookingPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CreateBookingPayload_expiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiresAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_CreateBookingPayload_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CreateBookingPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeniedBoardingList_flight(ctx context.Context, field graphql.CollectedField, obj *DeniedBoardingList) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeniedBoardingList_flight,
+		func(ctx context.Context) (any, error) {
+			return obj.Flight, nil
+		},
+		nil,
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeniedBoardingList_flight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeniedBoardingList",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeniedBoardingList_oversoldSeats(ctx context.Context, field graphql.CollectedField, obj *DeniedBoardingList) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeniedBoardingList_oversoldSeats,
+		func(ctx context.Context) (any, error) {
+			return obj.OversoldSeats, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeniedBoardingList_oversoldSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeniedBoardingList",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeniedBoardingList_volunteers(ctx context.Context, field graphql.CollectedField, obj *DeniedBoardingList) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeniedBoardingList_volunteers,
+		func(ctx context.Context) (any, error) {
+			return obj.Volunteers, nil
+		},
+		nil,
+		ec.marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeniedBoardingList_volunteers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeniedBoardingList",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DeniedBoardingList_candidates(ctx context.Context, field graphql.CollectedField, obj *DeniedBoardingList) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DeniedBoardingList_candidates,
+		func(ctx context.Context) (any, error) {
+			return obj.Candidates, nil
+		},
+		nil,
+		ec.marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DeniedBoardingList_candidates(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DeniedBoardingList",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DisruptionReport_flight(ctx context.Context, field graphql.CollectedField, obj *DisruptionReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DisruptionReport_flight,
+		func(ctx context.Context) (any, error) {
+			return obj.Flight, nil
+		},
+		nil,
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DisruptionReport_flight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DisruptionReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DisruptionReport_totalAffected(ctx context.Context, field graphql.CollectedField, obj *DisruptionReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DisruptionReport_totalAffected,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAffected, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DisruptionReport_totalAffected(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DisruptionReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DisruptionReport_rebookedCount(ctx context.Context, field graphql.CollectedField, obj *DisruptionReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DisruptionReport_rebookedCount,
+		func(ctx context.Context) (any, error) {
+			return obj.RebookedCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DisruptionReport_rebookedCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DisruptionReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DisruptionReport_unaccommodatedCount(ctx context.Context, field graphql.CollectedField, obj *DisruptionReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DisruptionReport_unaccommodatedCount,
+		func(ctx context.Context) (any, error) {
+			return obj.UnaccommodatedCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DisruptionReport_unaccommodatedCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DisruptionReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DisruptionReport_rebookings(ctx context.Context, field graphql.CollectedField, obj *DisruptionReport) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DisruptionReport_rebookings,
+		func(ctx context.Context) (any, error) {
+			return obj.Rebookings, nil
+		},
+		nil,
+		ec.marshalNRebooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRebookingᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DisruptionReport_rebookings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DisruptionReport",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Rebooking_id(ctx, field)
+			case "booking":
+				return ec.fieldContext_Rebooking_booking(ctx, field)
+			case "originalFlight":
+				return ec.fieldContext_Rebooking_originalFlight(ctx, field)
+			case "originalFare":
+				return ec.fieldContext_Rebooking_originalFare(ctx, field)
+			case "newFlight":
+				return ec.fieldContext_Rebooking_newFlight(ctx, field)
+			case "newFare":
+				return ec.fieldContext_Rebooking_newFare(ctx, field)
+			case "outcome":
+				return ec.fieldContext_Rebooking_outcome(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_Rebooking_processedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Rebooking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_id(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_flightId(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_flightId,
+		func(ctx context.Context) (any, error) {
+			return obj.FlightID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_flightId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_fareClass(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_fareClass,
+		func(ctx context.Context) (any, error) {
+			return obj.FareClass, nil
+		},
+		nil,
+		ec.marshalNFareClass2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_fareClass(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FareClass does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_price(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_price,
+		func(ctx context.Context) (any, error) {
+			return obj.Price, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_baggageAllowance(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_baggageAllowance,
+		func(ctx context.Context) (any, error) {
+			return obj.BaggageAllowance, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_baggageAllowance(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_isRefundable(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_isRefundable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRefundable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_isRefundable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_isChangeable(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_isChangeable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsChangeable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_isChangeable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_availableSeats(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_availableSeats,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Fare().AvailableSeats(ctx, obj)
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_availableSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_overbookingLimit(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_overbookingLimit,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Fare().OverbookingLimit(ctx, obj)
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_overbookingLimit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_oversoldSeats(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_oversoldSeats,
+		func(ctx context.Context) (any, error) {
+			return obj.OversoldSeats(), nil
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "OPS")
+				if err != nil {
+					var zeroVal int
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal int
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, obj, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOInt2int,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_oversoldSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_waitlistCount(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_waitlistCount,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Fare().WaitlistCount(ctx, obj)
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_waitlistCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_flight(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_flight,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Fare().Flight(ctx, obj)
+		},
+		nil,
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_flight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Fare_bookings(ctx context.Context, field graphql.CollectedField, obj *model.Fare) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Fare_bookings,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Fare().Bookings(ctx, obj)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "AGENT")
+				if err != nil {
+					var zeroVal []*model.Booking
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal []*model.Booking
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, obj, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Fare_bookings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Fare",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_id(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_flightNumber(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_flightNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.FlightNumber, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_flightNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_origin(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_origin,
+		func(ctx context.Context) (any, error) {
+			return obj.Origin, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_origin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_destination(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_destination,
+		func(ctx context.Context) (any, error) {
+			return obj.Destination, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_destination(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_departureTime(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_departureTime,
+		func(ctx context.Context) (any, error) {
+			return obj.DepartureTime, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_departureTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_arrivalTime(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_arrivalTime,
+		func(ctx context.Context) (any, error) {
+			return obj.ArrivalTime, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_arrivalTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_aircraftType(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_aircraftType,
+		func(ctx context.Context) (any, error) {
+			return obj.AircraftType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_aircraftType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_totalSeats(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_totalSeats,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalSeats, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_totalSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_availableSeats(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_availableSeats,
+		func(ctx context.Context) (any, error) {
+			return obj.AvailableSeats, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_availableSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_status(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNFlightStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FlightStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_gate(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_gate,
+		func(ctx context.Context) (any, error) {
+			return obj.Gate, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_gate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_delayMinutes(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_delayMinutes,
+		func(ctx context.Context) (any, error) {
+			return obj.DelayMinutes, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_delayMinutes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_bookedSeats(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_bookedSeats,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Flight().BookedSeats(ctx, obj)
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_bookedSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_oversoldSeats(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_oversoldSeats,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Flight().OversoldSeats(ctx, obj)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "OPS")
+				if err != nil {
+					var zeroVal *int
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *int
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, obj, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_oversoldSeats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_overbookingLimit(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_overbookingLimit,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Flight().OverbookingLimit(ctx, obj)
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_overbookingLimit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_statusHistory(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_statusHistory,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Flight().StatusHistory(ctx, obj)
+		},
+		nil,
+		ec.marshalNFlightEvent2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightEventᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_statusHistory(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FlightEvent_id(ctx, field)
+			case "flightId":
+				return ec.fieldContext_FlightEvent_flightId(ctx, field)
+			case "eventType":
+				return ec.fieldContext_FlightEvent_eventType(ctx, field)
+			case "previousStatus":
+				return ec.fieldContext_FlightEvent_previousStatus(ctx, field)
+			case "newStatus":
+				return ec.fieldContext_FlightEvent_newStatus(ctx, field)
+			case "gate":
+				return ec.fieldContext_FlightEvent_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_FlightEvent_delayMinutes(ctx, field)
+			case "reason":
+				return ec.fieldContext_FlightEvent_reason(ctx, field)
+			case "actor":
+				return ec.fieldContext_FlightEvent_actor(ctx, field)
+			case "occurredAt":
+				return ec.fieldContext_FlightEvent_occurredAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FlightEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_fares(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_fares,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Flight().Fares(ctx, obj)
+		},
+		nil,
+		ec.marshalNFare2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_fares(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Fare_id(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Fare_flightId(ctx, field)
+			case "fareClass":
+				return ec.fieldContext_Fare_fareClass(ctx, field)
+			case "price":
+				return ec.fieldContext_Fare_price(ctx, field)
+			case "baggageAllowance":
+				return ec.fieldContext_Fare_baggageAllowance(ctx, field)
+			case "isRefundable":
+				return ec.fieldContext_Fare_isRefundable(ctx, field)
+			case "isChangeable":
+				return ec.fieldContext_Fare_isChangeable(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Fare_availableSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Fare_overbookingLimit(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Fare_oversoldSeats(ctx, field)
+			case "waitlistCount":
+				return ec.fieldContext_Fare_waitlistCount(ctx, field)
+			case "flight":
+				return ec.fieldContext_Fare_flight(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Fare_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Fare", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Flight_bookings(ctx context.Context, field graphql.CollectedField, obj *model.Flight) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Flight_bookings,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Flight().Bookings(ctx, obj)
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "AGENT")
+				if err != nil {
+					var zeroVal []*model.Booking
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal []*model.Booking
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, obj, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Flight_bookings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Flight",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_id(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_flightId(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_flightId,
+		func(ctx context.Context) (any, error) {
+			return obj.FlightID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_flightId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_eventType(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_eventType,
+		func(ctx context.Context) (any, error) {
+			return obj.EventType, nil
+		},
+		nil,
+		ec.marshalNFlightEventType2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightEventType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_eventType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FlightEventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_previousStatus(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_previousStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.PreviousStatus, nil
+		},
+		nil,
+		ec.marshalOFlightStatus2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightStatus,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_previousStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FlightStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_newStatus(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_newStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.NewStatus, nil
+		},
+		nil,
+		ec.marshalOFlightStatus2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightStatus,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_newStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FlightStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_gate(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_gate,
+		func(ctx context.Context) (any, error) {
+			return obj.Gate, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_gate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_delayMinutes(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_delayMinutes,
+		func(ctx context.Context) (any, error) {
+			return obj.DelayMinutes, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_delayMinutes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_reason(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_actor(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_actor,
+		func(ctx context.Context) (any, error) {
+			return obj.Actor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_actor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightEvent_occurredAt(ctx context.Context, field graphql.CollectedField, obj *model.FlightEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightEvent_occurredAt,
+		func(ctx context.Context) (any, error) {
+			return obj.OccurredAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightEvent_occurredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightManifest_flight(ctx context.Context, field graphql.CollectedField, obj *FlightManifest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightManifest_flight,
+		func(ctx context.Context) (any, error) {
+			return obj.Flight, nil
+		},
+		nil,
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightManifest_flight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightManifest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightManifest_entries(ctx context.Context, field graphql.CollectedField, obj *FlightManifest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightManifest_entries,
+		func(ctx context.Context) (any, error) {
+			return obj.Entries, nil
+		},
+		nil,
+		ec.marshalNManifestEntry2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋmanifestᚐEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightManifest_entries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightManifest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "seatNumber":
+				return ec.fieldContext_ManifestEntry_seatNumber(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_ManifestEntry_passengerName(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_ManifestEntry_bookingReference(ctx, field)
+			case "fareClass":
+				return ec.fieldContext_ManifestEntry_fareClass(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_ManifestEntry_bookingStatus(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_ManifestEntry_specialRequests(ctx, field)
+			case "booking":
+				return ec.fieldContext_ManifestEntry_booking(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ManifestEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FlightManifest_downloadUrl(ctx context.Context, field graphql.CollectedField, obj *FlightManifest) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FlightManifest_downloadUrl,
+		func(ctx context.Context) (any, error) {
+			return obj.DownloadURL, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FlightManifest_downloadUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FlightManifest",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManagedBooking_booking(ctx context.Context, field graphql.CollectedField, obj *auth.BookingSession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManagedBooking_booking,
+		func(ctx context.Context) (any, error) {
+			return obj.Booking, nil
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManagedBooking_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManagedBooking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManagedBooking_token(ctx context.Context, field graphql.CollectedField, obj *auth.BookingSession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManagedBooking_token,
+		func(ctx context.Context) (any, error) {
+			return obj.Token, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManagedBooking_token(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManagedBooking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManagedBooking_expiresAt(ctx context.Context, field graphql.CollectedField, obj *auth.BookingSession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManagedBooking_expiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiresAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManagedBooking_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManagedBooking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManifestEntry_seatNumber(ctx context.Context, field graphql.CollectedField, obj *manifest.Entry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManifestEntry_seatNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.SeatNumber, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManifestEntry_seatNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManifestEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManifestEntry_passengerName(ctx context.Context, field graphql.CollectedField, obj *manifest.Entry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManifestEntry_passengerName,
+		func(ctx context.Context) (any, error) {
+			return obj.PassengerName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManifestEntry_passengerName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManifestEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManifestEntry_bookingReference(ctx context.Context, field graphql.CollectedField, obj *manifest.Entry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManifestEntry_bookingReference,
+		func(ctx context.Context) (any, error) {
+			return obj.BookingReference, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManifestEntry_bookingReference(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManifestEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManifestEntry_fareClass(ctx context.Context, field graphql.CollectedField, obj *manifest.Entry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManifestEntry_fareClass,
+		func(ctx context.Context) (any, error) {
+			return obj.FareClass, nil
+		},
+		nil,
+		ec.marshalNFareClass2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManifestEntry_fareClass(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManifestEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type FareClass does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManifestEntry_bookingStatus(ctx context.Context, field graphql.CollectedField, obj *manifest.Entry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManifestEntry_bookingStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.BookingStatus, nil
+		},
+		nil,
+		ec.marshalNBookingStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManifestEntry_bookingStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManifestEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BookingStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManifestEntry_specialRequests(ctx context.Context, field graphql.CollectedField, obj *manifest.Entry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManifestEntry_specialRequests,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecialRequests, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManifestEntry_specialRequests(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManifestEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ManifestEntry_booking(ctx context.Context, field graphql.CollectedField, obj *manifest.Entry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ManifestEntry_booking,
+		func(ctx context.Context) (any, error) {
+			return obj.Booking, nil
+		},
+		nil,
+		ec.marshalNBooking2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ManifestEntry_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ManifestEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_signup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_signup,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Signup(ctx, fc.Args["input"].(SignupInput))
+		},
+		nil,
+		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋauthᚐSession,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_signup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accessToken":
+				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
+			case "accessTokenExpiresAt":
+				return ec.fieldContext_AuthPayload_accessTokenExpiresAt(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_signup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_login(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_login,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Login(ctx, fc.Args["email"].(string), fc.Args["password"].(string))
+		},
+		nil,
+		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋauthᚐSession,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_login(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accessToken":
+				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
+			case "accessTokenExpiresAt":
+				return ec.fieldContext_AuthPayload_accessTokenExpiresAt(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_login_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_refreshToken,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RefreshToken(ctx, fc.Args["refreshToken"].(string))
+		},
+		nil,
+		ec.marshalNAuthPayload2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋauthᚐSession,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_refreshToken(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "accessToken":
+				return ec.fieldContext_AuthPayload_accessToken(ctx, field)
+			case "accessTokenExpiresAt":
+				return ec.fieldContext_AuthPayload_accessTokenExpiresAt(ctx, field)
+			case "refreshToken":
+				return ec.fieldContext_AuthPayload_refreshToken(ctx, field)
+			case "user":
+				return ec.fieldContext_AuthPayload_user(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AuthPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_refreshToken_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_logout,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().Logout(ctx, fc.Args["refreshToken"].(string))
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_logout(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_logout_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_manageBooking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_manageBooking,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ManageBooking(ctx, fc.Args["bookingReference"].(string), fc.Args["lastName"].(string))
+		},
+		nil,
+		ec.marshalNManagedBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋauthᚐBookingSession,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_manageBooking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "booking":
+				return ec.fieldContext_ManagedBooking_booking(ctx, field)
+			case "token":
+				return ec.fieldContext_ManagedBooking_token(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_ManagedBooking_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ManagedBooking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_manageBooking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_createBooking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_createBooking,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CreateBooking(ctx, fc.Args["input"].(CreateBookingInput))
+		},
+		nil,
+		ec.marshalNCreateBookingPayload2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐCreateBookingPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_createBooking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "booking":
+				return ec.fieldContext_CreateBookingPayload_booking(ctx, field)
+			case "token":
+				return ec.fieldContext_CreateBookingPayload_token(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_CreateBookingPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CreateBookingPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createBooking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_cancelBooking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_cancelBooking,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CancelBooking(ctx, fc.Args["bookingReference"].(string))
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_cancelBooking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelBooking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_cancelBookingOnBehalf(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_cancelBookingOnBehalf,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CancelBookingOnBehalf(ctx, fc.Args["bookingReference"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "AGENT")
+				if err != nil {
+					var zeroVal *model.Booking
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *model.Booking
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, nil, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_cancelBookingOnBehalf(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelBookingOnBehalf_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_joinWaitlist(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_joinWaitlist,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().JoinWaitlist(ctx, fc.Args["fareId"].(string), fc.Args["passenger"].(PassengerInput))
+		},
+		nil,
+		ec.marshalNWaitlistEntry2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐWaitlistEntry,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_joinWaitlist(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WaitlistEntry_id(ctx, field)
+			case "fare":
+				return ec.fieldContext_WaitlistEntry_fare(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_WaitlistEntry_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_WaitlistEntry_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_WaitlistEntry_passengerPhone(ctx, field)
+			case "status":
+				return ec.fieldContext_WaitlistEntry_status(ctx, field)
+			case "position":
+				return ec.fieldContext_WaitlistEntry_position(ctx, field)
+			case "offerExpiresAt":
+				return ec.fieldContext_WaitlistEntry_offerExpiresAt(ctx, field)
+			case "booking":
+				return ec.fieldContext_WaitlistEntry_booking(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WaitlistEntry_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WaitlistEntry", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_joinWaitlist_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_acceptWaitlistOffer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_acceptWaitlistOffer,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AcceptWaitlistOffer(ctx, fc.Args["entryId"].(string), fc.Args["passengerEmail"].(string))
+		},
+		nil,
+		ec.marshalNAcceptWaitlistOfferPayload2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAcceptWaitlistOfferPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_acceptWaitlistOffer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "booking":
+				return ec.fieldContext_AcceptWaitlistOfferPayload_booking(ctx, field)
+			case "token":
+				return ec.fieldContext_AcceptWaitlistOfferPayload_token(ctx, field)
+			case "expiresAt":
+				return ec.fieldContext_AcceptWaitlistOfferPayload_expiresAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AcceptWaitlistOfferPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_acceptWaitlistOffer_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_updateFlightStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_updateFlightStatus,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().UpdateFlightStatus(ctx, fc.Args["input"].(UpdateFlightStatusInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "OPS")
+				if err != nil {
+					var zeroVal *model.Flight
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *model.Flight
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, nil, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_updateFlightStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateFlightStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_delayFlight(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_delayFlight,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DelayFlight(ctx, fc.Args["input"].(DelayFlightInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "OPS")
+				if err != nil {
+					var zeroVal *model.Flight
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *model.Flight
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, nil, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_delayFlight(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_delayFlight_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_assignGate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_assignGate,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AssignGate(ctx, fc.Args["input"].(AssignGateInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "OPS")
+				if err != nil {
+					var zeroVal *model.Flight
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *model.Flight
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, nil, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_assignGate(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_assignGate_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_checkIn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_checkIn,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CheckIn(ctx, fc.Args["bookingReference"].(string), fc.Args["document"].(*PassengerDocumentInput))
+		},
+		nil,
+		ec.marshalNBoardingPass2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐBoardingPass,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_checkIn(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "booking":
+				return ec.fieldContext_BoardingPass_booking(ctx, field)
+			case "flight":
+				return ec.fieldContext_BoardingPass_flight(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_BoardingPass_seatNumber(ctx, field)
+			case "sequenceNumber":
+				return ec.fieldContext_BoardingPass_sequenceNumber(ctx, field)
+			case "barcode":
+				return ec.fieldContext_BoardingPass_barcode(ctx, field)
+			case "downloadUrl":
+				return ec.fieldContext_BoardingPass_downloadUrl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type BoardingPass", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_checkIn_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addPassengerDocument(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addPassengerDocument,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddPassengerDocument(ctx, fc.Args["bookingReference"].(string), fc.Args["document"].(PassengerDocumentInput))
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addPassengerDocument(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addPassengerDocument_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setOverbookingLimit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_setOverbookingLimit,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SetOverbookingLimit(ctx, fc.Args["input"].(SetOverbookingLimitInput))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "OPS")
+				if err != nil {
+					var zeroVal *model.Flight
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *model.Flight
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, nil, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setOverbookingLimit(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setOverbookingLimit_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addAncillary(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addAncillary,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddAncillary(ctx, fc.Args["bookingReference"].(string), fc.Args["productId"].(string), fc.Args["quantity"].(int))
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addAncillary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addAncillary_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_selectSeat(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_selectSeat,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SelectSeat(ctx, fc.Args["bookingReference"].(string), fc.Args["seatNumber"].(string))
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_selectSeat(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_selectSeat_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_volunteerForDeniedBoarding(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_volunteerForDeniedBoarding,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().VolunteerForDeniedBoarding(ctx, fc.Args["bookingReference"].(string), fc.Args["volunteer"].(bool))
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_volunteerForDeniedBoarding(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_volunteerForDeniedBoarding_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PassengerDocument_documentType(ctx context.Context, field graphql.CollectedField, obj *PassengerDocument) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PassengerDocument_documentType,
+		func(ctx context.Context) (any, error) {
+			return obj.DocumentType, nil
+		},
+		nil,
+		ec.marshalNDocumentType2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐDocumentType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PassengerDocument_documentType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PassengerDocument",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DocumentType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PassengerDocument_maskedNumber(ctx context.Context, field graphql.CollectedField, obj *PassengerDocument) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PassengerDocument_maskedNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.MaskedNumber, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PassengerDocument_maskedNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PassengerDocument",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PassengerDocument_nationality(ctx context.Context, field graphql.CollectedField, obj *PassengerDocument) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PassengerDocument_nationality,
+		func(ctx context.Context) (any, error) {
+			return obj.Nationality, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PassengerDocument_nationality(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PassengerDocument",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PassengerDocument_issuingCountry(ctx context.Context, field graphql.CollectedField, obj *PassengerDocument) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PassengerDocument_issuingCountry,
+		func(ctx context.Context) (any, error) {
+			return obj.IssuingCountry, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PassengerDocument_issuingCountry(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PassengerDocument",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PassengerDocument_expiryDate(ctx context.Context, field graphql.CollectedField, obj *PassengerDocument) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PassengerDocument_expiryDate,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiryDate, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PassengerDocument_expiryDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PassengerDocument",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_flights(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_flights,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Flights(ctx, fc.Args["origin"].(*string), fc.Args["destination"].(*string), fc.Args["limit"].(*int))
+		},
+		nil,
+		ec.marshalNFlight2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_flights(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_flights_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_flight(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_flight,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Flight(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_flight(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_flight_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_me,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Me(ctx)
+		},
+		nil,
+		ec.marshalOUser2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐUser,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_me(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_User_id(ctx, field)
+			case "email":
+				return ec.fieldContext_User_email(ctx, field)
+			case "name":
+				return ec.fieldContext_User_name(ctx, field)
+			case "roles":
+				return ec.fieldContext_User_roles(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_User_createdAt(ctx, field)
+			case "bookings":
+				return ec.fieldContext_User_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type User", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_booking(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_booking,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Booking(ctx, fc.Args["bookingReference"].(string))
+		},
+		nil,
+		ec.marshalOBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_booking(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_booking_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_bookings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_bookings,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Bookings(ctx, fc.Args["passengerEmail"].(*string), fc.Args["limit"].(*int))
+		},
+		nil,
+		ec.marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_bookings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_bookings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_airports(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_airports,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Airports(ctx)
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_airports(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_disruptionReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_disruptionReport,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DisruptionReport(ctx, fc.Args["flightId"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "AGENT")
+				if err != nil {
+					var zeroVal *DisruptionReport
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *DisruptionReport
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, nil, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalODisruptionReport2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐDisruptionReport,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_disruptionReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "flight":
+				return ec.fieldContext_DisruptionReport_flight(ctx, field)
+			case "totalAffected":
+				return ec.fieldContext_DisruptionReport_totalAffected(ctx, field)
+			case "rebookedCount":
+				return ec.fieldContext_DisruptionReport_rebookedCount(ctx, field)
+			case "unaccommodatedCount":
+				return ec.fieldContext_DisruptionReport_unaccommodatedCount(ctx, field)
+			case "rebookings":
+				return ec.fieldContext_DisruptionReport_rebookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DisruptionReport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_disruptionReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_deniedBoardingList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_deniedBoardingList,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().DeniedBoardingList(ctx, fc.Args["flightId"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "AGENT")
+				if err != nil {
+					var zeroVal *DeniedBoardingList
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *DeniedBoardingList
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, nil, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalODeniedBoardingList2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐDeniedBoardingList,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_deniedBoardingList(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "flight":
+				return ec.fieldContext_DeniedBoardingList_flight(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_DeniedBoardingList_oversoldSeats(ctx, field)
+			case "volunteers":
+				return ec.fieldContext_DeniedBoardingList_volunteers(ctx, field)
+			case "candidates":
+				return ec.fieldContext_DeniedBoardingList_candidates(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DeniedBoardingList", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_deniedBoardingList_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_ancillaryOffers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_ancillaryOffers,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().AncillaryOffers(ctx, fc.Args["flightId"].(string))
+		},
+		nil,
+		ec.marshalNAncillaryOffer2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAncillaryOfferᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_ancillaryOffers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "product":
+				return ec.fieldContext_AncillaryOffer_product(ctx, field)
+			case "price":
+				return ec.fieldContext_AncillaryOffer_price(ctx, field)
+			case "remaining":
+				return ec.fieldContext_AncillaryOffer_remaining(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AncillaryOffer", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_ancillaryOffers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_flightManifest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_flightManifest,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().FlightManifest(ctx, fc.Args["flightId"].(string))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				role, err := ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, "AGENT")
+				if err != nil {
+					var zeroVal *FlightManifest
+					return zeroVal, err
+				}
+				if ec.directives.HasRole == nil {
+					var zeroVal *FlightManifest
+					return zeroVal, errors.New("directive hasRole is not implemented")
+				}
+				return ec.directives.HasRole(ctx, nil, directive0, role)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOFlightManifest2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐFlightManifest,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_flightManifest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "flight":
+				return ec.fieldContext_FlightManifest_flight(ctx, field)
+			case "entries":
+				return ec.fieldContext_FlightManifest_entries(ctx, field)
+			case "downloadUrl":
+				return ec.fieldContext_FlightManifest_downloadUrl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FlightManifest", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_flightManifest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rebooking_id(ctx context.Context, field graphql.CollectedField, obj *model.Rebooking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rebooking_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rebooking_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rebooking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rebooking_booking(ctx context.Context, field graphql.CollectedField, obj *model.Rebooking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rebooking_booking,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Rebooking().Booking(ctx, obj)
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rebooking_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rebooking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rebooking_originalFlight(ctx context.Context, field graphql.CollectedField, obj *model.Rebooking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rebooking_originalFlight,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Rebooking().OriginalFlight(ctx, obj)
+		},
+		nil,
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rebooking_originalFlight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rebooking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rebooking_originalFare(ctx context.Context, field graphql.CollectedField, obj *model.Rebooking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rebooking_originalFare,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Rebooking().OriginalFare(ctx, obj)
+		},
+		nil,
+		ec.marshalNFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rebooking_originalFare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rebooking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Fare_id(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Fare_flightId(ctx, field)
+			case "fareClass":
+				return ec.fieldContext_Fare_fareClass(ctx, field)
+			case "price":
+				return ec.fieldContext_Fare_price(ctx, field)
+			case "baggageAllowance":
+				return ec.fieldContext_Fare_baggageAllowance(ctx, field)
+			case "isRefundable":
+				return ec.fieldContext_Fare_isRefundable(ctx, field)
+			case "isChangeable":
+				return ec.fieldContext_Fare_isChangeable(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Fare_availableSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Fare_overbookingLimit(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Fare_oversoldSeats(ctx, field)
+			case "waitlistCount":
+				return ec.fieldContext_Fare_waitlistCount(ctx, field)
+			case "flight":
+				return ec.fieldContext_Fare_flight(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Fare_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Fare", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rebooking_newFlight(ctx context.Context, field graphql.CollectedField, obj *model.Rebooking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rebooking_newFlight,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Rebooking().NewFlight(ctx, obj)
+		},
+		nil,
+		ec.marshalOFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rebooking_newFlight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rebooking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rebooking_newFare(ctx context.Context, field graphql.CollectedField, obj *model.Rebooking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rebooking_newFare,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Rebooking().NewFare(ctx, obj)
+		},
+		nil,
+		ec.marshalOFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rebooking_newFare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rebooking",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Fare_id(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Fare_flightId(ctx, field)
+			case "fareClass":
+				return ec.fieldContext_Fare_fareClass(ctx, field)
+			case "price":
+				return ec.fieldContext_Fare_price(ctx, field)
+			case "baggageAllowance":
+				return ec.fieldContext_Fare_baggageAllowance(ctx, field)
+			case "isRefundable":
+				return ec.fieldContext_Fare_isRefundable(ctx, field)
+			case "isChangeable":
+				return ec.fieldContext_Fare_isChangeable(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Fare_availableSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Fare_overbookingLimit(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Fare_oversoldSeats(ctx, field)
+			case "waitlistCount":
+				return ec.fieldContext_Fare_waitlistCount(ctx, field)
+			case "flight":
+				return ec.fieldContext_Fare_flight(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Fare_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Fare", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rebooking_outcome(ctx context.Context, field graphql.CollectedField, obj *model.Rebooking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rebooking_outcome,
+		func(ctx context.Context) (any, error) {
+			return obj.Outcome, nil
+		},
+		nil,
+		ec.marshalNRebookingOutcome2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRebookingOutcome,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rebooking_outcome(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rebooking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RebookingOutcome does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Rebooking_processedAt(ctx context.Context, field graphql.CollectedField, obj *model.Rebooking) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Rebooking_processedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ProcessedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Rebooking_processedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Rebooking",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_flightStatusChanged(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_flightStatusChanged,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().FlightStatusChanged(ctx, fc.Args["flightId"].(string))
+		},
+		nil,
+		ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_flightStatusChanged(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Flight_id(ctx, field)
+			case "flightNumber":
+				return ec.fieldContext_Flight_flightNumber(ctx, field)
+			case "origin":
+				return ec.fieldContext_Flight_origin(ctx, field)
+			case "destination":
+				return ec.fieldContext_Flight_destination(ctx, field)
+			case "departureTime":
+				return ec.fieldContext_Flight_departureTime(ctx, field)
+			case "arrivalTime":
+				return ec.fieldContext_Flight_arrivalTime(ctx, field)
+			case "aircraftType":
+				return ec.fieldContext_Flight_aircraftType(ctx, field)
+			case "totalSeats":
+				return ec.fieldContext_Flight_totalSeats(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Flight_availableSeats(ctx, field)
+			case "status":
+				return ec.fieldContext_Flight_status(ctx, field)
+			case "gate":
+				return ec.fieldContext_Flight_gate(ctx, field)
+			case "delayMinutes":
+				return ec.fieldContext_Flight_delayMinutes(ctx, field)
+			case "bookedSeats":
+				return ec.fieldContext_Flight_bookedSeats(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Flight_oversoldSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Flight_overbookingLimit(ctx, field)
+			case "statusHistory":
+				return ec.fieldContext_Flight_statusHistory(ctx, field)
+			case "fares":
+				return ec.fieldContext_Flight_fares(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Flight_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Flight", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_flightStatusChanged_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_bookingUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_bookingUpdated,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().BookingUpdated(ctx, fc.Args["bookingReference"].(string))
+		},
+		nil,
+		ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_bookingUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_bookingUpdated_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_email(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_email,
+		func(ctx context.Context) (any, error) {
+			return obj.Email, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_email(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_name(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_roles(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_roles,
+		func(ctx context.Context) (any, error) {
+			return obj.Roles(), nil
+		},
+		nil,
+		ec.marshalNRole2ᚕgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRoleᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_roles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _User_bookings(ctx context.Context, field graphql.CollectedField, obj *model.User) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_User_bookings,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.User().Bookings(ctx, obj)
+		},
+		nil,
+		ec.marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_User_bookings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_id(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_fare(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_fare,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.WaitlistEntry().Fare(ctx, obj)
+		},
+		nil,
+		ec.marshalNFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_fare(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Fare_id(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Fare_flightId(ctx, field)
+			case "fareClass":
+				return ec.fieldContext_Fare_fareClass(ctx, field)
+			case "price":
+				return ec.fieldContext_Fare_price(ctx, field)
+			case "baggageAllowance":
+				return ec.fieldContext_Fare_baggageAllowance(ctx, field)
+			case "isRefundable":
+				return ec.fieldContext_Fare_isRefundable(ctx, field)
+			case "isChangeable":
+				return ec.fieldContext_Fare_isChangeable(ctx, field)
+			case "availableSeats":
+				return ec.fieldContext_Fare_availableSeats(ctx, field)
+			case "overbookingLimit":
+				return ec.fieldContext_Fare_overbookingLimit(ctx, field)
+			case "oversoldSeats":
+				return ec.fieldContext_Fare_oversoldSeats(ctx, field)
+			case "waitlistCount":
+				return ec.fieldContext_Fare_waitlistCount(ctx, field)
+			case "flight":
+				return ec.fieldContext_Fare_flight(ctx, field)
+			case "bookings":
+				return ec.fieldContext_Fare_bookings(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Fare", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_passengerName(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_passengerName,
+		func(ctx context.Context) (any, error) {
+			return obj.PassengerName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_passengerName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_passengerEmail(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_passengerEmail,
+		func(ctx context.Context) (any, error) {
+			return obj.PassengerEmail, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_passengerEmail(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_passengerPhone(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_passengerPhone,
+		func(ctx context.Context) (any, error) {
+			return obj.PassengerPhone, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_passengerPhone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_status(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNWaitlistStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐWaitlistStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WaitlistStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_position(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_position,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.WaitlistEntry().Position(ctx, obj)
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_position(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_offerExpiresAt(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_offerExpiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.OfferExpiresAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_offerExpiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_booking(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_booking,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.WaitlistEntry().Booking(ctx, obj)
+		},
+		nil,
+		ec.marshalOBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_booking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Booking_id(ctx, field)
+			case "bookingReference":
+				return ec.fieldContext_Booking_bookingReference(ctx, field)
+			case "flightId":
+				return ec.fieldContext_Booking_flightId(ctx, field)
+			case "fareId":
+				return ec.fieldContext_Booking_fareId(ctx, field)
+			case "passengerName":
+				return ec.fieldContext_Booking_passengerName(ctx, field)
+			case "passengerEmail":
+				return ec.fieldContext_Booking_passengerEmail(ctx, field)
+			case "passengerPhone":
+				return ec.fieldContext_Booking_passengerPhone(ctx, field)
+			case "seatNumber":
+				return ec.fieldContext_Booking_seatNumber(ctx, field)
+			case "specialRequests":
+				return ec.fieldContext_Booking_specialRequests(ctx, field)
+			case "bookingStatus":
+				return ec.fieldContext_Booking_bookingStatus(ctx, field)
+			case "totalPrice":
+				return ec.fieldContext_Booking_totalPrice(ctx, field)
+			case "bookedAt":
+				return ec.fieldContext_Booking_bookedAt(ctx, field)
+			case "checkedInAt":
+				return ec.fieldContext_Booking_checkedInAt(ctx, field)
+			case "deniedBoardingVolunteer":
+				return ec.fieldContext_Booking_deniedBoardingVolunteer(ctx, field)
+			case "ancillaries":
+				return ec.fieldContext_Booking_ancillaries(ctx, field)
+			case "document":
+				return ec.fieldContext_Booking_document(ctx, field)
+			case "flight":
+				return ec.fieldContext_Booking_flight(ctx, field)
+			case "fare":
+				return ec.fieldContext_Booking_fare(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Booking", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaitlistEntry_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.WaitlistEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaitlistEntry_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaitlistEntry_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaitlistEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAssignGateInput(ctx context.Context, obj any) (AssignGateInput, error) {
+	var it AssignGateInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"flightId", "gate"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "flightId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flightId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FlightID = data
+		case "gate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gate"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Gate = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateBookingInput(ctx context.Context, obj any) (CreateBookingInput, error) {
+	var it CreateBookingInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"flightId", "fareId", "passengerName", "passengerEmail", "passengerPhone", "seatNumber", "specialRequests", "document"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "flightId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flightId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FlightID = data
+		case "fareId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fareId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FareID = data
+		case "passengerName":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passengerName"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PassengerName = data
+		case "passengerEmail":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passengerEmail"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PassengerEmail = data
+		case "passengerPhone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passengerPhone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PassengerPhone = data
+		case "seatNumber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("seatNumber"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SeatNumber = data
+		case "specialRequests":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("specialRequests"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SpecialRequests = data
+		case "document":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("document"))
+			data, err := ec.unmarshalOPassengerDocumentInput2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerDocumentInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Document = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDelayFlightInput(ctx context.Context, obj any) (DelayFlightInput, error) {
+	var it DelayFlightInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"flightId", "newDeparture", "reason"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "flightId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flightId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FlightID = data
+		case "newDeparture":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("newDeparture"))
+			data, err := ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.NewDeparture = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPassengerDocumentInput(ctx context.Context, obj any) (PassengerDocumentInput, error) {
+	var it PassengerDocumentInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"mrz", "documentType", "documentNumber", "nationality", "issuingCountry", "dateOfBirth", "expiryDate", "sex"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "mrz":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mrz"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Mrz = data
+		case "documentType":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("documentType"))
+			data, err := ec.unmarshalODocumentType2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐDocumentType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DocumentType = data
+		case "documentNumber":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("documentNumber"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DocumentNumber = data
+		case "nationality":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nationality"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Nationality = data
+		case "issuingCountry":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("issuingCountry"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IssuingCountry = data
+		case "dateOfBirth":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dateOfBirth"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DateOfBirth = data
+		case "expiryDate":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expiryDate"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExpiryDate = data
+		case "sex":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sex"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Sex = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPassengerInput(ctx context.Context, obj any) (PassengerInput, error) {
+	var it PassengerInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "email", "phone"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "phone":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("phone"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Phone = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetOverbookingLimitInput(ctx context.Context, obj any) (SetOverbookingLimitInput, error) {
+	var it SetOverbookingLimitInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"flightId", "fareClass", "seats", "percent"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "flightId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flightId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FlightID = data
+		case "fareClass":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fareClass"))
+			data, err := ec.unmarshalOFareClass2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FareClass = data
+		case "seats":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("seats"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Seats = data
+		case "percent":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("percent"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Percent = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSignupInput(ctx context.Context, obj any) (SignupInput, error) {
+	var it SignupInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"email", "name", "password"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "email":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("email"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Email = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "password":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("password"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Password = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateFlightStatusInput(ctx context.Context, obj any) (UpdateFlightStatusInput, error) {
+	var it UpdateFlightStatusInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"flightId", "status", "reason"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "flightId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flightId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FlightID = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalNFlightStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var acceptWaitlistOfferPayloadImplementors = []string{"AcceptWaitlistOfferPayload"}
+
+func (ec *executionContext) _AcceptWaitlistOfferPayload(ctx context.Context, sel ast.SelectionSet, obj *AcceptWaitlistOfferPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, acceptWaitlistOfferPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AcceptWaitlistOfferPayload")
+		case "booking":
+			out.Values[i] = ec._AcceptWaitlistOfferPayload_booking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "token":
+			out.Values[i] = ec._AcceptWaitlistOfferPayload_token(ctx, field, obj)
+		case "expiresAt":
+			out.Values[i] = ec._AcceptWaitlistOfferPayload_expiresAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var ancillaryOfferImplementors = []string{"AncillaryOffer"}
+
+func (ec *executionContext) _AncillaryOffer(ctx context.Context, sel ast.SelectionSet, obj *AncillaryOffer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ancillaryOfferImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AncillaryOffer")
+		case "product":
+			out.Values[i] = ec._AncillaryOffer_product(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "price":
+			out.Values[i] = ec._AncillaryOffer_price(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "remaining":
+			out.Values[i] = ec._AncillaryOffer_remaining(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var ancillaryProductImplementors = []string{"AncillaryProduct"}
+
+func (ec *executionContext) _AncillaryProduct(ctx context.Context, sel ast.SelectionSet, obj *model.AncillaryProduct) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ancillaryProductImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AncillaryProduct")
+		case "id":
+			out.Values[i] = ec._AncillaryProduct_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "code":
+			out.Values[i] = ec._AncillaryProduct_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._AncillaryProduct_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "category":
+			out.Values[i] = ec._AncillaryProduct_category(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec._AncillaryProduct_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "basePrice":
+			out.Values[i] = ec._AncillaryProduct_basePrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "maxPerBooking":
+			out.Values[i] = ec._AncillaryProduct_maxPerBooking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "allowedFareClasses":
+			out.Values[i] = ec._AncillaryProduct_allowedFareClasses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var authPayloadImplementors = []string{"AuthPayload"}
+
+func (ec *executionContext) _AuthPayload(ctx context.Context, sel ast.SelectionSet, obj *auth.Session) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, authPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuthPayload")
+		case "accessToken":
+			out.Values[i] = ec._AuthPayload_accessToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "accessTokenExpiresAt":
+			out.Values[i] = ec._AuthPayload_accessTokenExpiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refreshToken":
+			out.Values[i] = ec._AuthPayload_refreshToken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "user":
+			out.Values[i] = ec._AuthPayload_user(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var boardingPassImplementors = []string{"BoardingPass"}
+
+func (ec *executionContext) _BoardingPass(ctx context.Context, sel ast.SelectionSet, obj *BoardingPass) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, boardingPassImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BoardingPass")
+		case "booking":
+			out.Values[i] = ec._BoardingPass_booking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "flight":
+			out.Values[i] = ec._BoardingPass_flight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "seatNumber":
+			out.Values[i] = ec._BoardingPass_seatNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sequenceNumber":
+			out.Values[i] = ec._BoardingPass_sequenceNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "barcode":
+			out.Values[i] = ec._BoardingPass_barcode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "downloadUrl":
+			out.Values[i] = ec._BoardingPass_downloadUrl(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var bookingImplementors = []string{"Booking"}
+
+func (ec *executionContext) _Booking(ctx context.Context, sel ast.SelectionSet, obj *model.Booking) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bookingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Booking")
+		case "id":
+			out.Values[i] = ec._Booking_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "bookingReference":
+			out.Values[i] = ec._Booking_bookingReference(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "flightId":
+			out.Values[i] = ec._Booking_flightId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fareId":
+			out.Values[i] = ec._Booking_fareId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "passengerName":
+			out.Values[i] = ec._Booking_passengerName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "passengerEmail":
+			out.Values[i] = ec._Booking_passengerEmail(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "passengerPhone":
+			out.Values[i] = ec._Booking_passengerPhone(ctx, field, obj)
+		case "seatNumber":
+			out.Values[i] = ec._Booking_seatNumber(ctx, field, obj)
+		case "specialRequests":
+			out.Values[i] = ec._Booking_specialRequests(ctx, field, obj)
+		case "bookingStatus":
+			out.Values[i] = ec._Booking_bookingStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "totalPrice":
+			out.Values[i] = ec._Booking_totalPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "bookedAt":
+			out.Values[i] = ec._Booking_bookedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "checkedInAt":
+			out.Values[i] = ec._Booking_checkedInAt(ctx, field, obj)
+		case "deniedBoardingVolunteer":
+			out.Values[i] = ec._Booking_deniedBoardingVolunteer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "ancillaries":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Booking_ancillaries(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "document":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Booking_document(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "flight":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Booking_flight(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "fare":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Booking_fare(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var bookingAncillaryImplementors = []string{"BookingAncillary"}
+
+func (ec *executionContext) _BookingAncillary(ctx context.Context, sel ast.SelectionSet, obj *model.BookingAncillary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bookingAncillaryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BookingAncillary")
+		case "id":
+			out.Values[i] = ec._BookingAncillary_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "product":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BookingAncillary_product(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "quantity":
+			out.Values[i] = ec._BookingAncillary_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "unitPrice":
+			out.Values[i] = ec._BookingAncillary_unitPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "totalPrice":
+			out.Values[i] = ec._BookingAncillary_totalPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._BookingAncillary_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createBookingPayloadImplementors = []string{"CreateBookingPayload"}
+
+func (ec *executionContext) _CreateBookingPayload(ctx context.Context, sel ast.SelectionSet, obj *CreateBookingPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createBookingPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateBookingPayload")
+		case "booking":
+			out.Values[i] = ec._CreateBookingPayload_booking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "token":
+			out.Values[i] = ec._CreateBookingPayload_token(ctx, field, obj)
+		case "expiresAt":
+			out.Values[i] = ec._CreateBookingPayload_expiresAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deniedBoardingListImplementors = []string{"DeniedBoardingList"}
+
+func (ec *executionContext) _DeniedBoardingList(ctx context.Context, sel ast.SelectionSet, obj *DeniedBoardingList) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deniedBoardingListImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeniedBoardingList")
+		case "flight":
+			out.Values[i] = ec._DeniedBoardingList_flight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "oversoldSeats":
+			out.Values[i] = ec._DeniedBoardingList_oversoldSeats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "volunteers":
+			out.Values[i] = ec._DeniedBoardingList_volunteers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "candidates":
+			out.Values[i] = ec._DeniedBoardingList_candidates(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var disruptionReportImplementors = []string{"DisruptionReport"}
+
+func (ec *executionContext) _DisruptionReport(ctx context.Context, sel ast.SelectionSet, obj *DisruptionReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, disruptionReportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DisruptionReport")
+		case "flight":
+			out.Values[i] = ec._DisruptionReport_flight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalAffected":
+			out.Values[i] = ec._DisruptionReport_totalAffected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rebookedCount":
+			out.Values[i] = ec._DisruptionReport_rebookedCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unaccommodatedCount":
+			out.Values[i] = ec._DisruptionReport_unaccommodatedCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rebookings":
+			out.Values[i] = ec._DisruptionReport_rebookings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fareImplementors = []string{"Fare"}
+
+func (ec *executionContext) _Fare(ctx context.Context, sel ast.SelectionSet, obj *model.Fare) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fareImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Fare")
+		case "id":
+			out.Values[i] = ec._Fare_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "flightId":
+			out.Values[i] = ec._Fare_flightId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fareClass":
+			out.Values[i] = ec._Fare_fareClass(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "price":
+			out.Values[i] = ec._Fare_price(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "baggageAllowance":
+			out.Values[i] = ec._Fare_baggageAllowance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "isRefundable":
+			out.Values[i] = ec._Fare_isRefundable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "isChangeable":
+			out.Values[i] = ec._Fare_isChangeable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "availableSeats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Fare_availableSeats(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "overbookingLimit":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Fare_overbookingLimit(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "oversoldSeats":
+			out.Values[i] = ec._Fare_oversoldSeats(ctx, field, obj)
+		case "waitlistCount":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Fare_waitlistCount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "flight":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Fare_flight(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "bookings":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Fare_bookings(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var flightImplementors = []string{"Flight"}
+
+func (ec *executionContext) _Flight(ctx context.Context, sel ast.SelectionSet, obj *model.Flight) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flightImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Flight")
+		case "id":
+			out.Values[i] = ec._Flight_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "flightNumber":
+			out.Values[i] = ec._Flight_flightNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "origin":
+			out.Values[i] = ec._Flight_origin(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "destination":
+			out.Values[i] = ec._Flight_destination(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "departureTime":
+			out.Values[i] = ec._Flight_departureTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "arrivalTime":
+			out.Values[i] = ec._Flight_arrivalTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "aircraftType":
+			out.Values[i] = ec._Flight_aircraftType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "totalSeats":
+			out.Values[i] = ec._Flight_totalSeats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "availableSeats":
+			out.Values[i] = ec._Flight_availableSeats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._Flight_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "gate":
+			out.Values[i] = ec._Flight_gate(ctx, field, obj)
+		case "delayMinutes":
+			out.Values[i] = ec._Flight_delayMinutes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "bookedSeats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Flight_bookedSeats(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "oversoldSeats":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Flight_oversoldSeats(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "overbookingLimit":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Flight_overbookingLimit(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "statusHistory":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Flight_statusHistory(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "fares":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Flight_fares(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "bookings":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Flight_bookings(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var flightEventImplementors = []string{"FlightEvent"}
+
+func (ec *executionContext) _FlightEvent(ctx context.Context, sel ast.SelectionSet, obj *model.FlightEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flightEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FlightEvent")
+		case "id":
+			out.Values[i] = ec._FlightEvent_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "flightId":
+			out.Values[i] = ec._FlightEvent_flightId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventType":
+			out.Values[i] = ec._FlightEvent_eventType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "previousStatus":
+			out.Values[i] = ec._FlightEvent_previousStatus(ctx, field, obj)
+		case "newStatus":
+			out.Values[i] = ec._FlightEvent_newStatus(ctx, field, obj)
+		case "gate":
+			out.Values[i] = ec._FlightEvent_gate(ctx, field, obj)
+		case "delayMinutes":
+			out.Values[i] = ec._FlightEvent_delayMinutes(ctx, field, obj)
+		case "reason":
+			out.Values[i] = ec._FlightEvent_reason(ctx, field, obj)
+		case "actor":
+			out.Values[i] = ec._FlightEvent_actor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "occurredAt":
+			out.Values[i] = ec._FlightEvent_occurredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var flightManifestImplementors = []string{"FlightManifest"}
+
+func (ec *executionContext) _FlightManifest(ctx context.Context, sel ast.SelectionSet, obj *FlightManifest) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flightManifestImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FlightManifest")
+		case "flight":
+			out.Values[i] = ec._FlightManifest_flight(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entries":
+			out.Values[i] = ec._FlightManifest_entries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "downloadUrl":
+			out.Values[i] = ec._FlightManifest_downloadUrl(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var managedBookingImplementors = []string{"ManagedBooking"}
+
+func (ec *executionContext) _ManagedBooking(ctx context.Context, sel ast.SelectionSet, obj *auth.BookingSession) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, managedBookingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ManagedBooking")
+		case "booking":
+			out.Values[i] = ec._ManagedBooking_booking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "token":
+			out.Values[i] = ec._ManagedBooking_token(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._ManagedBooking_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var manifestEntryImplementors = []string{"ManifestEntry"}
+
+func (ec *executionContext) _ManifestEntry(ctx context.Context, sel ast.SelectionSet, obj *manifest.Entry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, manifestEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ManifestEntry")
+		case "seatNumber":
+			out.Values[i] = ec._ManifestEntry_seatNumber(ctx, field, obj)
+		case "passengerName":
+			out.Values[i] = ec._ManifestEntry_passengerName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "bookingReference":
+			out.Values[i] = ec._ManifestEntry_bookingReference(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fareClass":
+			out.Values[i] = ec._ManifestEntry_fareClass(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "bookingStatus":
+			out.Values[i] = ec._ManifestEntry_bookingStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "specialRequests":
+			out.Values[i] = ec._ManifestEntry_specialRequests(ctx, field, obj)
+		case "booking":
+			out.Values[i] = ec._ManifestEntry_booking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "signup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_signup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "login":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_login(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "refreshToken":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_refreshToken(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "logout":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_logout(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "manageBooking":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_manageBooking(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createBooking":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createBooking(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancelBooking":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelBooking(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancelBookingOnBehalf":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelBookingOnBehalf(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "joinWaitlist":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_joinWaitlist(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "acceptWaitlistOffer":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_acceptWaitlistOffer(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateFlightStatus":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateFlightStatus(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "delayFlight":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_delayFlight(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assignGate":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_assignGate(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "checkIn":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_checkIn(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addPassengerDocument":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addPassengerDocument(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setOverbookingLimit":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setOverbookingLimit(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addAncillary":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addAncillary(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "selectSeat":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_selectSeat(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "volunteerForDeniedBoarding":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_volunteerForDeniedBoarding(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_enumValues,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_inputFields,
-		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
-		},
-		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	return fc, nil
-}
+var passengerDocumentImplementors = []string{"PassengerDocument"}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_ofType,
-		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _PassengerDocument(ctx context.Context, sel ast.SelectionSet, obj *PassengerDocument) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, passengerDocumentImplementors)
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PassengerDocument")
+		case "documentType":
+			out.Values[i] = ec._PassengerDocument_documentType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "maskedNumber":
+			out.Values[i] = ec._PassengerDocument_maskedNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "nationality":
+			out.Values[i] = ec._PassengerDocument_nationality(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "issuingCountry":
+			out.Values[i] = ec._PassengerDocument_issuingCountry(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiryDate":
+			out.Values[i] = ec._PassengerDocument_expiryDate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_isOneOf,
-		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
-		},
-		nil,
-		ec.marshalOBoolean2bool,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
+
+	return out
 }
 
-// endregion **************************** field.gotpl *****************************
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "flights":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_flights(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-// region    **************************** input.gotpl *****************************
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "flight":
+			field := field
 
-func (ec *executionContext) unmarshalInputCreateBookingInput(ctx context.Context, obj any) (CreateBookingInput, error) {
-	var it CreateBookingInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_flight(ctx, field)
+				return res
+			}
 
-	fieldsInOrder := [...]string{"flightId", "fareId", "passengerName", "passengerEmail", "passengerPhone", "seatNumber"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "flightId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flightId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			it.FlightID = data
-		case "fareId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fareId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "me":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_me(ctx, field)
+				return res
 			}
-			it.FareID = data
-		case "passengerName":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passengerName"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			it.PassengerName = data
-		case "passengerEmail":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passengerEmail"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "booking":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_booking(ctx, field)
+				return res
 			}
-			it.PassengerEmail = data
-		case "passengerPhone":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passengerPhone"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			it.PassengerPhone = data
-		case "seatNumber":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("seatNumber"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "bookings":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_bookings(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			it.SeatNumber = data
-		}
-	}
 
-	return it, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-// endregion **************************** input.gotpl *****************************
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "airports":
+			field := field
 
-// region    ************************** interface.gotpl ***************************
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_airports(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-// endregion ************************** interface.gotpl ***************************
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-// region    **************************** object.gotpl ****************************
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "disruptionReport":
+			field := field
 
-var bookingImplementors = []string{"Booking"}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_disruptionReport(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) _Booking(ctx context.Context, sel ast.SelectionSet, obj *model.Booking) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, bookingImplementors)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Booking")
-		case "id":
-			out.Values[i] = ec._Booking_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "deniedBoardingList":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_deniedBoardingList(ctx, field)
+				return res
 			}
-		case "bookingReference":
-			out.Values[i] = ec._Booking_bookingReference(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "flightId":
-			out.Values[i] = ec._Booking_flightId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "ancillaryOffers":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_ancillaryOffers(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "fareId":
-			out.Values[i] = ec._Booking_fareId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "passengerName":
-			out.Values[i] = ec._Booking_passengerName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "flightManifest":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_flightManifest(ctx, field)
+				return res
 			}
-		case "passengerEmail":
-			out.Values[i] = ec._Booking_passengerEmail(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "passengerPhone":
-			out.Values[i] = ec._Booking_passengerPhone(ctx, field, obj)
-		case "seatNumber":
-			out.Values[i] = ec._Booking_seatNumber(ctx, field, obj)
-		case "bookingStatus":
-			out.Values[i] = ec._Booking_bookingStatus(ctx, field, obj)
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var rebookingImplementors = []string{"Rebooking"}
+
+func (ec *executionContext) _Rebooking(ctx context.Context, sel ast.SelectionSet, obj *model.Rebooking) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rebookingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Rebooking")
+		case "id":
+			out.Values[i] = ec._Rebooking_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "totalPrice":
-			out.Values[i] = ec._Booking_totalPrice(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+		case "booking":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Rebooking_booking(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "bookedAt":
-			out.Values[i] = ec._Booking_bookedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-		case "flight":
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "originalFlight":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -3970,7 +14072,7 @@ func (ec *executionContext) _Booking(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Booking_flight(ctx, field, obj)
+				res = ec._Rebooking_originalFlight(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -3997,7 +14099,7 @@ func (ec *executionContext) _Booking(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "fare":
+		case "originalFare":
 			field := field
 
 			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
@@ -4006,7 +14108,7 @@ func (ec *executionContext) _Booking(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Booking_fare(ctx, field, obj)
+				res = ec._Rebooking_originalFare(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&fs.Invalids, 1)
 				}
@@ -4033,6 +14135,82 @@ func (ec *executionContext) _Booking(ctx context.Context, sel ast.SelectionSet,
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "newFlight":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Rebooking_newFlight(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "newFare":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Rebooking_newFare(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "outcome":
+			out.Values[i] = ec._Rebooking_outcome(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "processedAt":
+			out.Values[i] = ec._Rebooking_processedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4056,70 +14234,202 @@ func (ec *executionContext) _Booking(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var fareImplementors = []string{"Fare"}
+var subscriptionImplementors = []string{"Subscription"}
 
-func (ec *executionContext) _Fare(ctx context.Context, sel ast.SelectionSet, obj *model.Fare) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, fareImplementors)
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		graphql.AddErrorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "flightStatusChanged":
+		return ec._Subscription_flightStatusChanged(ctx, fields[0])
+	case "bookingUpdated":
+		return ec._Subscription_bookingUpdated(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var userImplementors = []string{"User"}
+
+func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *model.User) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Fare")
+			out.Values[i] = graphql.MarshalString("User")
 		case "id":
-			out.Values[i] = ec._Fare_id(ctx, field, obj)
+			out.Values[i] = ec._User_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "flightId":
-			out.Values[i] = ec._Fare_flightId(ctx, field, obj)
+		case "email":
+			out.Values[i] = ec._User_email(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "fareClass":
-			out.Values[i] = ec._Fare_fareClass(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._User_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "price":
-			out.Values[i] = ec._Fare_price(ctx, field, obj)
+		case "roles":
+			out.Values[i] = ec._User_roles(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "baggageAllowance":
-			out.Values[i] = ec._Fare_baggageAllowance(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._User_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "bookings":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._User_bookings(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var waitlistEntryImplementors = []string{"WaitlistEntry"}
+
+func (ec *executionContext) _WaitlistEntry(ctx context.Context, sel ast.SelectionSet, obj *model.WaitlistEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, waitlistEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WaitlistEntry")
+		case "id":
+			out.Values[i] = ec._WaitlistEntry_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "isRefundable":
-			out.Values[i] = ec._Fare_isRefundable(ctx, field, obj)
+		case "fare":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._WaitlistEntry_fare(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "passengerName":
+			out.Values[i] = ec._WaitlistEntry_passengerName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "isChangeable":
-			out.Values[i] = ec._Fare_isChangeable(ctx, field, obj)
+		case "passengerEmail":
+			out.Values[i] = ec._WaitlistEntry_passengerEmail(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "availableSeats":
-			out.Values[i] = ec._Fare_availableSeats(ctx, field, obj)
+		case "passengerPhone":
+			out.Values[i] = ec._WaitlistEntry_passengerPhone(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._WaitlistEntry_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "flight":
+		case "position":
 			field := field
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Fare_flight(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._WaitlistEntry_position(ctx, field, obj)
 				return res
 			}
 
@@ -4143,19 +14453,18 @@ func (ec *executionContext) _Fare(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "bookings":
+		case "offerExpiresAt":
+			out.Values[i] = ec._WaitlistEntry_offerExpiresAt(ctx, field, obj)
+		case "booking":
 			field := field
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Fare_bookings(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._WaitlistEntry_booking(ctx, field, obj)
 				return res
 			}
 
@@ -4179,6 +14488,11 @@ func (ec *executionContext) _Fare(ctx context.Context, sel ast.SelectionSet, obj
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "createdAt":
+			out.Values[i] = ec._WaitlistEntry_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4202,139 +14516,200 @@ func (ec *executionContext) _Fare(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
-var flightImplementors = []string{"Flight"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _Flight(ctx context.Context, sel ast.SelectionSet, obj *model.Flight) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, flightImplementors)
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Flight")
-		case "id":
-			out.Values[i] = ec._Flight_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "flightNumber":
-			out.Values[i] = ec._Flight_flightNumber(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "origin":
-			out.Values[i] = ec._Flight_origin(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "destination":
-			out.Values[i] = ec._Flight_destination(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "departureTime":
-			out.Values[i] = ec._Flight_departureTime(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "arrivalTime":
-			out.Values[i] = ec._Flight_arrivalTime(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "aircraftType":
-			out.Values[i] = ec._Flight_aircraftType(ctx, field, obj)
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "totalSeats":
-			out.Values[i] = ec._Flight_totalSeats(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "availableSeats":
-			out.Values[i] = ec._Flight_availableSeats(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "status":
-			out.Values[i] = ec._Flight_status(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "fares":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Flight_fares(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "bookings":
-			field := field
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Flight_bookings(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	return out
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+var __InputValueImplementors = []string{"__InputValue"}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4358,29 +14733,35 @@ func (ec *executionContext) _Flight(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createBooking":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createBooking(ctx, field)
-			})
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -4407,500 +14788,688 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "flights":
-			field := field
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_flights(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAcceptWaitlistOfferPayload2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAcceptWaitlistOfferPayload(ctx context.Context, sel ast.SelectionSet, v AcceptWaitlistOfferPayload) graphql.Marshaler {
+	return ec._AcceptWaitlistOfferPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAcceptWaitlistOfferPayload2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAcceptWaitlistOfferPayload(ctx context.Context, sel ast.SelectionSet, v *AcceptWaitlistOfferPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AcceptWaitlistOfferPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAncillaryCategory2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐAncillaryCategory(ctx context.Context, v any) (model.AncillaryCategory, error) {
+	var res model.AncillaryCategory
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAncillaryCategory2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐAncillaryCategory(ctx context.Context, sel ast.SelectionSet, v model.AncillaryCategory) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNAncillaryOffer2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAncillaryOfferᚄ(ctx context.Context, sel ast.SelectionSet, v []*AncillaryOffer) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNAncillaryOffer2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAncillaryOffer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "flight":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_flight(ctx, field)
-				return res
-			}
+	return ret
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNAncillaryOffer2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAncillaryOffer(ctx context.Context, sel ast.SelectionSet, v *AncillaryOffer) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AncillaryOffer(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAncillaryProduct2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐAncillaryProduct(ctx context.Context, sel ast.SelectionSet, v model.AncillaryProduct) graphql.Marshaler {
+	return ec._AncillaryProduct(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAncillaryProduct2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐAncillaryProduct(ctx context.Context, sel ast.SelectionSet, v *model.AncillaryProduct) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AncillaryProduct(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNAssignGateInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐAssignGateInput(ctx context.Context, v any) (AssignGateInput, error) {
+	res, err := ec.unmarshalInputAssignGateInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "booking":
-			field := field
+func (ec *executionContext) marshalNAuthPayload2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋauthᚐSession(ctx context.Context, sel ast.SelectionSet, v auth.Session) graphql.Marshaler {
+	return ec._AuthPayload(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_booking(ctx, field)
-				return res
-			}
+func (ec *executionContext) marshalNAuthPayload2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋauthᚐSession(ctx context.Context, sel ast.SelectionSet, v *auth.Session) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AuthPayload(ctx, sel, v)
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNBoardingPass2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐBoardingPass(ctx context.Context, sel ast.SelectionSet, v BoardingPass) graphql.Marshaler {
+	return ec._BoardingPass(ctx, sel, &v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "bookings":
-			field := field
+func (ec *executionContext) marshalNBoardingPass2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐBoardingPass(ctx context.Context, sel ast.SelectionSet, v *BoardingPass) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._BoardingPass(ctx, sel, v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_bookings(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNBooking2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking(ctx context.Context, sel ast.SelectionSet, v model.Booking) graphql.Marshaler {
+	return ec._Booking(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Booking) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "airports":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_airports(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+	return ret
+}
+
+func (ec *executionContext) marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking(ctx context.Context, sel ast.SelectionSet, v *model.Booking) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Booking(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNBookingAncillary2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingAncillaryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.BookingAncillary) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNBookingAncillary2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingAncillary(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNBookingAncillary2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingAncillary(ctx context.Context, sel ast.SelectionSet, v *model.BookingAncillary) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._BookingAncillary(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNBookingStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingStatus(ctx context.Context, v any) (model.BookingStatus, error) {
+	var res model.BookingStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) marshalNBookingStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingStatus(ctx context.Context, sel ast.SelectionSet, v model.BookingStatus) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	return res
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNCreateBookingInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐCreateBookingInput(ctx context.Context, v any) (CreateBookingInput, error) {
+	res, err := ec.unmarshalInputCreateBookingInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNCreateBookingPayload2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐCreateBookingPayload(ctx context.Context, sel ast.SelectionSet, v CreateBookingPayload) graphql.Marshaler {
+	return ec._CreateBookingPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCreateBookingPayload2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐCreateBookingPayload(ctx context.Context, sel ast.SelectionSet, v *CreateBookingPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._CreateBookingPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDelayFlightInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐDelayFlightInput(ctx context.Context, v any) (DelayFlightInput, error) {
+	res, err := ec.unmarshalInputDelayFlightInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) unmarshalNDocumentType2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐDocumentType(ctx context.Context, v any) (model.DocumentType, error) {
+	var res model.DocumentType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) marshalNDocumentType2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐDocumentType(ctx context.Context, sel ast.SelectionSet, v model.DocumentType) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNFare2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare(ctx context.Context, sel ast.SelectionSet, v model.Fare) graphql.Marshaler {
+	return ec._Fare(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNFare2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Fare) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare(ctx context.Context, sel ast.SelectionSet, v *model.Fare) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._Fare(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) unmarshalNFareClass2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass(ctx context.Context, v any) (model.FareClass, error) {
+	var res model.FareClass
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNFareClass2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass(ctx context.Context, sel ast.SelectionSet, v model.FareClass) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNFareClass2ᚕgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClassᚄ(ctx context.Context, v any) ([]model.FareClass, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.FareClass, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNFareClass2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	return res, nil
+}
+
+func (ec *executionContext) marshalNFareClass2ᚕgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClassᚄ(ctx context.Context, sel ast.SelectionSet, v []model.FareClass) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFareClass2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) marshalNFlight2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight(ctx context.Context, sel ast.SelectionSet, v model.Flight) graphql.Marshaler {
+	return ec._Flight(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNFlight2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Flight) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight(ctx context.Context, sel ast.SelectionSet, v *model.Flight) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._Flight(ctx, sel, v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) marshalNFlightEvent2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FlightEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFlightEvent2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+	}
+	wg.Wait()
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNFlightEvent2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightEvent(ctx context.Context, sel ast.SelectionSet, v *model.FlightEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._FlightEvent(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNFlightEventType2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightEventType(ctx context.Context, v any) (model.FlightEventType, error) {
+	var res model.FlightEventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) marshalNFlightEventType2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightEventType(ctx context.Context, sel ast.SelectionSet, v model.FlightEventType) graphql.Marshaler {
+	return v
+}
 
-	return out
+func (ec *executionContext) unmarshalNFlightStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightStatus(ctx context.Context, v any) (model.FlightStatus, error) {
+	var res model.FlightStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) marshalNFlightStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightStatus(ctx context.Context, sel ast.SelectionSet, v model.FlightStatus) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
+	return res
+}
 
-	return out
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNManagedBooking2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋauthᚐBookingSession(ctx context.Context, sel ast.SelectionSet, v auth.BookingSession) graphql.Marshaler {
+	return ec._ManagedBooking(ctx, sel, &v)
+}
 
-func (ec *executionContext) marshalNBooking2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking(ctx context.Context, sel ast.SelectionSet, v model.Booking) graphql.Marshaler {
-	return ec._Booking(ctx, sel, &v)
+func (ec *executionContext) marshalNManagedBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋauthᚐBookingSession(ctx context.Context, sel ast.SelectionSet, v *auth.BookingSession) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ManagedBooking(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Booking) graphql.Marshaler {
+func (ec *executionContext) marshalNManifestEntry2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋmanifestᚐEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*manifest.Entry) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -4924,7 +15493,7 @@ func (ec *executionContext) marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking(ctx, sel, v[i])
+			ret[i] = ec.marshalNManifestEntry2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋmanifestᚐEntry(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -4944,42 +15513,27 @@ func (ec *executionContext) marshalNBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking(ctx context.Context, sel ast.SelectionSet, v *model.Booking) graphql.Marshaler {
+func (ec *executionContext) marshalNManifestEntry2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋmanifestᚐEntry(ctx context.Context, sel ast.SelectionSet, v *manifest.Entry) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Booking(ctx, sel, v)
+	return ec._ManifestEntry(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNPassengerDocumentInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerDocumentInput(ctx context.Context, v any) (PassengerDocumentInput, error) {
+	res, err := ec.unmarshalInputPassengerDocumentInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNCreateBookingInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐCreateBookingInput(ctx context.Context, v any) (CreateBookingInput, error) {
-	res, err := ec.unmarshalInputCreateBookingInput(ctx, v)
+func (ec *executionContext) unmarshalNPassengerInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerInput(ctx context.Context, v any) (PassengerInput, error) {
+	res, err := ec.unmarshalInputPassengerInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNFare2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare(ctx context.Context, sel ast.SelectionSet, v model.Fare) graphql.Marshaler {
-	return ec._Fare(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNFare2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Fare) graphql.Marshaler {
+func (ec *executionContext) marshalNRebooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRebookingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Rebooking) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5003,7 +15557,7 @@ func (ec *executionContext) marshalNFare2ᚕᚖgithubᚗcomᚋdavidalecrimᚋred
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare(ctx, sel, v[i])
+			ret[i] = ec.marshalNRebooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRebooking(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5020,24 +15574,55 @@ func (ec *executionContext) marshalNFare2ᚕᚖgithubᚗcomᚋdavidalecrimᚋred
 		}
 	}
 
-	return ret
+	return ret
+}
+
+func (ec *executionContext) marshalNRebooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRebooking(ctx context.Context, sel ast.SelectionSet, v *model.Rebooking) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Rebooking(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRebookingOutcome2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRebookingOutcome(ctx context.Context, v any) (model.RebookingOutcome, error) {
+	var res model.RebookingOutcome
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRebookingOutcome2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRebookingOutcome(ctx context.Context, sel ast.SelectionSet, v model.RebookingOutcome) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx context.Context, v any) (model.Role, error) {
+	var res model.Role
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx context.Context, sel ast.SelectionSet, v model.Role) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare(ctx context.Context, sel ast.SelectionSet, v *model.Fare) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) unmarshalNRole2ᚕgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRoleᚄ(ctx context.Context, v any) ([]model.Role, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.Role, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	return ec._Fare(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNFlight2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight(ctx context.Context, sel ast.SelectionSet, v model.Flight) graphql.Marshaler {
-	return ec._Flight(ctx, sel, &v)
+	return res, nil
 }
 
-func (ec *executionContext) marshalNFlight2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Flight) graphql.Marshaler {
+func (ec *executionContext) marshalNRole2ᚕgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRoleᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Role) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5061,7 +15646,7 @@ func (ec *executionContext) marshalNFlight2ᚕᚖgithubᚗcomᚋdavidalecrimᚋr
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight(ctx, sel, v[i])
+			ret[i] = ec.marshalNRole2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐRole(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5081,64 +15666,16 @@ func (ec *executionContext) marshalNFlight2ᚕᚖgithubᚗcomᚋdavidalecrimᚋr
 	return ret
 }
 
-func (ec *executionContext) marshalNFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight(ctx context.Context, sel ast.SelectionSet, v *model.Flight) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Flight(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalFloatContext(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return graphql.WrapContextMarshaler(ctx, res)
-}
-
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
+func (ec *executionContext) unmarshalNSetOverbookingLimitInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐSetOverbookingLimitInput(ctx context.Context, v any) (SetOverbookingLimitInput, error) {
+	res, err := ec.unmarshalInputSetOverbookingLimitInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
+func (ec *executionContext) unmarshalNSignupInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐSignupInput(ctx context.Context, v any) (SignupInput, error) {
+	res, err := ec.unmarshalInputSignupInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -5201,6 +15738,45 @@ func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalNUpdateFlightStatusInput2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐUpdateFlightStatusInput(ctx context.Context, v any) (UpdateFlightStatusInput, error) {
+	res, err := ec.unmarshalInputUpdateFlightStatusInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNUser2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model.User) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._User(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNWaitlistEntry2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐWaitlistEntry(ctx context.Context, sel ast.SelectionSet, v model.WaitlistEntry) graphql.Marshaler {
+	return ec._WaitlistEntry(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNWaitlistEntry2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐWaitlistEntry(ctx context.Context, sel ast.SelectionSet, v *model.WaitlistEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._WaitlistEntry(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNWaitlistStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐWaitlistStatus(ctx context.Context, v any) (model.WaitlistStatus, error) {
+	var res model.WaitlistStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWaitlistStatus2githubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐWaitlistStatus(ctx context.Context, sel ast.SelectionSet, v model.WaitlistStatus) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -5454,6 +16030,53 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 	return res
 }
 
+func (ec *executionContext) marshalOBooking2ᚕᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBookingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Booking) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOBooking2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐBooking(ctx context.Context, sel ast.SelectionSet, v *model.Booking) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -5491,6 +16114,59 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) marshalODeniedBoardingList2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐDeniedBoardingList(ctx context.Context, sel ast.SelectionSet, v *DeniedBoardingList) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DeniedBoardingList(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalODisruptionReport2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐDisruptionReport(ctx context.Context, sel ast.SelectionSet, v *DisruptionReport) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DisruptionReport(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalODocumentType2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐDocumentType(ctx context.Context, v any) (*model.DocumentType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.DocumentType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODocumentType2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐDocumentType(ctx context.Context, sel ast.SelectionSet, v *model.DocumentType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOFare2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFare(ctx context.Context, sel ast.SelectionSet, v *model.Fare) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Fare(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOFareClass2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass(ctx context.Context, v any) (*model.FareClass, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.FareClass)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFareClass2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFareClass(ctx context.Context, sel ast.SelectionSet, v *model.FareClass) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOFlight2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlight(ctx context.Context, sel ast.SelectionSet, v *model.Flight) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -5498,6 +16174,58 @@ func (ec *executionContext) marshalOFlight2ᚖgithubᚗcomᚋdavidalecrimᚋred
 	return ec._Flight(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOFlightManifest2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐFlightManifest(ctx context.Context, sel ast.SelectionSet, v *FlightManifest) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FlightManifest(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOFlightStatus2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightStatus(ctx context.Context, v any) (*model.FlightStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.FlightStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFlightStatus2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐFlightStatus(ctx context.Context, sel ast.SelectionSet, v *model.FlightStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt(v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
 	if v == nil {
 		return nil, nil
@@ -5516,16 +16244,19 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
-func (ec *executionContext) unmarshalOString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOPassengerDocument2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerDocument(ctx context.Context, sel ast.SelectionSet, v *PassengerDocument) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PassengerDocument(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	_ = ctx
-	res := graphql.MarshalString(v)
-	return res
+func (ec *executionContext) unmarshalOPassengerDocumentInput2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋgeneratedᚐPassengerDocumentInput(ctx context.Context, v any) (*PassengerDocumentInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputPassengerDocumentInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
@@ -5546,6 +16277,31 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return res
 }
 
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalTime(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOUser2ᚖgithubᚗcomᚋdavidalecrimᚋredᚑairlinesᚋinternalᚋgraphᚋmodelᚐUser(ctx context.Context, sel ast.SelectionSet, v *model.User) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._User(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -2,17 +2,155 @@
 
 package generated
 
+import (
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/manifest"
+)
+
+type AcceptWaitlistOfferPayload struct {
+	Booking *model.Booking `json:"booking"`
+	// Bearer token for the booking mutations on the new booking, as createBooking returns; null when the waitlist was joined signed in.
+	Token     *string    `json:"token,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type AncillaryOffer struct {
+	Product *model.AncillaryProduct `json:"product"`
+	// Price on the flight's route.
+	Price float64 `json:"price"`
+	// Units left on the flight, null when the product has no inventory.
+	Remaining *int `json:"remaining,omitempty"`
+}
+
+type AssignGateInput struct {
+	FlightID string `json:"flightId"`
+	Gate     string `json:"gate"`
+}
+
+type BoardingPass struct {
+	Booking        *model.Booking `json:"booking"`
+	Flight         *model.Flight  `json:"flight"`
+	SeatNumber     string         `json:"seatNumber"`
+	SequenceNumber int            `json:"sequenceNumber"`
+	// IATA Bar Coded Boarding Pass (BCBP) string, as encoded in the PDF417 barcode.
+	Barcode string `json:"barcode"`
+	// Relative URL of the PNG barcode, valid for 15 minutes; append format=pdf for a printable pass.
+	DownloadURL string `json:"downloadUrl"`
+}
+
 type CreateBookingInput struct {
 	FlightID       string  `json:"flightId"`
 	FareID         string  `json:"fareId"`
 	PassengerName  string  `json:"passengerName"`
 	PassengerEmail string  `json:"passengerEmail"`
 	PassengerPhone *string `json:"passengerPhone,omitempty"`
-	SeatNumber     *string `json:"seatNumber,omitempty"`
+	// Picks the seat and buys seat selection with the booking; PROMO fares cannot.
+	SeatNumber      *string                 `json:"seatNumber,omitempty"`
+	SpecialRequests *string                 `json:"specialRequests,omitempty"`
+	Document        *PassengerDocumentInput `json:"document,omitempty"`
+}
+
+type CreateBookingPayload struct {
+	Booking *model.Booking `json:"booking"`
+	// Bearer token for the booking mutations on the new booking, as manageBooking returns; null when signed in.
+	Token     *string    `json:"token,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type DelayFlightInput struct {
+	FlightID     string    `json:"flightId"`
+	NewDeparture time.Time `json:"newDeparture"`
+	Reason       *string   `json:"reason,omitempty"`
+}
+
+type DeniedBoardingList struct {
+	Flight        *model.Flight `json:"flight"`
+	OversoldSeats int           `json:"oversoldSeats"`
+	// Passengers who offered to give up their seat, in booking order.
+	Volunteers []*model.Booking `json:"volunteers"`
+	// Remaining passengers in the order they are denied boarding involuntarily.
+	Candidates []*model.Booking `json:"candidates"`
+}
+
+type DisruptionReport struct {
+	Flight              *model.Flight      `json:"flight"`
+	TotalAffected       int                `json:"totalAffected"`
+	RebookedCount       int                `json:"rebookedCount"`
+	UnaccommodatedCount int                `json:"unaccommodatedCount"`
+	Rebookings          []*model.Rebooking `json:"rebookings"`
+}
+
+type FlightManifest struct {
+	Flight *model.Flight `json:"flight"`
+	// Bookings that are not cancelled, sorted by seat row; passengers without a seat come last.
+	Entries []*manifest.Entry `json:"entries"`
+	// Relative URL of the CSV download, which needs the same access token; append
+	// format=pdf for a printable manifest.
+	DownloadURL string `json:"downloadUrl"`
 }
 
+// Booking mutations act on bookings of the signed-in user or of the booking token
+// returned by manageBooking, sent as the bearer token.
 type Mutation struct {
 }
 
+// Identity document of a passenger, without the sensitive details.
+type PassengerDocument struct {
+	DocumentType model.DocumentType `json:"documentType"`
+	// Document number with all but the last three characters masked.
+	MaskedNumber   string `json:"maskedNumber"`
+	Nationality    string `json:"nationality"`
+	IssuingCountry string `json:"issuingCountry"`
+	// YYYY-MM-DD
+	ExpiryDate string `json:"expiryDate"`
+}
+
+// Identity document of a passenger. Either the two lines of a passport machine
+// readable zone (mrz) or the individual fields; dates are YYYY-MM-DD and country
+// codes ISO 3166 alpha-3.
+type PassengerDocumentInput struct {
+	Mrz            *string             `json:"mrz,omitempty"`
+	DocumentType   *model.DocumentType `json:"documentType,omitempty"`
+	DocumentNumber *string             `json:"documentNumber,omitempty"`
+	Nationality    *string             `json:"nationality,omitempty"`
+	IssuingCountry *string             `json:"issuingCountry,omitempty"`
+	DateOfBirth    *string             `json:"dateOfBirth,omitempty"`
+	ExpiryDate     *string             `json:"expiryDate,omitempty"`
+	Sex            *string             `json:"sex,omitempty"`
+}
+
+type PassengerInput struct {
+	Name  string  `json:"name"`
+	Email string  `json:"email"`
+	Phone *string `json:"phone,omitempty"`
+}
+
 type Query struct {
 }
+
+// Overbooking limit of a flight, or of one of its fares when fareClass is set.
+// Give either seats or a percentage of totalSeats; leave both out to clear it.
+type SetOverbookingLimitInput struct {
+	FlightID  string           `json:"flightId"`
+	FareClass *model.FareClass `json:"fareClass,omitempty"`
+	Seats     *int             `json:"seats,omitempty"`
+	Percent   *float64         `json:"percent,omitempty"`
+}
+
+type SignupInput struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+	// 8 to 72 bytes.
+	Password string `json:"password"`
+}
+
+type Subscription struct {
+}
+
+type UpdateFlightStatusInput struct {
+	FlightID string             `json:"flightId"`
+	Status   model.FlightStatus `json:"status"`
+	Reason   *string            `json:"reason,omitempty"`
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// AncillaryProduct is an extra sold on top of a fare, such as a bag or a meal.
+type AncillaryProduct struct {
+	ID            string            `db:"id"`
+	Code          string            `db:"code"`
+	Name          string            `db:"name"`
+	Category      AncillaryCategory `db:"category"`
+	Description   string            `db:"description"`
+	BasePrice     float64           `db:"base_price"`
+	MaxPerBooking int               `db:"max_per_booking"`
+	// FlightInventory caps the units sold per flight, nil means unlimited.
+	FlightInventory *int           `db:"flight_inventory"`
+	AllowedClasses  pq.StringArray `db:"allowed_fare_classes"`
+	CreatedAt       time.Time      `db:"created_at"`
+	UpdatedAt       time.Time      `db:"updated_at"`
+}
+
+// AllowedFareClasses lists the fare classes that can buy the product.
+func (p *AncillaryProduct) AllowedFareClasses() []FareClass {
+	classes := make([]FareClass, 0, len(p.AllowedClasses))
+	for _, class := range p.AllowedClasses {
+		classes = append(classes, FareClass(class))
+	}
+	return classes
+}
+
+// Allows reports whether bookings on class can buy the product.
+func (p *AncillaryProduct) Allows(class FareClass) bool {
+	return slices.Contains(p.AllowedClasses, string(class))
+}
+
+// BookingAncillary is a line item of a booking, priced when it was bought.
+type BookingAncillary struct {
+	ID         string    `db:"id"`
+	BookingID  string    `db:"booking_id"`
+	ProductID  string    `db:"product_id"`
+	Quantity   int       `db:"quantity"`
+	UnitPrice  float64   `db:"unit_price"`
+	TotalPrice float64   `db:"total_price"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type AncillaryCategory string
+
+const (
+	AncillaryCategoryBaggage          AncillaryCategory = "BAGGAGE"
+	AncillaryCategoryMeal             AncillaryCategory = "MEAL"
+	AncillaryCategoryPriorityBoarding AncillaryCategory = "PRIORITY_BOARDING"
+	AncillaryCategorySeatSelection    AncillaryCategory = "SEAT_SELECTION"
+	AncillaryCategoryLoungePass       AncillaryCategory = "LOUNGE_PASS"
+)
+
+var AllAncillaryCategory = []AncillaryCategory{
+	AncillaryCategoryBaggage,
+	AncillaryCategoryMeal,
+	AncillaryCategoryPriorityBoarding,
+	AncillaryCategorySeatSelection,
+	AncillaryCategoryLoungePass,
+}
+
+func (e AncillaryCategory) IsValid() bool {
+	switch e {
+	case AncillaryCategoryBaggage, AncillaryCategoryMeal, AncillaryCategoryPriorityBoarding,
+		AncillaryCategorySeatSelection, AncillaryCategoryLoungePass:
+		return true
+	}
+	return false
+}
+
+func (e AncillaryCategory) String() string {
+	return string(e)
+}
+
+func (e *AncillaryCategory) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ancillary category must be a string")
+	}
+	*e = AncillaryCategory(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AncillaryCategory", str)
+	}
+	return nil
+}
+
+func (e AncillaryCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
+}
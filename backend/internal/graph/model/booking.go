@@ -1,19 +1,144 @@
 package model
 
-import "time"
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type Booking struct {
-	ID               string    `db:"id"`
-	BookingReference string    `db:"booking_reference"`
-	FlightID         string    `db:"flight_id"`
-	FareID           string    `db:"fare_id"`
-	PassengerName    string    `db:"passenger_name"`
-	PassengerEmail   string    `db:"passenger_email"`
-	PassengerPhone   string    `db:"passenger_phone"`
-	SeatNumber       string    `db:"seat_number"`
-	BookingStatus    string    `db:"booking_status"`
-	TotalPrice       float64   `db:"total_price"`
-	BookedAt         time.Time `db:"booked_at"`
-	CreatedAt        time.Time `db:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at"`
+	ID               string        `db:"id"`
+	BookingReference string        `db:"booking_reference"`
+	FlightID         string        `db:"flight_id"`
+	FareID           string        `db:"fare_id"`
+	PassengerName    string        `db:"passenger_name"`
+	PassengerEmail   string        `db:"passenger_email"`
+	PassengerPhone   *string       `db:"passenger_phone"`
+	SeatNumber       *string       `db:"seat_number"`
+	SpecialRequests  *string       `db:"special_requests"`
+	BookingStatus    BookingStatus `db:"booking_status"`
+	TotalPrice       float64       `db:"total_price"`
+	BookedAt         time.Time     `db:"booked_at"`
+	CheckedInAt      *time.Time    `db:"checked_in_at"`
+	CheckInSequence  *int          `db:"check_in_sequence"`
+	// DeniedBoardingVolunteer is set by passengers willing to give up their
+	// seat when the flight is oversold.
+	DeniedBoardingVolunteer bool `db:"denied_boarding_volunteer"`
+	// UserID is the account that made the booking, nil for guest bookings.
+	UserID    *string   `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// LastName returns the last word of the passenger name.
+func (b *Booking) LastName() string {
+	fields := strings.Fields(b.PassengerName)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[len(fields)-1]
+}
+
+// MatchesLastName reports whether lastName identifies the passenger of the
+// booking, ignoring case and surrounding spaces. Both names are hashed before
+// the constant-time comparison so neither their content nor their length
+// leaks through timing.
+func (b *Booking) MatchesLastName(lastName string) bool {
+	lastName = strings.TrimSpace(lastName)
+	want := sha256.Sum256([]byte(strings.ToLower(b.LastName())))
+	got := sha256.Sum256([]byte(strings.ToLower(lastName)))
+	return subtle.ConstantTimeCompare(want[:], got[:]) == 1 && lastName != ""
+}
+
+type BookingStatus string
+
+const (
+	BookingStatusConfirmed BookingStatus = "CONFIRMED"
+	BookingStatusCancelled BookingStatus = "CANCELLED"
+	BookingStatusCheckedIn BookingStatus = "CHECKED_IN"
+	BookingStatusCompleted BookingStatus = "COMPLETED"
+	// BookingStatusDisrupted marks bookings whose flight was cancelled and
+	// that could not be re-accommodated automatically.
+	BookingStatusDisrupted BookingStatus = "DISRUPTED"
+)
+
+var AllBookingStatus = []BookingStatus{BookingStatusConfirmed, BookingStatusCancelled, BookingStatusCheckedIn, BookingStatusCompleted, BookingStatusDisrupted}
+
+func (e BookingStatus) IsValid() bool {
+	switch e {
+	case BookingStatusConfirmed, BookingStatusCancelled, BookingStatusCheckedIn, BookingStatusCompleted, BookingStatusDisrupted:
+		return true
+	}
+	return false
+}
+
+// HoldsSeat reports whether the booking occupies a seat on its flight.
+func (e BookingStatus) HoldsSeat() bool {
+	return e == BookingStatusConfirmed || e == BookingStatusCheckedIn
+}
+
+// IsCancellable reports whether a passenger can still cancel the booking.
+func (e BookingStatus) IsCancellable() bool {
+	return e == BookingStatusConfirmed || e == BookingStatusCheckedIn || e == BookingStatusDisrupted
+}
+
+func (e BookingStatus) String() string {
+	return string(e)
+}
+
+func (e *BookingStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("booking status must be a string")
+	}
+	*e = BookingStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BookingStatus", str)
+	}
+	return nil
+}
+
+func (e BookingStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
+}
+
+type DocumentType string
+
+const (
+	DocumentTypePassport DocumentType = "PASSPORT"
+	DocumentTypeIDCard   DocumentType = "ID_CARD"
+)
+
+var AllDocumentType = []DocumentType{DocumentTypePassport, DocumentTypeIDCard}
+
+func (e DocumentType) IsValid() bool {
+	switch e {
+	case DocumentTypePassport, DocumentTypeIDCard:
+		return true
+	}
+	return false
+}
+
+func (e DocumentType) String() string {
+	return string(e)
+}
+
+func (e *DocumentType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("document type must be a string")
+	}
+	*e = DocumentType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DocumentType", str)
+	}
+	return nil
+}
+
+func (e DocumentType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
 }
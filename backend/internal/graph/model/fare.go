@@ -1,16 +1,69 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+)
 
 type Fare struct {
 	ID               string    `db:"id"`
 	FlightID         string    `db:"flight_id"`
-	FareClass        string    `db:"fare_class"`
+	FareClass        FareClass `db:"fare_class"`
 	Price            float64   `db:"price"`
 	BaggageAllowance int       `db:"baggage_allowance"`
 	IsRefundable     bool      `db:"is_refundable"`
 	IsChangeable     bool      `db:"is_changeable"`
-	AvailableSeats   int       `db:"available_seats"`
-	CreatedAt        time.Time `db:"created_at"`
-	UpdatedAt        time.Time `db:"updated_at"`
+	// AvailableSeats goes negative once the fare is overbooked.
+	AvailableSeats     int       `db:"available_seats"`
+	OverbookingSeats   *int      `db:"overbooking_seats"`
+	OverbookingPercent *float64  `db:"overbooking_percent"`
+	CreatedAt          time.Time `db:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at"`
+}
+
+type FareClass string
+
+const (
+	FareClassPromo FareClass = "PROMO"
+	FareClassBasic FareClass = "BASIC"
+	FareClassPro   FareClass = "PRO"
+)
+
+var AllFareClass = []FareClass{FareClassPromo, FareClassBasic, FareClassPro}
+
+func (e FareClass) IsValid() bool {
+	switch e {
+	case FareClassPromo, FareClassBasic, FareClassPro:
+		return true
+	}
+	return false
+}
+
+// Rank orders fare classes from the most restrictive (PROMO) to the most
+// flexible (PRO).
+func (e FareClass) Rank() int {
+	return slices.Index(AllFareClass, e)
+}
+
+func (e FareClass) String() string {
+	return string(e)
+}
+
+func (e *FareClass) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("fare class must be a string")
+	}
+	*e = FareClass(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FareClass", str)
+	}
+	return nil
+}
+
+func (e FareClass) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
 }
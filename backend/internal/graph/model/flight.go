@@ -1,18 +1,91 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+)
 
 type Flight struct {
-	ID             string    `db:"id"`
-	FlightNumber   string    `db:"flight_number"`
-	Origin         string    `db:"origin"`
-	Destination    string    `db:"destination"`
-	DepartureTime  time.Time `db:"departure_time"`
-	ArrivalTime    time.Time `db:"arrival_time"`
-	AircraftType   string    `db:"aircraft_type"`
-	TotalSeats     int       `db:"total_seats"`
-	AvailableSeats int       `db:"available_seats"`
-	Status         string    `db:"status"`
-	CreatedAt      time.Time `db:"created_at"`
-	UpdatedAt      time.Time `db:"updated_at"`
+	ID             string       `db:"id"`
+	FlightNumber   string       `db:"flight_number"`
+	Origin         string       `db:"origin"`
+	Destination    string       `db:"destination"`
+	DepartureTime  time.Time    `db:"departure_time"`
+	ArrivalTime    time.Time    `db:"arrival_time"`
+	AircraftType   string       `db:"aircraft_type"`
+	TotalSeats     int          `db:"total_seats"`
+	AvailableSeats int          `db:"available_seats"`
+	Status         FlightStatus `db:"status"`
+	Gate           *string      `db:"gate"`
+	DelayMinutes   int          `db:"delay_minutes"`
+	// At most one of OverbookingSeats and OverbookingPercent is set.
+	OverbookingSeats   *int      `db:"overbooking_seats"`
+	OverbookingPercent *float64  `db:"overbooking_percent"`
+	CreatedAt          time.Time `db:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at"`
+}
+
+// EstimatedDeparture is the scheduled departure shifted by the current delay.
+func (f *Flight) EstimatedDeparture() time.Time {
+	return f.DepartureTime.Add(time.Duration(f.DelayMinutes) * time.Minute)
+}
+
+type FlightStatus string
+
+const (
+	FlightStatusScheduled FlightStatus = "SCHEDULED"
+	FlightStatusBoarding  FlightStatus = "BOARDING"
+	FlightStatusDeparted  FlightStatus = "DEPARTED"
+	FlightStatusArrived   FlightStatus = "ARRIVED"
+	FlightStatusCancelled FlightStatus = "CANCELLED"
+)
+
+var AllFlightStatus = []FlightStatus{FlightStatusScheduled, FlightStatusBoarding, FlightStatusDeparted, FlightStatusArrived, FlightStatusCancelled}
+
+func (e FlightStatus) IsValid() bool {
+	switch e {
+	case FlightStatusScheduled, FlightStatusBoarding, FlightStatusDeparted, FlightStatusArrived, FlightStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// flightStatusTransitions lists the legal moves of the flight lifecycle.
+// ARRIVED and CANCELLED are terminal.
+var flightStatusTransitions = map[FlightStatus][]FlightStatus{
+	FlightStatusScheduled: {FlightStatusBoarding, FlightStatusCancelled},
+	FlightStatusBoarding:  {FlightStatusScheduled, FlightStatusDeparted, FlightStatusCancelled},
+	FlightStatusDeparted:  {FlightStatusArrived},
+}
+
+func (e FlightStatus) CanTransitionTo(next FlightStatus) bool {
+	return slices.Contains(flightStatusTransitions[e], next)
+}
+
+// IsOperable reports whether the flight can still be delayed or re-gated.
+func (e FlightStatus) IsOperable() bool {
+	return e == FlightStatusScheduled || e == FlightStatusBoarding
+}
+
+func (e FlightStatus) String() string {
+	return string(e)
+}
+
+func (e *FlightStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("flight status must be a string")
+	}
+	*e = FlightStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FlightStatus", str)
+	}
+	return nil
+}
+
+func (e FlightStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
 }
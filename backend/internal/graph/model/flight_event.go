@@ -0,0 +1,59 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type FlightEvent struct {
+	ID             string          `db:"id"`
+	FlightID       string          `db:"flight_id"`
+	EventType      FlightEventType `db:"event_type"`
+	PreviousStatus *FlightStatus   `db:"previous_status"`
+	NewStatus      *FlightStatus   `db:"new_status"`
+	Gate           *string         `db:"gate"`
+	DelayMinutes   *int            `db:"delay_minutes"`
+	Reason         *string         `db:"reason"`
+	Actor          string          `db:"actor"`
+	OccurredAt     time.Time       `db:"occurred_at"`
+}
+
+type FlightEventType string
+
+const (
+	FlightEventTypeStatusChanged FlightEventType = "STATUS_CHANGED"
+	FlightEventTypeDelayed       FlightEventType = "DELAYED"
+	FlightEventTypeGateAssigned  FlightEventType = "GATE_ASSIGNED"
+)
+
+var AllFlightEventType = []FlightEventType{FlightEventTypeStatusChanged, FlightEventTypeDelayed, FlightEventTypeGateAssigned}
+
+func (e FlightEventType) IsValid() bool {
+	switch e {
+	case FlightEventTypeStatusChanged, FlightEventTypeDelayed, FlightEventTypeGateAssigned:
+		return true
+	}
+	return false
+}
+
+func (e FlightEventType) String() string {
+	return string(e)
+}
+
+func (e *FlightEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("flight event type must be a string")
+	}
+	*e = FlightEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FlightEventType", str)
+	}
+	return nil
+}
+
+func (e FlightEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
+}
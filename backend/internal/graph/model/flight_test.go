@@ -0,0 +1,49 @@
+package model
+
+import "testing"
+
+func TestFlightStatusCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from, to FlightStatus
+		want     bool
+	}{
+		{FlightStatusScheduled, FlightStatusBoarding, true},
+		{FlightStatusScheduled, FlightStatusCancelled, true},
+		{FlightStatusScheduled, FlightStatusDeparted, false},
+		{FlightStatusScheduled, FlightStatusScheduled, false},
+		{FlightStatusBoarding, FlightStatusScheduled, true},
+		{FlightStatusBoarding, FlightStatusDeparted, true},
+		{FlightStatusBoarding, FlightStatusCancelled, true},
+		{FlightStatusBoarding, FlightStatusArrived, false},
+		{FlightStatusDeparted, FlightStatusArrived, true},
+		{FlightStatusDeparted, FlightStatusCancelled, false},
+		{FlightStatusDeparted, FlightStatusScheduled, false},
+		{FlightStatusArrived, FlightStatusScheduled, false},
+		{FlightStatusArrived, FlightStatusDeparted, false},
+		{FlightStatusCancelled, FlightStatusScheduled, false},
+		{FlightStatusCancelled, FlightStatusBoarding, false},
+	}
+	for _, tt := range tests {
+		if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+			t.Errorf("%s -> %s: expected %v, got %v", tt.from, tt.to, tt.want, got)
+		}
+	}
+}
+
+func TestFlightStatusIsOperable(t *testing.T) {
+	tests := []struct {
+		status FlightStatus
+		want   bool
+	}{
+		{FlightStatusScheduled, true},
+		{FlightStatusBoarding, true},
+		{FlightStatusDeparted, false},
+		{FlightStatusArrived, false},
+		{FlightStatusCancelled, false},
+	}
+	for _, tt := range tests {
+		if got := tt.status.IsOperable(); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.status, tt.want, got)
+		}
+	}
+}
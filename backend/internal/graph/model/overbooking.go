@@ -0,0 +1,38 @@
+package model
+
+import "math"
+
+// overbookingAllowance converts a limit stored either as seats or as a
+// percentage of totalSeats into seats. ok is false when no limit is set.
+func overbookingAllowance(seats *int, percent *float64, totalSeats int) (allowance int, ok bool) {
+	switch {
+	case seats != nil:
+		return *seats, true
+	case percent != nil:
+		return int(math.Floor(float64(totalSeats) * *percent / 100)), true
+	}
+	return 0, false
+}
+
+// OverbookingAllowance returns how many seats may be sold on the flight
+// beyond TotalSeats. ok is false when the flight has no limit of its own, in
+// which case only the limits of its fares apply.
+func (f *Flight) OverbookingAllowance() (allowance int, ok bool) {
+	return overbookingAllowance(f.OverbookingSeats, f.OverbookingPercent, f.TotalSeats)
+}
+
+// OverbookingAllowance returns how many seats may be sold on the fare beyond
+// its allocation. Fares without a limit of their own use the flight limit,
+// and neither set means no overbooking.
+func (f *Fare) OverbookingAllowance(flight *Flight) int {
+	if allowance, ok := overbookingAllowance(f.OverbookingSeats, f.OverbookingPercent, flight.TotalSeats); ok {
+		return allowance
+	}
+	allowance, _ := flight.OverbookingAllowance()
+	return allowance
+}
+
+// OversoldSeats is the number of seats sold beyond the fare allocation.
+func (f *Fare) OversoldSeats() int {
+	return max(0, -f.AvailableSeats)
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Rebooking records how a booking was handled after its flight was cancelled.
+type Rebooking struct {
+	ID               string           `db:"id"`
+	BookingID        string           `db:"booking_id"`
+	OriginalFlightID string           `db:"original_flight_id"`
+	OriginalFareID   string           `db:"original_fare_id"`
+	NewFlightID      *string          `db:"new_flight_id"`
+	NewFareID        *string          `db:"new_fare_id"`
+	Outcome          RebookingOutcome `db:"outcome"`
+	ProcessedAt      time.Time        `db:"processed_at"`
+}
+
+type RebookingOutcome string
+
+const (
+	RebookingOutcomeRebooked       RebookingOutcome = "REBOOKED"
+	RebookingOutcomeUnaccommodated RebookingOutcome = "UNACCOMMODATED"
+)
+
+var AllRebookingOutcome = []RebookingOutcome{RebookingOutcomeRebooked, RebookingOutcomeUnaccommodated}
+
+func (e RebookingOutcome) IsValid() bool {
+	switch e {
+	case RebookingOutcomeRebooked, RebookingOutcomeUnaccommodated:
+		return true
+	}
+	return false
+}
+
+func (e RebookingOutcome) String() string {
+	return string(e)
+}
+
+func (e *RebookingOutcome) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("rebooking outcome must be a string")
+	}
+	*e = RebookingOutcome(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RebookingOutcome", str)
+	}
+	return nil
+}
+
+func (e RebookingOutcome) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// User is a customer or staff account.
+type User struct {
+	ID           string         `db:"id"`
+	Email        string         `db:"email"`
+	Name         string         `db:"name"`
+	PasswordHash string         `db:"password_hash"`
+	RoleNames    pq.StringArray `db:"roles"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+// Roles lists the roles granted to the user.
+func (u *User) Roles() []Role {
+	roles := make([]Role, 0, len(u.RoleNames))
+	for _, role := range u.RoleNames {
+		roles = append(roles, Role(role))
+	}
+	return roles
+}
+
+// HasRole reports whether role was granted to the user.
+func (u *User) HasRole(role Role) bool {
+	return slices.Contains(u.RoleNames, string(role))
+}
+
+// RefreshToken is a refresh token of a user. Only its SHA-256 hash is
+// stored; tokens are rotated on every use and revoked on logout.
+type RefreshToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	TokenHash []byte     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type Role string
+
+const (
+	RoleCustomer Role = "CUSTOMER"
+	// RoleAgent is held by customer service agents, who act on bookings on
+	// behalf of passengers.
+	RoleAgent Role = "AGENT"
+	// RoleOps is held by operations staff, who manage flights.
+	RoleOps Role = "OPS"
+)
+
+var AllRole = []Role{RoleCustomer, RoleAgent, RoleOps}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleCustomer, RoleAgent, RoleOps:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("role must be a string")
+	}
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
+}
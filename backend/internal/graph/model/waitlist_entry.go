@@ -0,0 +1,65 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type WaitlistEntry struct {
+	ID             string         `db:"id"`
+	FareID         string         `db:"fare_id"`
+	FlightID       string         `db:"flight_id"`
+	PassengerName  string         `db:"passenger_name"`
+	PassengerEmail string         `db:"passenger_email"`
+	PassengerPhone *string        `db:"passenger_phone"`
+	Status         WaitlistStatus `db:"status"`
+	OfferExpiresAt *time.Time     `db:"offer_expires_at"`
+	BookingID      *string        `db:"booking_id"`
+	// UserID is the account that joined the waitlist, nil for guests.
+	UserID    *string   `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type WaitlistStatus string
+
+const (
+	// WaitlistStatusWaiting entries are queued for the next released seat.
+	WaitlistStatusWaiting WaitlistStatus = "WAITING"
+	// WaitlistStatusOffered entries hold a seat until OfferExpiresAt.
+	WaitlistStatusOffered WaitlistStatus = "OFFERED"
+	WaitlistStatusBooked  WaitlistStatus = "BOOKED"
+	WaitlistStatusExpired WaitlistStatus = "EXPIRED"
+)
+
+var AllWaitlistStatus = []WaitlistStatus{WaitlistStatusWaiting, WaitlistStatusOffered, WaitlistStatusBooked, WaitlistStatusExpired}
+
+func (e WaitlistStatus) IsValid() bool {
+	switch e {
+	case WaitlistStatusWaiting, WaitlistStatusOffered, WaitlistStatusBooked, WaitlistStatusExpired:
+		return true
+	}
+	return false
+}
+
+func (e WaitlistStatus) String() string {
+	return string(e)
+}
+
+func (e *WaitlistStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("waitlist status must be a string")
+	}
+	*e = WaitlistStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WaitlistStatus", str)
+	}
+	return nil
+}
+
+func (e WaitlistStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(string(e)))
+}
@@ -0,0 +1,100 @@
+package persisted
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/jmoiron/sqlx"
+)
+
+// MaxQueryLength is the size in bytes of the longest query PostgresCache
+// stores. Longer queries still run, but are not registered.
+const MaxQueryLength = 16 << 10
+
+// PostgresCache stores automatic persisted queries in persisted_queries so a
+// hash registered on one server instance is known to all of them. Recently
+// used queries are kept in an in-process LRU in front of the table.
+type PostgresCache struct {
+	db    *sqlx.DB
+	local *lru.LRU[string]
+}
+
+var _ graphql.Cache[string] = (*PostgresCache)(nil)
+
+func NewPostgresCache(db *sqlx.DB, size int) *PostgresCache {
+	return &PostgresCache{db: db, local: lru.New[string](size)}
+}
+
+// Get reports a database failure as a miss; the client then sends the full
+// query again.
+func (c *PostgresCache) Get(ctx context.Context, hash string) (string, bool) {
+	if query, ok := c.local.Get(ctx, hash); ok {
+		return query, true
+	}
+
+	var query string
+	err := c.db.GetContext(ctx, &query, `
+		UPDATE persisted_queries SET last_used_at = CURRENT_TIMESTAMP WHERE hash = $1 RETURNING query
+	`, hash)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Failed to load persisted query: %v", err)
+		}
+		return "", false
+	}
+
+	c.local.Add(ctx, hash, query)
+	return query, true
+}
+
+// Add ignores queries longer than MaxQueryLength, so clients cannot fill
+// the table with large documents.
+func (c *PostgresCache) Add(ctx context.Context, hash, query string) {
+	if len(query) > MaxQueryLength {
+		return
+	}
+	c.local.Add(ctx, hash, query)
+
+	_, err := c.db.ExecContext(ctx, `
+		INSERT INTO persisted_queries (hash, query) VALUES ($1, $2)
+		ON CONFLICT (hash) DO UPDATE SET last_used_at = CURRENT_TIMESTAMP
+	`, hash, query)
+	if err != nil {
+		log.Printf("Failed to store persisted query: %v", err)
+	}
+}
+
+// Prune deletes the queries no instance has loaded or registered for
+// maxIdle. Instances serving a query from their LRU do not touch its row; if
+// it is pruned, the next miss makes the client register it again.
+func (c *PostgresCache) Prune(ctx context.Context, maxIdle time.Duration) (int64, error) {
+	res, err := c.db.ExecContext(ctx, `
+		DELETE FROM persisted_queries WHERE last_used_at < CURRENT_TIMESTAMP - $1::float8 * INTERVAL '1 second'
+	`, maxIdle.Seconds())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// RunPrune calls Prune every interval until ctx is cancelled.
+func (c *PostgresCache) RunPrune(ctx context.Context, interval, maxIdle time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.Prune(ctx, maxIdle); err != nil {
+				log.Printf("Failed to prune persisted queries: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package persisted
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errNotTrusted = "OPERATION_NOT_TRUSTED"
+
+// TrustedDocuments only executes operations listed in a manifest generated
+// from the frontend's documents. Clients send the hash the way automatic
+// persisted queries do, in extensions.persistedQuery.sha256Hash; a full query
+// is accepted only if its hash is in the manifest. Nothing can be registered
+// at runtime.
+type TrustedDocuments struct {
+	documents map[string]string
+}
+
+var (
+	_ graphql.HandlerExtension          = (*TrustedDocuments)(nil)
+	_ graphql.OperationParameterMutator = (*TrustedDocuments)(nil)
+)
+
+// LoadManifest reads a JSON object mapping the SHA-256 hex hash of every
+// document to its text, as written by graphql-codegen's persistedDocuments
+// option with hashAlgorithm sha256.
+func LoadManifest(path string) (*TrustedDocuments, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var documents map[string]string
+	if err := json.Unmarshal(data, &documents); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+	for hash, query := range documents {
+		if queryHash(query) != hash {
+			return nil, fmt.Errorf("manifest %s: hash %s does not match its document", path, hash)
+		}
+	}
+
+	return &TrustedDocuments{documents: documents}, nil
+}
+
+// Len returns the number of trusted documents.
+func (t *TrustedDocuments) Len() int {
+	return len(t.documents)
+}
+
+func (t *TrustedDocuments) ExtensionName() string {
+	return "TrustedDocuments"
+}
+
+func (t *TrustedDocuments) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t *TrustedDocuments) MutateOperationParameters(_ context.Context, params *graphql.RawParams) *gqlerror.Error {
+	var extension struct {
+		Sha256 string `mapstructure:"sha256Hash"`
+	}
+	if persisted := params.Extensions["persistedQuery"]; persisted != nil {
+		if err := mapstructure.Decode(persisted, &extension); err != nil {
+			return gqlerror.Errorf("invalid persistedQuery extension")
+		}
+	}
+
+	hash := extension.Sha256
+	if hash == "" {
+		hash = queryHash(params.Query)
+	}
+
+	query, ok := t.documents[hash]
+	if !ok || (params.Query != "" && params.Query != query) {
+		err := gqlerror.Errorf("operation is not a trusted document")
+		errcode.Set(err, errNotTrusted)
+		return err
+	}
+
+	params.Query = query
+	return nil
+}
+
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,67 @@
+package querylimit
+
+import "github.com/davidalecrim/red-airlines/internal/graph/generated"
+
+const (
+	// DefaultListLimit is used by list queries called without a limit.
+	DefaultListLimit = 50
+	// MaxListLimit caps the limit argument of list queries.
+	MaxListLimit = 100
+)
+
+// Expected sizes of lists without a limit argument, used to weight their
+// children. They are deliberately on the high side of real data.
+const (
+	faresPerFlight        = 3
+	eventsPerFlight       = 10
+	bookingsPerFlight     = 100
+	bookingsPerFare       = 50
+	bookingsPerUser       = DefaultListLimit
+	ancillariesPerBooking = 5
+	ancillaryProducts     = 10
+	rebookingsPerFlight   = bookingsPerFlight
+	deniedBoardingPerList = 20
+)
+
+// ListSize returns the number of rows a list query returns for limit.
+func ListSize(limit *int) int {
+	if limit == nil || *limit <= 0 {
+		return DefaultListLimit
+	}
+	return min(*limit, MaxListLimit)
+}
+
+// Complexity weights list fields by the number of items they return, so the
+// cost of a query grows with every level of nested lists.
+func Complexity() generated.ComplexityRoot {
+	var c generated.ComplexityRoot
+
+	c.Query.Flights = func(childComplexity int, _, _ *string, limit *int) int {
+		return 1 + ListSize(limit)*childComplexity
+	}
+	c.Query.Bookings = func(childComplexity int, _ *string, limit *int) int {
+		return 1 + ListSize(limit)*childComplexity
+	}
+	c.Query.AncillaryOffers = func(childComplexity int, _ string) int {
+		return 1 + ancillaryProducts*childComplexity
+	}
+
+	c.Flight.Fares = list(faresPerFlight)
+	c.Flight.StatusHistory = list(eventsPerFlight)
+	c.Flight.Bookings = list(bookingsPerFlight)
+	c.Fare.Bookings = list(bookingsPerFare)
+	c.User.Bookings = list(bookingsPerUser)
+	c.Booking.Ancillaries = list(ancillariesPerBooking)
+	c.FlightManifest.Entries = list(bookingsPerFlight)
+	c.DisruptionReport.Rebookings = list(rebookingsPerFlight)
+	c.DeniedBoardingList.Volunteers = list(deniedBoardingPerList)
+	c.DeniedBoardingList.Candidates = list(deniedBoardingPerList)
+
+	return c
+}
+
+func list(size int) func(childComplexity int) int {
+	return func(childComplexity int) int {
+		return 1 + size*childComplexity
+	}
+}
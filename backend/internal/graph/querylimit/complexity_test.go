@@ -0,0 +1,54 @@
+package querylimit
+
+import "testing"
+
+func TestListSize(t *testing.T) {
+	limit := func(n int) *int { return &n }
+
+	tests := []struct {
+		name  string
+		limit *int
+		want  int
+	}{
+		{"no limit", nil, DefaultListLimit},
+		{"zero", limit(0), DefaultListLimit},
+		{"negative", limit(-5), DefaultListLimit},
+		{"below the cap", limit(10), 10},
+		{"at the cap", limit(MaxListLimit), MaxListLimit},
+		{"above the cap", limit(1000), MaxListLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ListSize(tt.limit); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestComplexity(t *testing.T) {
+	c := Complexity()
+	limit := 10
+
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{"flights without a limit", c.Query.Flights(2, nil, nil, nil), 1 + DefaultListLimit*2},
+		{"flights with a limit", c.Query.Flights(2, nil, nil, &limit), 21},
+		{"bookings", c.Query.Bookings(3, nil, &limit), 31},
+		{"ancillary offers", c.Query.AncillaryOffers(1, "RDA1B2C3D"), 1 + ancillaryProducts},
+		{"flight fares", c.Flight.Fares(2), 1 + faresPerFlight*2},
+		{"flight bookings", c.Flight.Bookings(1), 1 + bookingsPerFlight},
+		{"manifest entries", c.FlightManifest.Entries(1), 1 + bookingsPerFlight},
+		// Flight.bookings → Booking.flight → Flight.bookings grows with
+		// every level.
+		{"nested bookings", c.Flight.Bookings(1 + c.Flight.Bookings(1)), 1 + bookingsPerFlight*(2+bookingsPerFlight)},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %d, got %d", tt.name, tt.want, tt.got)
+		}
+	}
+}
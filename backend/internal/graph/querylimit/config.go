@@ -0,0 +1,57 @@
+package querylimit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+
+	"github.com/davidalecrim/red-airlines/internal/ratelimit"
+)
+
+// Config is read from the environment:
+//
+//	GRAPHQL_MAX_DEPTH                 (10)
+//	GRAPHQL_MAX_COMPLEXITY            (5000) for anonymous and signed-in clients
+//	GRAPHQL_MAX_COMPLEXITY_API_KEY    (20000) for clients with a registered API key
+type Config struct {
+	MaxDepth            int
+	MaxComplexity       int
+	MaxComplexityAPIKey int
+}
+
+func ConfigFromEnv() (Config, error) {
+	config := Config{MaxDepth: 10, MaxComplexity: 5000, MaxComplexityAPIKey: 20000}
+	for env, target := range map[string]*int{
+		"GRAPHQL_MAX_DEPTH":              &config.MaxDepth,
+		"GRAPHQL_MAX_COMPLEXITY":         &config.MaxComplexity,
+		"GRAPHQL_MAX_COMPLEXITY_API_KEY": &config.MaxComplexityAPIKey,
+	} {
+		value := os.Getenv(env)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return Config{}, fmt.Errorf("%s must be a positive integer", env)
+		}
+		*target = n
+	}
+	return config, nil
+}
+
+// ComplexityLimit picks the limit of the client's tier. Operations over it
+// fail with COMPLEXITY_LIMIT_EXCEEDED and a message giving their cost.
+func (c Config) ComplexityLimit() *extension.ComplexityLimit {
+	return &extension.ComplexityLimit{
+		Func: func(ctx context.Context, _ *graphql.OperationContext) int {
+			if ratelimit.HasAPIKey(ctx) {
+				return c.MaxComplexityAPIKey
+			}
+			return c.MaxComplexity
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package querylimit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// DepthLimit rejects operations whose fields nest deeper than Max.
+// Introspection fields are not counted, since the standard introspection
+// query is deeper than any application query.
+type DepthLimit struct {
+	Max int
+}
+
+var (
+	_ graphql.HandlerExtension        = DepthLimit{}
+	_ graphql.OperationContextMutator = DepthLimit{}
+)
+
+func (DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (DepthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(_ context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+	if depth := selectionDepth(opCtx.Operation.SelectionSet); depth > d.Max {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.Max)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// selectionDepth returns the deepest field nesting of set. Fragments do not
+// add a level. Validation has already rejected fragment cycles.
+func selectionDepth(set ast.SelectionSet) int {
+	deepest := 0
+	for _, selection := range set {
+		var depth int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = 1 + selectionDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = selectionDepth(s.Definition.SelectionSet)
+			}
+		}
+		deepest = max(deepest, depth)
+	}
+	return deepest
+}
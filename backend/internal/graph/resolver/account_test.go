@@ -0,0 +1,91 @@
+package resolver_test
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+type authPayload struct {
+	AccessToken  string
+	RefreshToken string
+	User         struct {
+		Email string
+		Roles []string
+	}
+}
+
+const authFields = `accessToken refreshToken user { email roles }`
+
+func TestSignupAndLogin(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	signup := `mutation($input: SignupInput!) { signup(input: $input) { ` + authFields + ` } }`
+	input := map[string]any{"email": " Linus@Example.com ", "name": "Linus", "password": "correct horse"}
+
+	var resp struct{ Signup authPayload }
+	e.mustPost("", signup, &resp, client.Var("input", input))
+	if resp.Signup.User.Email != "linus@example.com" || len(resp.Signup.User.Roles) != 1 || resp.Signup.User.Roles[0] != "CUSTOMER" {
+		t.Fatalf("expected a normalized customer account, got %+v", resp.Signup.User)
+	}
+
+	var me struct{ Me struct{ Email string } }
+	e.mustPost(resp.Signup.AccessToken, `{ me { email } }`, &me)
+	if me.Me.Email != "linus@example.com" {
+		t.Errorf("expected the access token to sign in, got %+v", me)
+	}
+
+	e.expectError("", signup, apperror.CodeConflict, client.Var("input", input))
+	e.expectError("", signup, apperror.CodeValidation, client.Var("input",
+		map[string]any{"email": "short@example.com", "name": "Short", "password": "short"}))
+
+	login := `mutation($email: String!, $password: String!) { login(email: $email, password: $password) { ` + authFields + ` } }`
+	var loginResp struct{ Login authPayload }
+	e.mustPost("", login, &loginResp, client.Var("email", "linus@example.com"), client.Var("password", "correct horse"))
+	if loginResp.Login.AccessToken == "" || loginResp.Login.RefreshToken == "" {
+		t.Errorf("expected tokens, got %+v", loginResp.Login)
+	}
+
+	e.expectError("", login, apperror.CodeUnauthenticated,
+		client.Var("email", "linus@example.com"), client.Var("password", "wrong password"))
+	e.expectError("", login, apperror.CodeUnauthenticated,
+		client.Var("email", "nobody@example.com"), client.Var("password", "correct horse"))
+}
+
+func TestRefreshTokenAndLogout(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	var signup struct{ Signup authPayload }
+	e.mustPost("", `mutation($input: SignupInput!) { signup(input: $input) { `+authFields+` } }`, &signup,
+		client.Var("input", map[string]any{"email": "linus@example.com", "name": "Linus", "password": "correct horse"}))
+
+	refresh := `mutation($token: String!) { refreshToken(refreshToken: $token) { ` + authFields + ` } }`
+	var resp struct{ RefreshToken authPayload }
+	e.mustPost("", refresh, &resp, client.Var("token", signup.Signup.RefreshToken))
+	rotated := resp.RefreshToken.RefreshToken
+	if rotated == "" || rotated == signup.Signup.RefreshToken {
+		t.Fatalf("expected a new refresh token, got %q", rotated)
+	}
+
+	// Reusing a rotated token revokes every session of the user.
+	e.expectError("", refresh, apperror.CodeUnauthenticated, client.Var("token", signup.Signup.RefreshToken))
+	e.expectError("", refresh, apperror.CodeUnauthenticated, client.Var("token", rotated))
+
+	var login struct{ Login authPayload }
+	e.mustPost("", `mutation { login(email: "linus@example.com", password: "correct horse") { `+authFields+` } }`, &login)
+
+	logout := `mutation($token: String!) { logout(refreshToken: $token) }`
+	var logoutResp struct{ Logout bool }
+	e.mustPost("", logout, &logoutResp, client.Var("token", login.Login.RefreshToken))
+	if !logoutResp.Logout {
+		t.Error("expected logout to succeed")
+	}
+	// Logging out again is a no-op.
+	e.mustPost("", logout, &logoutResp, client.Var("token", login.Login.RefreshToken))
+
+	e.expectError("", refresh, apperror.CodeUnauthenticated, client.Var("token", login.Login.RefreshToken))
+}
@@ -0,0 +1,650 @@
+package resolver_test
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+func TestCreateBooking(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($input: CreateBookingInput!) {
+		createBooking(input: $input) {
+			booking { bookingReference bookingStatus totalPrice fare { availableSeats } }
+			token
+		}
+	}`
+	input := func(flightID, fareID string) client.Option {
+		return client.Var("input", map[string]any{
+			"flightId":       flightID,
+			"fareId":         fareID,
+			"passengerName":  "Ada Lovelace",
+			"passengerEmail": "ada@example.com",
+		})
+	}
+	var resp struct {
+		CreateBooking struct {
+			Booking struct {
+				BookingReference string
+				BookingStatus    string
+				TotalPrice       float64
+				Fare             struct{ AvailableSeats int }
+			}
+			Token *string
+		}
+	}
+
+	e.mustPost(e.customerToken, mutation, &resp, input(domesticFlightID, domesticProID))
+	booking := resp.CreateBooking.Booking
+	if resp.CreateBooking.Token != nil {
+		t.Errorf("expected no booking token for a signed-in customer")
+	}
+	if booking.BookingStatus != "CONFIRMED" || booking.TotalPrice != 499 || booking.Fare.AvailableSeats != 4 {
+		t.Fatalf("expected a confirmed PRO booking taking a seat, got %+v", booking)
+	}
+
+	var bookings struct {
+		Bookings []struct{ BookingReference string }
+	}
+	e.mustPost(e.customerToken, `{ bookings { bookingReference } }`, &bookings)
+	if len(bookings.Bookings) != 3 {
+		t.Errorf("expected the booking on the customer's account, got %+v", bookings.Bookings)
+	}
+
+	// Guests can book too, and get a token to manage the booking with.
+	resp.CreateBooking.Token = nil
+	e.mustPost("", mutation, &resp, input(domesticFlightID, domesticPromoID))
+	if resp.CreateBooking.Token == nil {
+		t.Fatal("expected a booking token for a guest")
+	}
+	var guest struct {
+		Booking struct{ BookingReference string }
+	}
+	e.mustPost(*resp.CreateBooking.Token, `query($ref: String!) { booking(bookingReference: $ref) { bookingReference } }`, &guest,
+		client.Var("ref", resp.CreateBooking.Booking.BookingReference))
+
+	e.expectError(e.customerToken, mutation, apperror.CodeNotFound, input(domesticFlightID, unknownID))
+	e.expectError(e.customerToken, mutation, apperror.CodeNotFound, input(unknownID, domesticBasicID))
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, input(domesticFlightID, laterBasicID))
+	e.expectError(e.customerToken, mutation, apperror.CodeSoldOut, input(laterFlightID, laterPromoID))
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, client.Var("input", map[string]any{
+		"flightId":        domesticFlightID,
+		"fareId":          domesticBasicID,
+		"passengerName":   "Ada Lovelace",
+		"passengerEmail":  "ada@example.com",
+		"specialRequests": strings.Repeat("x", 501),
+	}))
+
+	// Seats must exist on the aircraft and be free.
+	seat := func(seatNumber string) client.Option {
+		return client.Var("input", map[string]any{
+			"flightId":       domesticFlightID,
+			"fareId":         domesticBasicID,
+			"passengerName":  "Ada Lovelace",
+			"passengerEmail": "ada@example.com",
+			"seatNumber":     seatNumber,
+		})
+	}
+	var seated struct {
+		CreateBooking struct {
+			Booking struct {
+				SeatNumber  string
+				TotalPrice  float64
+				Ancillaries []struct {
+					Product    struct{ Code string }
+					TotalPrice float64
+				}
+			}
+		}
+	}
+	e.mustPost(e.customerToken, `mutation($input: CreateBookingInput!) {
+		createBooking(input: $input) {
+			booking { seatNumber totalPrice ancillaries { product { code } totalPrice } }
+		}
+	}`, &seated, seat(" 2c"))
+	picked := seated.CreateBooking.Booking
+	if picked.SeatNumber != "2C" {
+		t.Errorf("expected seat 2C, got %q", picked.SeatNumber)
+	}
+	// Picking the seat buys seat selection.
+	if len(picked.Ancillaries) != 1 || picked.Ancillaries[0].Product.Code != "SEAT_SELECTION" ||
+		picked.TotalPrice != 199+picked.Ancillaries[0].TotalPrice {
+		t.Errorf("expected seat selection added to the booking price, got %+v", picked)
+	}
+	e.expectError(e.customerToken, mutation, apperror.CodeConflict, seat("2C"))
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, seat("1G"))
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, seat("31A"))
+
+	// PROMO fares cannot buy seat selection, so they cannot pick a seat.
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, client.Var("input", map[string]any{
+		"flightId":       domesticFlightID,
+		"fareId":         domesticPromoID,
+		"passengerName":  "Ada Lovelace",
+		"passengerEmail": "ada@example.com",
+		"seatNumber":     "3A",
+	}))
+}
+
+func TestCancelBooking(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($ref: String!) {
+		cancelBooking(bookingReference: $ref) { bookingStatus fare { availableSeats } }
+	}`
+	var resp struct {
+		CancelBooking struct {
+			BookingStatus string
+			Fare          struct{ AvailableSeats int }
+		}
+	}
+
+	e.expectError("", mutation, apperror.CodeUnauthenticated, client.Var("ref", e.adaRef))
+	// Bookings of other passengers look like unknown references.
+	e.expectError(e.customerToken, mutation, apperror.CodeNotFound, client.Var("ref", e.graceRef))
+
+	e.mustPost(e.customerToken, mutation, &resp, client.Var("ref", e.adaRef))
+	if resp.CancelBooking.BookingStatus != "CANCELLED" || resp.CancelBooking.Fare.AvailableSeats != 10 {
+		t.Fatalf("expected the booking cancelled and its seat released, got %+v", resp.CancelBooking)
+	}
+
+	e.expectError(e.customerToken, mutation, apperror.CodeConflict, client.Var("ref", e.adaRef))
+}
+
+func TestManageBooking(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($ref: String!, $lastName: String!) {
+		manageBooking(bookingReference: $ref, lastName: $lastName) { token booking { passengerName } }
+	}`
+	var resp struct {
+		ManageBooking struct {
+			Token   string
+			Booking struct{ PassengerName string }
+		}
+	}
+
+	e.mustPost("", mutation, &resp, client.Var("ref", strings.ToLower(e.graceRef)), client.Var("lastName", "hopper"))
+	token := resp.ManageBooking.Token
+	if token == "" || resp.ManageBooking.Booking.PassengerName != "Grace Hopper" {
+		t.Fatalf("expected a token for Grace's booking, got %+v", resp.ManageBooking)
+	}
+
+	e.expectError("", mutation, apperror.CodeNotFound, client.Var("ref", e.graceRef), client.Var("lastName", "Lovelace"))
+	e.expectError("", mutation, apperror.CodeNotFound, client.Var("ref", "ZZZZZZ"), client.Var("lastName", "Hopper"))
+
+	// The booking token only gives access to its own booking.
+	var booking struct {
+		Booking *struct{ PassengerName string }
+	}
+	query := `query($ref: String!) { booking(bookingReference: $ref) { passengerName } }`
+	e.mustPost(token, query, &booking, client.Var("ref", e.graceRef))
+	if booking.Booking == nil {
+		t.Fatal("expected the booking token to read its booking")
+	}
+	e.mustPost(token, query, &booking, client.Var("ref", e.adaRef))
+	if booking.Booking != nil {
+		t.Errorf("expected the booking token not to read other bookings, got %+v", booking.Booking)
+	}
+
+	cancel := `mutation($ref: String!) { cancelBooking(bookingReference: $ref) { bookingStatus } }`
+	e.expectError(token, cancel, apperror.CodeNotFound, client.Var("ref", e.adaRef))
+	e.mustPost(token, cancel, nil, client.Var("ref", e.graceRef))
+}
+
+func TestCancelBookingOnBehalf(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($ref: String!) { cancelBookingOnBehalf(bookingReference: $ref) { bookingStatus } }`
+	var resp struct {
+		CancelBookingOnBehalf struct{ BookingStatus string }
+	}
+
+	e.expectError(e.customerToken, mutation, apperror.CodeForbidden, client.Var("ref", e.graceRef))
+	e.expectError(e.agentToken, mutation, apperror.CodeNotFound, client.Var("ref", "ZZZZZZ"))
+
+	e.mustPost(e.agentToken, mutation, &resp, client.Var("ref", e.graceRef))
+	if resp.CancelBookingOnBehalf.BookingStatus != "CANCELLED" {
+		t.Errorf("expected the agent to cancel any booking, got %+v", resp.CancelBookingOnBehalf)
+	}
+}
+
+func TestWaitlist(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	join := `mutation($fareId: ID!, $passenger: PassengerInput!) {
+		joinWaitlist(fareId: $fareId, passenger: $passenger) { id status position fare { waitlistCount } }
+	}`
+	passenger := client.Var("passenger", map[string]any{"name": "Linus Torvalds", "email": "linus@example.com"})
+	var joined struct {
+		JoinWaitlist struct {
+			ID       string
+			Status   string
+			Position *int
+			Fare     struct{ WaitlistCount int }
+		}
+	}
+
+	e.expectError("", join, apperror.CodeValidation, client.Var("fareId", domesticBasicID), passenger)
+	e.expectError("", join, apperror.CodeNotFound, client.Var("fareId", unknownID), passenger)
+
+	e.mustPost("", join, &joined, client.Var("fareId", laterPromoID), passenger)
+	entry := joined.JoinWaitlist
+	if entry.Status != "WAITING" || entry.Position == nil || *entry.Position != 1 || entry.Fare.WaitlistCount != 1 {
+		t.Fatalf("expected first in the queue, got %+v", entry)
+	}
+	e.expectError("", join, apperror.CodeConflict, client.Var("fareId", laterPromoID), passenger)
+
+	accept := `mutation($id: ID!, $email: String!) {
+		acceptWaitlistOffer(entryId: $id, passengerEmail: $email) { booking { passengerName bookingStatus fareId } token }
+	}`
+	e.expectError("", accept, apperror.CodeConflict, client.Var("id", entry.ID), client.Var("email", "linus@example.com"))
+
+	// Cancelling the last PROMO seat offers it to the waitlist.
+	e.mustPost(e.customerToken, `mutation($ref: String!) { cancelBooking(bookingReference: $ref) { bookingStatus } }`,
+		nil, client.Var("ref", e.alanRef))
+
+	e.expectError("", accept, apperror.CodeNotFound, client.Var("id", entry.ID), client.Var("email", "ada@example.com"))
+
+	var accepted struct {
+		AcceptWaitlistOffer struct {
+			Booking struct {
+				PassengerName string
+				BookingStatus string
+				FareID        string `json:"fareId"`
+			}
+			Token *string
+		}
+	}
+	e.mustPost("", accept, &accepted, client.Var("id", entry.ID), client.Var("email", "LINUS@example.com"))
+	if b := accepted.AcceptWaitlistOffer.Booking; b.BookingStatus != "CONFIRMED" || b.FareID != laterPromoID {
+		t.Fatalf("expected a PROMO booking for Linus, got %+v", b)
+	}
+	if accepted.AcceptWaitlistOffer.Token == nil {
+		t.Error("expected a booking token for the guest")
+	}
+	e.expectError("", accept, apperror.CodeConflict, client.Var("id", entry.ID), client.Var("email", "linus@example.com"))
+}
+
+func TestWaitlistSignedIn(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	var joined struct {
+		JoinWaitlist struct{ ID string }
+	}
+	e.mustPost(e.customerToken, `mutation($passenger: PassengerInput!) {
+		joinWaitlist(fareId: "`+laterPromoID+`", passenger: $passenger) { id }
+	}`, &joined, client.Var("passenger", map[string]any{"name": "Linus Torvalds", "email": "linus@example.com"}))
+
+	e.mustPost(e.customerToken, `mutation($ref: String!) { cancelBooking(bookingReference: $ref) { bookingStatus } }`,
+		nil, client.Var("ref", e.alanRef))
+
+	// The offer is accepted from the link in the email, without signing in.
+	var accepted struct {
+		AcceptWaitlistOffer struct {
+			Booking struct{ BookingReference string }
+			Token   *string
+		}
+	}
+	e.mustPost("", `mutation($id: ID!) {
+		acceptWaitlistOffer(entryId: $id, passengerEmail: "linus@example.com") { booking { bookingReference } token }
+	}`, &accepted, client.Var("id", joined.JoinWaitlist.ID))
+	if accepted.AcceptWaitlistOffer.Token != nil {
+		t.Error("expected no booking token for a booking of an account")
+	}
+
+	var me struct {
+		Me struct {
+			Bookings []struct{ BookingReference string }
+		}
+	}
+	e.mustPost(e.customerToken, `{ me { bookings { bookingReference } } }`, &me)
+	ref := accepted.AcceptWaitlistOffer.Booking.BookingReference
+	if !slices.ContainsFunc(me.Me.Bookings, func(b struct{ BookingReference string }) bool { return b.BookingReference == ref }) {
+		t.Errorf("expected booking %s among the bookings of the account that joined, got %+v", ref, me.Me.Bookings)
+	}
+}
+
+func TestWaitlistCancelledFlight(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	join := `mutation($passenger: PassengerInput!) { joinWaitlist(fareId: "` + laterPromoID + `", passenger: $passenger) { id } }`
+	var offered, waiting struct {
+		JoinWaitlist struct{ ID string }
+	}
+	e.mustPost("", join, &offered, client.Var("passenger", map[string]any{"name": "Linus Torvalds", "email": "linus@example.com"}))
+	e.mustPost("", join, &waiting, client.Var("passenger", map[string]any{"name": "Grace Hopper", "email": "grace@example.com"}))
+
+	// Linus is offered the released seat, then the flight is cancelled.
+	e.mustPost(e.customerToken, `mutation($ref: String!) { cancelBooking(bookingReference: $ref) { bookingStatus } }`,
+		nil, client.Var("ref", e.alanRef))
+	e.mustPost(e.opsToken, `mutation($id: ID!) {
+		updateFlightStatus(input: { flightId: $id, status: CANCELLED }) { status }
+	}`, nil, client.Var("id", laterFlightID))
+
+	accept := `mutation($id: ID!, $email: String!) { acceptWaitlistOffer(entryId: $id, passengerEmail: $email) { booking { id } } }`
+	e.expectError("", accept, apperror.CodeConflict, client.Var("id", offered.JoinWaitlist.ID), client.Var("email", "linus@example.com"))
+
+	var flight struct {
+		Flight struct {
+			Fares []struct {
+				ID            string
+				WaitlistCount int
+			}
+		}
+	}
+	e.mustPost("", `query($id: ID!) { flight(id: $id) { fares { id waitlistCount } } }`, &flight, client.Var("id", laterFlightID))
+	for _, fare := range flight.Flight.Fares {
+		if fare.ID == laterPromoID && fare.WaitlistCount != 0 {
+			t.Errorf("expected the waitlist of the cancelled flight expired, got %d waiting", fare.WaitlistCount)
+		}
+	}
+}
+
+func TestAddAncillary(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	products := e.productIDs()
+	mutation := `mutation($ref: String!, $productId: ID!, $quantity: Int!) {
+		addAncillary(bookingReference: $ref, productId: $productId, quantity: $quantity) {
+			totalPrice
+			ancillaries { product { code } quantity unitPrice totalPrice }
+		}
+	}`
+	add := func(ref, code string, quantity int) []client.Option {
+		return []client.Option{client.Var("ref", ref), client.Var("productId", products[code]), client.Var("quantity", quantity)}
+	}
+	var resp struct {
+		AddAncillary struct {
+			TotalPrice  float64
+			Ancillaries []struct {
+				Product    struct{ Code string }
+				Quantity   int
+				UnitPrice  float64
+				TotalPrice float64
+			}
+		}
+	}
+
+	e.mustPost(e.customerToken, mutation, &resp, add(e.adaRef, "EXTRA_BAG", 2)...)
+	booking := resp.AddAncillary
+	if len(booking.Ancillaries) != 1 || booking.Ancillaries[0].Quantity != 2 {
+		t.Fatalf("expected two extra bags, got %+v", booking.Ancillaries)
+	}
+	if bags := booking.Ancillaries[0].TotalPrice; bags != 2*booking.Ancillaries[0].UnitPrice || booking.TotalPrice != 199+bags {
+		t.Errorf("expected the bags added to the booking price, got %+v", booking)
+	}
+
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, add(e.adaRef, "EXTRA_BAG", 2)...)
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, add(e.adaRef, "HOT_MEAL", 0)...)
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, add(e.alanRef, "SEAT_SELECTION", 1)...)
+	e.expectError(e.customerToken, mutation, apperror.CodeNotFound,
+		client.Var("ref", e.adaRef), client.Var("productId", unknownID), client.Var("quantity", 1))
+	e.expectError(e.customerToken, mutation, apperror.CodeNotFound, add(e.graceRef, "HOT_MEAL", 1)...)
+	e.expectError("", mutation, apperror.CodeUnauthenticated, add(e.adaRef, "HOT_MEAL", 1)...)
+
+	e.mustPost(e.customerToken, mutation, nil, add(e.adaRef, "LOUNGE_PASS", 2)...)
+	var offers struct {
+		AncillaryOffers []struct {
+			Product   struct{ Code string }
+			Remaining *int
+		}
+	}
+	e.mustPost("", `query($id: ID!) { ancillaryOffers(flightId: $id) { product { code } remaining } }`,
+		&offers, client.Var("id", domesticFlightID))
+	for _, offer := range offers.AncillaryOffers {
+		if offer.Product.Code == "LOUNGE_PASS" && (offer.Remaining == nil || *offer.Remaining != 18) {
+			t.Errorf("expected 18 lounge passes left, got %v", offer.Remaining)
+		}
+	}
+}
+
+func TestSelectSeat(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($ref: String!, $seat: String!) {
+		selectSeat(bookingReference: $ref, seatNumber: $seat) { seatNumber }
+	}`
+	selectSeat := func(ref, seat string) []client.Option {
+		return []client.Option{client.Var("ref", ref), client.Var("seat", seat)}
+	}
+	var resp struct {
+		SelectSeat struct{ SeatNumber string }
+	}
+
+	e.expectError("", mutation, apperror.CodeUnauthenticated, selectSeat(e.adaRef, "4B")...)
+	e.expectError(e.customerToken, mutation, apperror.CodeNotFound, selectSeat(e.graceRef, "4B")...)
+	// Only bookings that bought seat selection choose their seat.
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, selectSeat(e.adaRef, "4B")...)
+
+	e.mustPost(e.customerToken, `mutation($ref: String!, $productId: ID!) {
+		addAncillary(bookingReference: $ref, productId: $productId, quantity: 1) { id }
+	}`, nil, client.Var("ref", e.adaRef), client.Var("productId", e.productIDs()["SEAT_SELECTION"]))
+
+	e.mustPost(e.customerToken, mutation, &resp, selectSeat(e.adaRef, " 4b")...)
+	if resp.SelectSeat.SeatNumber != "4B" {
+		t.Fatalf("expected seat 4B, got %q", resp.SelectSeat.SeatNumber)
+	}
+	e.mustPost(e.customerToken, mutation, &resp, selectSeat(e.adaRef, "4B")...)
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, selectSeat(e.adaRef, "1G")...)
+
+	e.mustPost(e.customerToken, `mutation($input: CreateBookingInput!) { createBooking(input: $input) { booking { id } } }`, nil,
+		client.Var("input", map[string]any{
+			"flightId":       domesticFlightID,
+			"fareId":         domesticProID,
+			"passengerName":  "Grace Hopper",
+			"passengerEmail": "grace@example.com",
+			"seatNumber":     "5A",
+		}))
+	e.expectError(e.customerToken, mutation, apperror.CodeConflict, selectSeat(e.adaRef, "5A")...)
+
+	// The seat is fixed once checked in.
+	var checkIn struct {
+		CheckIn struct{ SeatNumber string }
+	}
+	e.mustPost(e.customerToken, `mutation($ref: String!) { checkIn(bookingReference: $ref) { seatNumber } }`,
+		&checkIn, client.Var("ref", e.adaRef))
+	if checkIn.CheckIn.SeatNumber != "4B" {
+		t.Errorf("expected the selected seat on the boarding pass, got %q", checkIn.CheckIn.SeatNumber)
+	}
+	e.expectError(e.customerToken, mutation, apperror.CodeConflict, selectSeat(e.adaRef, "6C")...)
+}
+
+// productIDs maps the codes of the ancillary catalog to product IDs.
+func (e *testEnv) productIDs() map[string]string {
+	e.t.Helper()
+	var resp struct {
+		AncillaryOffers []struct {
+			Product struct{ ID, Code string }
+		}
+	}
+	e.mustPost("", `query($id: ID!) { ancillaryOffers(flightId: $id) { product { id code } } }`,
+		&resp, client.Var("id", domesticFlightID))
+
+	ids := map[string]string{}
+	for _, offer := range resp.AncillaryOffers {
+		ids[offer.Product.Code] = offer.Product.ID
+	}
+	return ids
+}
+
+func TestCheckIn(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($ref: String!, $document: PassengerDocumentInput) {
+		checkIn(bookingReference: $ref, document: $document) {
+			seatNumber sequenceNumber barcode downloadUrl
+			booking { bookingStatus }
+		}
+	}`
+	type boardingPass struct {
+		SeatNumber     string
+		SequenceNumber int
+		Barcode        string
+		DownloadURL    string `json:"downloadUrl"`
+		Booking        struct{ BookingStatus string }
+	}
+	var resp struct{ CheckIn boardingPass }
+
+	e.expectError("", mutation, apperror.CodeUnauthenticated, client.Var("ref", e.adaRef))
+
+	e.mustPost(e.customerToken, mutation, &resp, client.Var("ref", e.adaRef))
+	pass := resp.CheckIn
+	if pass.Booking.BookingStatus != "CHECKED_IN" || pass.SeatNumber == "" || pass.SequenceNumber != 1 {
+		t.Fatalf("expected a boarding pass, got %+v", pass)
+	}
+	if !strings.Contains(pass.Barcode, "JFKLAX") || pass.DownloadURL == "" {
+		t.Errorf("expected a barcode and download URL, got %+v", pass)
+	}
+
+	// The token of the link only downloads the pass, so a leaked link cannot
+	// cancel the booking.
+	link, err := url.Parse(pass.DownloadURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.client.RawPost(`mutation($ref: String!) { cancelBooking(bookingReference: $ref) { id } }`,
+		client.Var("ref", e.adaRef), client.AddHeader("Authorization", "Bearer "+link.Query().Get("token")))
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected the boarding pass token to be rejected with 401, got %v", err)
+	}
+
+	// Checking in again returns the same boarding pass.
+	e.mustPost(e.customerToken, mutation, &resp, client.Var("ref", e.adaRef))
+	if resp.CheckIn.SeatNumber != pass.SeatNumber || resp.CheckIn.SequenceNumber != pass.SequenceNumber {
+		t.Errorf("expected the same boarding pass, got %+v", resp.CheckIn)
+	}
+
+	// RA102 leaves in 30 hours, before the window opens.
+	e.expectError(e.customerToken, mutation, apperror.CodeValidation, client.Var("ref", e.alanRef))
+}
+
+func TestCheckInInternational(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+	token := e.manageBooking(e.graceRef, "Hopper")
+
+	passport := map[string]any{
+		"documentType":   "PASSPORT",
+		"documentNumber": "X12345678",
+		"nationality":    "USA",
+		"issuingCountry": "USA",
+		"dateOfBirth":    "1906-12-09",
+		"expiryDate":     "2099-01-01",
+		"sex":            "F",
+	}
+
+	checkIn := `mutation($ref: String!, $document: PassengerDocumentInput) {
+		checkIn(bookingReference: $ref, document: $document) { booking { bookingStatus } }
+	}`
+	e.expectError(token, checkIn, apperror.CodeValidation, client.Var("ref", e.graceRef))
+
+	invalid := map[string]any{}
+	for k, v := range passport {
+		invalid[k] = v
+	}
+	invalid["nationality"] = "US"
+	add := `mutation($ref: String!, $document: PassengerDocumentInput!) {
+		addPassengerDocument(bookingReference: $ref, document: $document) {
+			document { documentType maskedNumber nationality expiryDate }
+		}
+	}`
+	e.expectError(token, add, apperror.CodeValidation, client.Var("ref", e.graceRef), client.Var("document", invalid))
+	e.expectError(e.customerToken, add, apperror.CodeNotFound, client.Var("ref", e.graceRef), client.Var("document", passport))
+
+	var resp struct {
+		AddPassengerDocument struct {
+			Document *struct {
+				DocumentType string
+				MaskedNumber string
+				Nationality  string
+				ExpiryDate   string
+			}
+		}
+	}
+	e.mustPost(token, add, &resp, client.Var("ref", e.graceRef), client.Var("document", passport))
+	doc := resp.AddPassengerDocument.Document
+	if doc == nil || doc.DocumentType != "PASSPORT" || !strings.HasSuffix(doc.MaskedNumber, "678") || strings.Contains(doc.MaskedNumber, "12345") {
+		t.Fatalf("expected a masked passport, got %+v", doc)
+	}
+	if doc.Nationality != "USA" || doc.ExpiryDate != "2099-01-01" {
+		t.Errorf("expected the passport details, got %+v", doc)
+	}
+
+	var pass struct {
+		CheckIn struct {
+			Booking struct{ BookingStatus string }
+		}
+	}
+	e.mustPost(token, checkIn, &pass, client.Var("ref", e.graceRef))
+	if pass.CheckIn.Booking.BookingStatus != "CHECKED_IN" {
+		t.Errorf("expected the passenger checked in, got %+v", pass.CheckIn)
+	}
+}
+
+// manageBooking returns a booking token for reference.
+func (e *testEnv) manageBooking(reference, lastName string) string {
+	e.t.Helper()
+	var resp struct {
+		ManageBooking struct{ Token string }
+	}
+	e.mustPost("", `mutation($ref: String!, $lastName: String!) {
+		manageBooking(bookingReference: $ref, lastName: $lastName) { token }
+	}`, &resp, client.Var("ref", reference), client.Var("lastName", lastName))
+	return resp.ManageBooking.Token
+}
+
+func TestVolunteerForDeniedBoarding(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($ref: String!, $volunteer: Boolean!) {
+		volunteerForDeniedBoarding(bookingReference: $ref, volunteer: $volunteer) { deniedBoardingVolunteer }
+	}`
+	var resp struct {
+		VolunteerForDeniedBoarding struct{ DeniedBoardingVolunteer bool }
+	}
+
+	e.expectError("", mutation, apperror.CodeUnauthenticated, client.Var("ref", e.adaRef), client.Var("volunteer", true))
+	e.expectError(e.customerToken, mutation, apperror.CodeNotFound, client.Var("ref", e.graceRef), client.Var("volunteer", true))
+
+	e.mustPost(e.customerToken, mutation, &resp, client.Var("ref", e.adaRef), client.Var("volunteer", true))
+	if !resp.VolunteerForDeniedBoarding.DeniedBoardingVolunteer {
+		t.Fatal("expected the passenger to volunteer")
+	}
+
+	var list struct {
+		DeniedBoardingList struct {
+			OversoldSeats int
+			Volunteers    []struct{ BookingReference string }
+			Candidates    []struct{ BookingReference string }
+		}
+	}
+	e.mustPost(e.agentToken, `query($id: ID!) {
+		deniedBoardingList(flightId: $id) {
+			oversoldSeats volunteers { bookingReference } candidates { bookingReference }
+		}
+	}`, &list, client.Var("id", domesticFlightID))
+	if l := list.DeniedBoardingList; len(l.Volunteers) != 1 || l.Volunteers[0].BookingReference != e.adaRef || len(l.Candidates) != 0 {
+		t.Errorf("expected Ada among the volunteers, got %+v", l)
+	}
+
+	e.mustPost(e.customerToken, `mutation($ref: String!) { cancelBooking(bookingReference: $ref) { bookingStatus } }`,
+		nil, client.Var("ref", e.adaRef))
+	e.expectError(e.customerToken, mutation, apperror.CodeConflict, client.Var("ref", e.adaRef), client.Var("volunteer", false))
+}
@@ -0,0 +1,186 @@
+package resolver_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// Fixture IDs. Departures are relative to the start of the test so the
+// check-in window is open on the flights leaving in six hours.
+const (
+	// RA100 JFK-LAX, leaves in 6 hours.
+	domesticFlightID = "10000000-0000-0000-0000-000000000100"
+	domesticPromoID  = "20000000-0000-0000-0000-000000000101"
+	domesticBasicID  = "20000000-0000-0000-0000-000000000102"
+	domesticProID    = "20000000-0000-0000-0000-000000000103"
+
+	// RA102 JFK-LAX, leaves in 30 hours with its PROMO fare sold out. It is
+	// where RA100 passengers are rebooked.
+	laterFlightID = "10000000-0000-0000-0000-000000000102"
+	laterPromoID  = "20000000-0000-0000-0000-000000000201"
+	laterBasicID  = "20000000-0000-0000-0000-000000000202"
+
+	// RA300 JFK-LHR, leaves in 6 hours; checking in needs a passport.
+	internationalFlightID = "10000000-0000-0000-0000-000000000300"
+	internationalBasicID  = "20000000-0000-0000-0000-000000000301"
+
+	customerID = "30000000-0000-0000-0000-000000000001"
+	agentID    = "30000000-0000-0000-0000-000000000002"
+	opsID      = "30000000-0000-0000-0000-000000000003"
+
+	// unknownID is a well-formed ID that matches no record.
+	unknownID = "99999999-9999-9999-9999-999999999999"
+)
+
+// fixtures holds what the seed generates: booking references and the access
+// tokens of the fixture users.
+type fixtures struct {
+	now time.Time
+
+	// adaRef is the customer's booking on the RA100 BASIC fare.
+	adaRef string
+	// alanRef is the customer's booking of the last RA102 PROMO seat.
+	alanRef string
+	// graceRef is a guest booking on RA300.
+	graceRef string
+
+	customerToken string
+	agentToken    string
+	opsToken      string
+}
+
+func (e *testEnv) seed() {
+	e.t.Helper()
+	ctx := context.Background()
+	e.now = time.Now().UTC().Truncate(time.Minute)
+
+	err := e.store.InTx(ctx, func(tx repository.Store) error {
+		flights := []*model.Flight{
+			e.flight(domesticFlightID, "RA100", "JFK", "LAX", 6*time.Hour),
+			e.flight(laterFlightID, "RA102", "JFK", "LAX", 30*time.Hour),
+			e.flight(internationalFlightID, "RA300", "JFK", "LHR", 6*time.Hour),
+		}
+		for _, flight := range flights {
+			if err := tx.Flights().Create(ctx, flight); err != nil {
+				return err
+			}
+		}
+
+		fares := []*model.Fare{
+			fare(domesticPromoID, domesticFlightID, model.FareClassPromo, 99, 2),
+			fare(domesticBasicID, domesticFlightID, model.FareClassBasic, 199, 10),
+			fare(domesticProID, domesticFlightID, model.FareClassPro, 499, 5),
+			fare(laterPromoID, laterFlightID, model.FareClassPromo, 89, 1),
+			fare(laterBasicID, laterFlightID, model.FareClassBasic, 189, 10),
+			fare(internationalBasicID, internationalFlightID, model.FareClassBasic, 649, 10),
+		}
+		for _, fare := range fares {
+			if err := tx.Fares().Create(ctx, fare); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		e.t.Fatalf("seed flights: %v", err)
+	}
+
+	users := []*model.User{
+		{ID: customerID, Email: "ada@example.com", Name: "Ada Lovelace", RoleNames: pq.StringArray{string(model.RoleCustomer)}},
+		{ID: agentID, Email: "agent@example.com", Name: "Gate Agent", RoleNames: pq.StringArray{string(model.RoleCustomer), string(model.RoleAgent)}},
+		{ID: opsID, Email: "ops@example.com", Name: "Ops Controller", RoleNames: pq.StringArray{string(model.RoleCustomer), string(model.RoleOps)}},
+	}
+	for _, user := range users {
+		// The fixture users sign in with tokens from the issuer, so the
+		// password hash is never checked.
+		user.PasswordHash = "unused"
+		user.CreatedAt, user.UpdatedAt = e.now, e.now
+		if err := e.store.Users().Create(ctx, user); err != nil {
+			e.t.Fatalf("seed users: %v", err)
+		}
+	}
+	e.customerToken = e.accessToken(users[0])
+	e.agentToken = e.accessToken(users[1])
+	e.opsToken = e.accessToken(users[2])
+
+	customer := customerID
+	e.adaRef = e.book(domesticFlightID, domesticBasicID, "Ada Lovelace", "ada@example.com", &customer)
+	e.alanRef = e.book(laterFlightID, laterPromoID, "Alan Turing", "ada@example.com", &customer)
+	e.graceRef = e.book(internationalFlightID, internationalBasicID, "Grace Hopper", "grace@example.com", nil)
+}
+
+func (e *testEnv) flight(id, number, origin, destination string, departsIn time.Duration) *model.Flight {
+	departure := e.now.Add(departsIn)
+	return &model.Flight{
+		ID:             id,
+		FlightNumber:   number,
+		Origin:         origin,
+		Destination:    destination,
+		DepartureTime:  departure,
+		ArrivalTime:    departure.Add(5 * time.Hour),
+		AircraftType:   "A320",
+		TotalSeats:     30,
+		AvailableSeats: 30,
+		Status:         model.FlightStatusScheduled,
+	}
+}
+
+func fare(id, flightID string, class model.FareClass, price float64, seats int) *model.Fare {
+	return &model.Fare{
+		ID:               id,
+		FlightID:         flightID,
+		FareClass:        class,
+		Price:            price,
+		BaggageAllowance: 1,
+		IsRefundable:     class == model.FareClassPro,
+		IsChangeable:     class != model.FareClassPromo,
+		AvailableSeats:   seats,
+	}
+}
+
+// book creates a confirmed booking the way createBooking does, taking the
+// seat from the fare.
+func (e *testEnv) book(flightID, fareID, name, email string, userID *string) string {
+	e.t.Helper()
+	ctx := context.Background()
+
+	b := &model.Booking{
+		FlightID:       flightID,
+		FareID:         fareID,
+		PassengerName:  name,
+		PassengerEmail: email,
+		BookingStatus:  model.BookingStatusConfirmed,
+		BookedAt:       e.now,
+		UserID:         userID,
+	}
+	err := e.store.InTx(ctx, func(tx repository.Store) error {
+		fare, err := tx.Fares().GetForUpdate(ctx, fareID)
+		if err != nil {
+			return err
+		}
+		b.TotalPrice = fare.Price
+		if err := tx.Bookings().Create(ctx, b); err != nil {
+			return err
+		}
+		return tx.Fares().TakeSeat(ctx, fareID)
+	})
+	if err != nil {
+		e.t.Fatalf("seed booking: %v", err)
+	}
+	return b.BookingReference
+}
+
+func (e *testEnv) accessToken(user *model.User) string {
+	e.t.Helper()
+	token, _, err := e.issuer.AccessToken(user)
+	if err != nil {
+		e.t.Fatalf("sign access token: %v", err)
+	}
+	return token
+}
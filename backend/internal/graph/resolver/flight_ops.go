@@ -0,0 +1,33 @@
+package resolver
+
+import (
+	"context"
+	"strings"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/auth"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+const maxGateLength = 10
+
+// opsActor returns who is recorded on the flight events of an operations
+// mutation: the signed-in user that @hasRole let through.
+func opsActor(ctx context.Context) (string, error) {
+	claims, err := auth.RequireRole(ctx, model.RoleOps)
+	if err != nil {
+		return "", err
+	}
+	return claims.Email, nil
+}
+
+func normalizeGate(gate string) (string, error) {
+	gate = strings.ToUpper(strings.TrimSpace(gate))
+	if gate == "" {
+		return "", apperror.Validation("gate is required")
+	}
+	if len(gate) > maxGateLength {
+		return "", apperror.Validation("gate must be at most %d characters", maxGateLength)
+	}
+	return gate, nil
+}
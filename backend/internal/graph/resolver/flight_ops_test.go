@@ -0,0 +1,316 @@
+package resolver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+type flightEvent struct {
+	EventType    string
+	NewStatus    *string
+	Gate         *string
+	DelayMinutes *int
+	Actor        string
+}
+
+const eventFields = `statusHistory { eventType newStatus gate delayMinutes actor }`
+
+func TestUpdateFlightStatus(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($input: UpdateFlightStatusInput!) {
+		updateFlightStatus(input: $input) { status ` + eventFields + ` }
+	}`
+	input := func(flightID, status string) client.Option {
+		return client.Var("input", map[string]any{"flightId": flightID, "status": status, "reason": "crew ready"})
+	}
+	var resp struct {
+		UpdateFlightStatus struct {
+			Status        string
+			StatusHistory []flightEvent
+		}
+	}
+
+	e.expectError("", mutation, apperror.CodeUnauthenticated, input(domesticFlightID, "BOARDING"))
+	e.expectError(e.customerToken, mutation, apperror.CodeForbidden, input(domesticFlightID, "BOARDING"))
+	e.expectError(e.agentToken, mutation, apperror.CodeForbidden, input(domesticFlightID, "BOARDING"))
+
+	e.mustPost(e.opsToken, mutation, &resp, input(domesticFlightID, "BOARDING"))
+	flight := resp.UpdateFlightStatus
+	if flight.Status != "BOARDING" || len(flight.StatusHistory) != 1 {
+		t.Fatalf("expected the flight boarding with one event, got %+v", flight)
+	}
+	if event := flight.StatusHistory[0]; event.EventType != "STATUS_CHANGED" || *event.NewStatus != "BOARDING" || event.Actor != "ops@example.com" {
+		t.Errorf("expected a STATUS_CHANGED event by the ops user, got %+v", event)
+	}
+
+	e.expectError(e.opsToken, mutation, apperror.CodeConflict, input(domesticFlightID, "ARRIVED"))
+	e.expectError(e.opsToken, mutation, apperror.CodeNotFound, input(unknownID, "BOARDING"))
+}
+
+func TestCancelFlightRebooksPassengers(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	e.mustPost(e.customerToken, `mutation($ref: String!) { checkIn(bookingReference: $ref) { seatNumber } }`,
+		nil, client.Var("ref", e.adaRef))
+	e.mustPost(e.customerToken, `mutation($ref: String!, $productId: ID!) {
+		addAncillary(bookingReference: $ref, productId: $productId, quantity: 2) { id }
+	}`, nil, client.Var("ref", e.adaRef), client.Var("productId", e.productIDs()["LOUNGE_PASS"]))
+	e.mustPost(e.opsToken, `mutation($id: ID!) {
+		updateFlightStatus(input: { flightId: $id, status: CANCELLED }) { status }
+	}`, nil, client.Var("id", domesticFlightID))
+
+	var report struct {
+		DisruptionReport struct {
+			TotalAffected       int
+			RebookedCount       int
+			UnaccommodatedCount int
+			Rebookings          []struct {
+				Outcome        string
+				Booking        struct{ BookingReference string }
+				OriginalFlight struct{ FlightNumber string }
+				NewFlight      *struct{ FlightNumber string }
+				NewFare        *struct{ FareClass string }
+			}
+		}
+	}
+	e.mustPost(e.agentToken, `query($id: ID!) {
+		disruptionReport(flightId: $id) {
+			totalAffected rebookedCount unaccommodatedCount
+			rebookings {
+				outcome
+				booking { bookingReference }
+				originalFlight { flightNumber }
+				newFlight { flightNumber }
+				newFare { fareClass }
+			}
+		}
+	}`, &report, client.Var("id", domesticFlightID))
+
+	r := report.DisruptionReport
+	if r.TotalAffected != 1 || r.RebookedCount != 1 || r.UnaccommodatedCount != 0 || len(r.Rebookings) != 1 {
+		t.Fatalf("expected Ada rebooked, got %+v", r)
+	}
+	rebooking := r.Rebookings[0]
+	if rebooking.Booking.BookingReference != e.adaRef || rebooking.OriginalFlight.FlightNumber != "RA100" {
+		t.Errorf("expected Ada's RA100 booking, got %+v", rebooking)
+	}
+	if rebooking.NewFlight == nil || rebooking.NewFlight.FlightNumber != "RA102" || rebooking.NewFare.FareClass != "BASIC" {
+		t.Errorf("expected a seat on the RA102 BASIC fare, got %+v", rebooking)
+	}
+
+	// Ada has to check in again on the new flight.
+	var booking struct {
+		Booking struct {
+			BookingStatus string
+			SeatNumber    *string
+			CheckedInAt   *time.Time
+			Flight        struct{ FlightNumber string }
+		}
+	}
+	e.mustPost(e.customerToken, `query($ref: String!) {
+		booking(bookingReference: $ref) { bookingStatus seatNumber checkedInAt flight { flightNumber } }
+	}`, &booking, client.Var("ref", e.adaRef))
+	if booking.Booking.BookingStatus != "CONFIRMED" || booking.Booking.Flight.FlightNumber != "RA102" {
+		t.Errorf("expected the booking moved to RA102, got %+v", booking.Booking)
+	}
+	if booking.Booking.SeatNumber != nil || booking.Booking.CheckedInAt != nil {
+		t.Errorf("expected the check-in cleared, got %+v", booking.Booking)
+	}
+
+	// The lounge passes follow the booking to RA102.
+	if left := e.loungePassesLeft(domesticFlightID); left != 20 {
+		t.Errorf("expected the lounge passes of RA100 released, got %d left", left)
+	}
+	if left := e.loungePassesLeft(laterFlightID); left != 18 {
+		t.Errorf("expected two lounge passes taken on RA102, got %d left", left)
+	}
+
+	e.expectError(e.customerToken, `mutation($input: CreateBookingInput!) { createBooking(input: $input) { booking { id } } }`,
+		apperror.CodeConflict, client.Var("input", map[string]any{
+			"flightId":       domesticFlightID,
+			"fareId":         domesticBasicID,
+			"passengerName":  "Ada Lovelace",
+			"passengerEmail": "ada@example.com",
+		}))
+}
+
+// loungePassesLeft returns the lounge passes still for sale on flightID.
+func (e *testEnv) loungePassesLeft(flightID string) int {
+	e.t.Helper()
+	var offers struct {
+		AncillaryOffers []struct {
+			Product   struct{ Code string }
+			Remaining *int
+		}
+	}
+	e.mustPost("", `query($id: ID!) { ancillaryOffers(flightId: $id) { product { code } remaining } }`,
+		&offers, client.Var("id", flightID))
+	for _, offer := range offers.AncillaryOffers {
+		if offer.Product.Code == "LOUNGE_PASS" && offer.Remaining != nil {
+			return *offer.Remaining
+		}
+	}
+	e.t.Fatal("no lounge pass offer")
+	return 0
+}
+
+func TestDelayFlight(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($input: DelayFlightInput!) {
+		delayFlight(input: $input) { delayMinutes ` + eventFields + ` }
+	}`
+	departure := e.now.Add(6 * time.Hour)
+	input := func(flightID string, newDeparture time.Time) client.Option {
+		return client.Var("input", map[string]any{
+			"flightId":     flightID,
+			"newDeparture": newDeparture.Format(time.RFC3339),
+			"reason":       "late inbound aircraft",
+		})
+	}
+	var resp struct {
+		DelayFlight struct {
+			DelayMinutes  int
+			StatusHistory []flightEvent
+		}
+	}
+
+	e.expectError(e.customerToken, mutation, apperror.CodeForbidden, input(domesticFlightID, departure.Add(time.Hour)))
+
+	e.mustPost(e.opsToken, mutation, &resp, input(domesticFlightID, departure.Add(90*time.Minute)))
+	if resp.DelayFlight.DelayMinutes != 90 {
+		t.Fatalf("expected a 90 minute delay, got %+v", resp.DelayFlight)
+	}
+
+	// Delays replace each other rather than add up.
+	e.mustPost(e.opsToken, mutation, &resp, input(domesticFlightID, departure.Add(30*time.Minute)))
+	history := resp.DelayFlight.StatusHistory
+	if resp.DelayFlight.DelayMinutes != 30 || len(history) != 2 || history[0].EventType != "DELAYED" {
+		t.Errorf("expected a 30 minute delay after two DELAYED events, got %+v", resp.DelayFlight)
+	}
+
+	e.expectError(e.opsToken, mutation, apperror.CodeValidation, input(domesticFlightID, departure.Add(-time.Minute)))
+	e.expectError(e.opsToken, mutation, apperror.CodeNotFound, input(unknownID, departure))
+}
+
+func TestAssignGate(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($id: ID!, $gate: String!) {
+		assignGate(input: { flightId: $id, gate: $gate }) { gate ` + eventFields + ` }
+	}`
+	var resp struct {
+		AssignGate struct {
+			Gate          *string
+			StatusHistory []flightEvent
+		}
+	}
+
+	e.expectError(e.agentToken, mutation, apperror.CodeForbidden, client.Var("id", domesticFlightID), client.Var("gate", "B12"))
+
+	e.mustPost(e.opsToken, mutation, &resp, client.Var("id", domesticFlightID), client.Var("gate", " b12 "))
+	if resp.AssignGate.Gate == nil || *resp.AssignGate.Gate != "B12" {
+		t.Fatalf("expected gate B12, got %+v", resp.AssignGate)
+	}
+	if history := resp.AssignGate.StatusHistory; len(history) != 1 || history[0].EventType != "GATE_ASSIGNED" || *history[0].Gate != "B12" {
+		t.Errorf("expected a GATE_ASSIGNED event, got %+v", history)
+	}
+
+	e.expectError(e.opsToken, mutation, apperror.CodeValidation, client.Var("id", domesticFlightID), client.Var("gate", " "))
+	e.expectError(e.opsToken, mutation, apperror.CodeNotFound, client.Var("id", unknownID), client.Var("gate", "B12"))
+}
+
+func TestSetOverbookingLimit(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	mutation := `mutation($input: SetOverbookingLimitInput!) {
+		setOverbookingLimit(input: $input) { overbookingLimit fares { fareClass overbookingLimit } }
+	}`
+	var resp struct {
+		SetOverbookingLimit struct {
+			OverbookingLimit *int
+			Fares            []struct {
+				FareClass        string
+				OverbookingLimit int
+			}
+		}
+	}
+
+	e.expectError(e.agentToken, mutation, apperror.CodeForbidden,
+		client.Var("input", map[string]any{"flightId": laterFlightID, "seats": 2}))
+	e.expectError(e.opsToken, mutation, apperror.CodeValidation,
+		client.Var("input", map[string]any{"flightId": laterFlightID, "seats": 2, "percent": 10}))
+	e.expectError(e.opsToken, mutation, apperror.CodeNotFound,
+		client.Var("input", map[string]any{"flightId": unknownID, "seats": 2}))
+	e.expectError(e.opsToken, mutation, apperror.CodeNotFound,
+		client.Var("input", map[string]any{"flightId": laterFlightID, "fareClass": "PRO", "seats": 2}))
+
+	e.mustPost(e.opsToken, mutation, &resp, client.Var("input", map[string]any{"flightId": laterFlightID, "percent": 10}))
+	if limit := resp.SetOverbookingLimit.OverbookingLimit; limit == nil || *limit != 3 {
+		t.Fatalf("expected 10%% of 30 seats, got %v", limit)
+	}
+
+	e.mustPost(e.opsToken, mutation, &resp, client.Var("input", map[string]any{"flightId": laterFlightID, "fareClass": "PROMO", "seats": 1}))
+	for _, fare := range resp.SetOverbookingLimit.Fares {
+		if fare.FareClass == "PROMO" && fare.OverbookingLimit != 1 {
+			t.Errorf("expected the PROMO fare limited to one seat, got %d", fare.OverbookingLimit)
+		}
+	}
+
+	// The sold-out PROMO fare now sells exactly one more seat.
+	book := `mutation($input: CreateBookingInput!) { createBooking(input: $input) { booking { fare { availableSeats } } } }`
+	input := client.Var("input", map[string]any{
+		"flightId":       laterFlightID,
+		"fareId":         laterPromoID,
+		"passengerName":  "Ada Lovelace",
+		"passengerEmail": "ada@example.com",
+	})
+	var booked struct {
+		CreateBooking struct {
+			Booking struct {
+				Fare struct{ AvailableSeats int }
+			}
+		}
+	}
+	e.mustPost(e.customerToken, book, &booked, input)
+	if seats := booked.CreateBooking.Booking.Fare.AvailableSeats; seats != 0 {
+		t.Errorf("expected customers to see no seats left on the overbooked fare, got %d", seats)
+	}
+	e.expectError(e.customerToken, book, apperror.CodeSoldOut, input)
+
+	oversold := `query($id: ID!) { flight(id: $id) { oversoldSeats fares { fareClass oversoldSeats } } }`
+	var flight struct {
+		Flight struct {
+			OversoldSeats *int
+			Fares         []struct {
+				FareClass     string
+				OversoldSeats *int
+			}
+		}
+	}
+	e.mustPost(e.opsToken, oversold, &flight, client.Var("id", laterFlightID))
+	for _, fare := range flight.Flight.Fares {
+		want := 0
+		if fare.FareClass == "PROMO" {
+			want = 1
+		}
+		if fare.OversoldSeats == nil || *fare.OversoldSeats != want {
+			t.Errorf("expected %d oversold %s seats, got %v", want, fare.FareClass, fare.OversoldSeats)
+		}
+	}
+	for name, token := range map[string]string{"guests": "", "customers": e.customerToken, "agents": e.agentToken} {
+		if errs := e.post(token, oversold, nil, client.Var("id", laterFlightID)); len(errs) == 0 {
+			t.Errorf("expected oversoldSeats hidden from %s", name)
+		}
+	}
+}
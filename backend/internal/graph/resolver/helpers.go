@@ -0,0 +1,158 @@
+package resolver
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/ancillary"
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/document"
+	"github.com/davidalecrim/red-airlines/internal/graph/dataloader"
+	"github.com/davidalecrim/red-airlines/internal/graph/generated"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/graph/querylimit"
+	"github.com/davidalecrim/red-airlines/internal/overbooking"
+	"github.com/davidalecrim/red-airlines/internal/pubsub"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+	"github.com/davidalecrim/red-airlines/internal/waitlist"
+)
+
+// maxSpecialRequestsLength matches bookings.special_requests.
+const maxSpecialRequestsLength = 500
+
+func manifestURL(flightID string) string {
+	return "/flights/" + url.PathEscape(flightID) + "/manifest"
+}
+
+func boardingPassURL(bookingReference, token string) string {
+	return "/boarding-passes/" + url.PathEscape(bookingReference) + "?token=" + url.QueryEscape(token)
+}
+
+// bookedSeats counts the bookings of a flight that hold a seat.
+func (r *Resolver) bookedSeats(ctx context.Context, flightID string) (int, error) {
+	bookings, err := dataloader.FromContext(ctx).BookingsByFlightLoader.Load(ctx, flightID)()
+	if err != nil {
+		return 0, err
+	}
+
+	booked := 0
+	for _, b := range bookings {
+		if b.BookingStatus.HoldsSeat() {
+			booked++
+		}
+	}
+	return booked, nil
+}
+
+// parseDocument converts an optional document input, returning nil when none
+// was given.
+func parseDocument(in *generated.PassengerDocumentInput, now time.Time) (*document.Document, error) {
+	if in == nil {
+		return nil, nil
+	}
+	input := document.Input{
+		MRZ:            in.Mrz,
+		Type:           in.DocumentType,
+		Number:         in.DocumentNumber,
+		IssuingCountry: in.IssuingCountry,
+		Nationality:    in.Nationality,
+		DateOfBirth:    in.DateOfBirth,
+		ExpiryDate:     in.ExpiryDate,
+		Sex:            in.Sex,
+	}
+	return input.Parse(now)
+}
+
+// userBookings lists the bookings of an account, most recent first, up to
+// querylimit.ListSize(limit).
+func (r *Resolver) userBookings(ctx context.Context, userID string, passengerEmail *string, limit *int) ([]*model.Booking, error) {
+	return r.Store.Bookings().ListByUser(ctx, repository.BookingFilter{
+		UserID:         userID,
+		PassengerEmail: passengerEmail,
+		Limit:          querylimit.ListSize(limit),
+	})
+}
+
+// guestToken returns a booking token for a new booking without an account.
+// Guests have nothing to find the booking with again; the token lets them
+// manage it without looking it up by last name. Both are nil for bookings
+// of an account.
+func (r *Resolver) guestToken(b *model.Booking) (*string, *time.Time, error) {
+	if b.UserID != nil {
+		return nil, nil, nil
+	}
+	token, expiresAt, err := r.Auth.BookingToken(b)
+	if err != nil {
+		return nil, nil, apperror.Internal(err, "failed to sign booking token")
+	}
+	return &token, &expiresAt, nil
+}
+
+// cancelBooking cancels the booking if authorized accepts it, releasing its
+// seat and ancillaries and offering the seat to the waitlist.
+func (r *Resolver) cancelBooking(ctx context.Context, bookingReference string, authorized func(*model.Booking) bool) (*model.Booking, error) {
+	var b *model.Booking
+	err := r.Store.InTx(ctx, func(tx repository.Store) error {
+		var err error
+		b, err = tx.Bookings().GetByReferenceForUpdate(ctx, bookingReference)
+		if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+			return err
+		}
+		if err != nil || !authorized(b) {
+			return apperror.NotFound("booking %s not found", bookingReference)
+		}
+
+		if !b.BookingStatus.IsCancellable() {
+			return apperror.Conflict("booking is %s and can no longer be cancelled", b.BookingStatus)
+		}
+
+		flight, err := tx.Flights().GetForUpdate(ctx, b.FlightID)
+		if err != nil {
+			return err
+		}
+		if flight.Status == model.FlightStatusDeparted || flight.Status == model.FlightStatusArrived {
+			return apperror.Conflict("flight %s has already %s", flight.FlightNumber, strings.ToLower(flight.Status.String()))
+		}
+
+		b.BookingStatus = model.BookingStatusCancelled
+		if err := tx.Bookings().UpdateStatus(ctx, b.ID, b.BookingStatus); err != nil {
+			return err
+		}
+
+		if err := ancillary.ReleaseInventory(ctx, tx, b.ID, b.FlightID); err != nil {
+			return err
+		}
+
+		// Seats of a cancelled flight are not resold, so the waitlist only moves
+		// while the flight still operates.
+		if !flight.Status.IsOperable() {
+			return nil
+		}
+		if err := tx.Fares().ReleaseSeat(ctx, b.FareID); err != nil {
+			return err
+		}
+
+		// An oversold fare may still be over its limit after the release.
+		fare, err := tx.Fares().GetForUpdate(ctx, b.FareID)
+		if err != nil {
+			return err
+		}
+		if err := overbooking.CanSell(ctx, tx.Bookings(), flight, fare); err == nil {
+			if _, err := waitlist.PromoteNext(ctx, tx, b.FareID, time.Now()); err != nil {
+				return err
+			}
+		} else if apperror.CodeOf(err) != apperror.CodeSoldOut {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, pubsub.BookingTopic(b.BookingReference), b.BookingReference)
+
+	return b, nil
+}
@@ -0,0 +1,268 @@
+package resolver_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/auth"
+	"github.com/davidalecrim/red-airlines/internal/database/pgtest"
+	"github.com/davidalecrim/red-airlines/internal/document"
+	"github.com/davidalecrim/red-airlines/internal/graph/dataloader"
+	"github.com/davidalecrim/red-airlines/internal/graph/generated"
+	"github.com/davidalecrim/red-airlines/internal/graph/querylimit"
+	"github.com/davidalecrim/red-airlines/internal/graph/resolver"
+	"github.com/davidalecrim/red-airlines/internal/migrate"
+	"github.com/davidalecrim/red-airlines/internal/pubsub"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+	"github.com/davidalecrim/red-airlines/migrations"
+)
+
+// templateDB holds the migrated schema every test database is copied from.
+const templateDB = "red_airlines_template"
+
+// Every test runs once per store.
+const (
+	memoryStore   = "memory"
+	postgresStore = "postgres"
+)
+
+var (
+	// store is the kind of store of the current run.
+	store     string
+	pg        *pgtest.Server
+	databases atomic.Int64
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	// Timestamps are stored without a time zone and read back as UTC, as in
+	// the containers the server runs in.
+	time.Local = time.UTC
+
+	log.Printf("Running the resolver tests against the %s store", memoryStore)
+	store = memoryStore
+	if code := m.Run(); code != 0 {
+		return code
+	}
+
+	var err error
+	pg, err = pgtest.Start()
+	if errors.Is(err, pgtest.ErrUnavailable) && pgtest.Skippable() {
+		log.Printf("Skipping the resolver tests against the %s store: %v", postgresStore, err)
+		return 0
+	}
+	if err != nil {
+		log.Printf("Failed to start Postgres: %v", err)
+		return 1
+	}
+	defer func() {
+		if err := pg.Stop(); err != nil {
+			log.Printf("Failed to stop Postgres: %v", err)
+		}
+	}()
+
+	if err := migrateTemplate(); err != nil {
+		log.Printf("Failed to migrate the template database: %v", err)
+		return 1
+	}
+
+	log.Printf("Running the resolver tests against the %s store", postgresStore)
+	store = postgresStore
+	return m.Run()
+}
+
+func migrateTemplate() error {
+	url, err := pg.CreateDatabase(templateDB, "")
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return err
+	}
+	// The template must have no connections left to be copied.
+	defer func() { _ = db.Close() }()
+
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+	// Migrating down and up again checks the down files against the schema.
+	ctx := context.Background()
+	if err := migrator.Up(ctx); err != nil {
+		return err
+	}
+	if err := migrator.Goto(ctx, 0); err != nil {
+		return err
+	}
+	return migrator.Up(ctx)
+}
+
+// testEnv is a GraphQL server on an empty store, seeded with the fixtures.
+// Postgres stores are fresh copies of the migrated database.
+type testEnv struct {
+	t      *testing.T
+	store  repository.Store
+	issuer *auth.Issuer
+	client *client.Client
+	// subscribed receives the topic of every subscription once it listens.
+	subscribed chan string
+	fixtures
+}
+
+// signalingHub is a MemoryHub that reports subscriptions, so tests publish
+// only once a subscriber is listening.
+type signalingHub struct {
+	*pubsub.MemoryHub
+	subscribed chan<- string
+}
+
+func (h signalingHub) Subscribe(ctx context.Context, topic string) (<-chan string, error) {
+	messages, err := h.MemoryHub.Subscribe(ctx, topic)
+	if err == nil {
+		h.subscribed <- topic
+	}
+	return messages, err
+}
+
+func newEnv(t *testing.T) *testEnv {
+	t.Helper()
+
+	issuer, err := auth.NewIssuer(bytes.Repeat([]byte("s"), 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	vault, err := document.NewVault(bytes.Repeat([]byte("k"), 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := newStore(t)
+	subscribed := make(chan string, 16)
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers: &resolver.Resolver{
+			Store:          store,
+			PubSub:         signalingHub{pubsub.NewMemoryHub(), subscribed},
+			Documents:      vault,
+			Auth:           issuer,
+			BookingLookups: auth.NewLookupLimiter(),
+		},
+		Directives: generated.DirectiveRoot{HasRole: auth.HasRole},
+		Complexity: querylimit.Complexity(),
+	}))
+	// The test client expects a keep-alive right after the connection ack.
+	srv.AddTransport(transport.Websocket{KeepAlivePingInterval: time.Second, InitFunc: issuer.WebsocketInit})
+	srv.AddTransport(transport.POST{})
+	srv.Use(dataloader.Extension{Store: store})
+	srv.SetErrorPresenter(apperror.Presenter)
+	srv.SetRecoverFunc(apperror.Recover)
+
+	e := &testEnv{
+		t:          t,
+		store:      store,
+		issuer:     issuer,
+		client:     client.New(auth.Middleware(issuer, srv)),
+		subscribed: subscribed,
+	}
+	e.seed()
+	return e
+}
+
+func newStore(t *testing.T) repository.Store {
+	t.Helper()
+	if store == memoryStore {
+		return repository.NewMemoryStore()
+	}
+
+	url, err := pg.CreateDatabase(fmt.Sprintf("test_%d", databases.Add(1)), templateDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := sqlx.Connect("postgres", url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	return repository.NewPostgresStore(db)
+}
+
+// in returns a copy of e reporting to t, for subtests.
+func (e *testEnv) in(t *testing.T) *testEnv {
+	c := *e
+	c.t = t
+	return &c
+}
+
+type gqlError struct {
+	Message    string `json:"message"`
+	Extensions struct {
+		Code string `json:"code"`
+	} `json:"extensions"`
+}
+
+// post sends query as the holder of token, anonymously when it is empty, and
+// decodes the data of the response into out, which may be nil. It returns
+// the errors of the response.
+func (e *testEnv) post(token, query string, out any, options ...client.Option) []gqlError {
+	e.t.Helper()
+	if token != "" {
+		options = append(options, client.AddHeader("Authorization", "Bearer "+token))
+	}
+
+	resp, err := e.client.RawPost(query, options...)
+	if err != nil {
+		e.t.Fatalf("post: %v", err)
+	}
+
+	var errs []gqlError
+	if resp.Errors != nil {
+		if err := json.Unmarshal(resp.Errors, &errs); err != nil {
+			e.t.Fatalf("decode errors: %v", err)
+		}
+	}
+	if out != nil && resp.Data != nil {
+		data, err := json.Marshal(resp.Data)
+		if err != nil {
+			e.t.Fatalf("encode data: %v", err)
+		}
+		if err := json.Unmarshal(data, out); err != nil {
+			e.t.Fatalf("decode data: %v", err)
+		}
+	}
+	return errs
+}
+
+// mustPost is post for requests expected to succeed.
+func (e *testEnv) mustPost(token, query string, out any, options ...client.Option) {
+	e.t.Helper()
+	if errs := e.post(token, query, out, options...); len(errs) > 0 {
+		e.t.Fatalf("unexpected errors: %+v", errs)
+	}
+}
+
+// expectError asserts that query fails with a single error of code.
+func (e *testEnv) expectError(token, query string, code apperror.Code, options ...client.Option) {
+	e.t.Helper()
+	errs := e.post(token, query, nil, options...)
+	if len(errs) != 1 || errs[0].Extensions.Code != string(code) {
+		e.t.Fatalf("expected one %s error, got %+v", code, errs)
+	}
+}
@@ -0,0 +1,330 @@
+package resolver_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+func TestFlights(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	query := `query($origin: String, $destination: String, $limit: Int) {
+		flights(origin: $origin, destination: $destination, limit: $limit) {
+			flightNumber
+			fares { fareClass availableSeats }
+		}
+	}`
+	var resp struct {
+		Flights []struct {
+			FlightNumber string
+			Fares        []struct {
+				FareClass      string
+				AvailableSeats int
+			}
+		}
+	}
+
+	e.mustPost("", query, &resp, client.Var("origin", "JFK"), client.Var("destination", "LAX"))
+	if len(resp.Flights) != 2 || resp.Flights[0].FlightNumber != "RA100" || resp.Flights[1].FlightNumber != "RA102" {
+		t.Fatalf("expected RA100 and RA102 by departure, got %+v", resp.Flights)
+	}
+	fares := resp.Flights[0].Fares
+	if len(fares) != 3 || fares[0].FareClass != "PROMO" || fares[1].FareClass != "BASIC" || fares[2].FareClass != "PRO" {
+		t.Fatalf("expected fares by price, got %+v", fares)
+	}
+	if fares[1].AvailableSeats != 9 {
+		t.Errorf("expected 9 BASIC seats left after Ada's booking, got %d", fares[1].AvailableSeats)
+	}
+
+	e.mustPost("", query, &resp, client.Var("limit", 1))
+	if len(resp.Flights) != 1 {
+		t.Errorf("expected limit to apply, got %d flights", len(resp.Flights))
+	}
+
+	e.mustPost("", query, &resp, client.Var("destination", "NRT"))
+	if len(resp.Flights) != 0 {
+		t.Errorf("expected no flights to NRT, got %+v", resp.Flights)
+	}
+}
+
+func TestFlight(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	query := `query($id: ID!) {
+		flight(id: $id) {
+			flightNumber
+			bookedSeats
+			overbookingLimit
+			bookings { bookingReference passengerName passengerEmail }
+			fares { bookings { bookingReference } }
+		}
+	}`
+	type listedBooking struct {
+		BookingReference string
+		PassengerName    string
+		PassengerEmail   string
+	}
+	var resp struct {
+		Flight *struct {
+			FlightNumber     string
+			BookedSeats      int
+			OverbookingLimit *int
+			Bookings         []listedBooking
+			Fares            []struct{ Bookings []listedBooking }
+		}
+	}
+
+	e.mustPost(e.agentToken, query, &resp, client.Var("id", domesticFlightID))
+	if resp.Flight == nil || resp.Flight.FlightNumber != "RA100" || resp.Flight.BookedSeats != 1 {
+		t.Fatalf("expected RA100 with one booked seat, got %+v", resp.Flight)
+	}
+	if resp.Flight.OverbookingLimit != nil {
+		t.Errorf("expected no overbooking, got %+v", resp.Flight)
+	}
+	if len(resp.Flight.Bookings) != 1 || resp.Flight.Bookings[0].PassengerEmail != "ada@example.com" {
+		t.Fatalf("expected agents to see the passengers, got %+v", resp.Flight.Bookings)
+	}
+
+	// References and names listed together would let anyone open every
+	// booking with manageBooking, so only agents may list passengers.
+	for _, caller := range []struct {
+		name, token string
+		code        apperror.Code
+	}{
+		{"anonymous", "", apperror.CodeUnauthenticated},
+		{"customer", e.customerToken, apperror.CodeForbidden},
+	} {
+		resp.Flight = nil
+		errs := e.post(caller.token, query, &resp, client.Var("id", domesticFlightID))
+		if len(errs) != 1+3 {
+			t.Fatalf("%s: expected an error for the flight and each fare listing, got %+v", caller.name, errs)
+		}
+		for _, err := range errs {
+			if err.Extensions.Code != string(caller.code) {
+				t.Errorf("%s: expected %s errors, got %+v", caller.name, caller.code, err)
+			}
+		}
+		if resp.Flight == nil || resp.Flight.FlightNumber != "RA100" || resp.Flight.Bookings != nil {
+			t.Fatalf("%s: expected the flight without its bookings, got %+v", caller.name, resp.Flight)
+		}
+		for _, fare := range resp.Flight.Fares {
+			if fare.Bookings != nil {
+				t.Errorf("%s: expected no fare bookings, got %+v", caller.name, fare.Bookings)
+			}
+		}
+	}
+
+	e.mustPost("", query, &resp, client.Var("id", unknownID))
+	if resp.Flight != nil {
+		t.Errorf("expected null for an unknown flight, got %+v", resp.Flight)
+	}
+}
+
+func TestAirports(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	var resp struct{ Airports []string }
+	e.mustPost("", `{ airports }`, &resp)
+	if !slices.Equal(resp.Airports, []string{"JFK", "LAX", "LHR"}) {
+		t.Errorf("expected JFK, LAX and LHR, got %v", resp.Airports)
+	}
+}
+
+func TestMe(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	query := `{ me { email roles bookings { bookingReference } } }`
+	var resp struct {
+		Me *struct {
+			Email    string
+			Roles    []string
+			Bookings []struct{ BookingReference string }
+		}
+	}
+
+	e.mustPost("", query, &resp)
+	if resp.Me != nil {
+		t.Fatalf("expected null for anonymous requests, got %+v", resp.Me)
+	}
+
+	e.mustPost(e.customerToken, query, &resp)
+	if resp.Me == nil || resp.Me.Email != "ada@example.com" || !slices.Equal(resp.Me.Roles, []string{"CUSTOMER"}) {
+		t.Fatalf("expected the customer, got %+v", resp.Me)
+	}
+	if len(resp.Me.Bookings) != 2 {
+		t.Errorf("expected the customer's two bookings, got %+v", resp.Me.Bookings)
+	}
+}
+
+func TestBooking(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	query := `query($ref: String!) {
+		booking(bookingReference: $ref) { passengerName flight { flightNumber } fare { fareClass } }
+	}`
+	var resp struct {
+		Booking *struct {
+			PassengerName string
+			Flight        struct{ FlightNumber string }
+			Fare          struct{ FareClass string }
+		}
+	}
+
+	e.mustPost(e.customerToken, query, &resp, client.Var("ref", e.adaRef))
+	if resp.Booking == nil || resp.Booking.Flight.FlightNumber != "RA100" || resp.Booking.Fare.FareClass != "BASIC" {
+		t.Fatalf("expected Ada's RA100 BASIC booking, got %+v", resp.Booking)
+	}
+
+	e.mustPost(e.customerToken, query, &resp, client.Var("ref", e.graceRef))
+	if resp.Booking != nil {
+		t.Errorf("expected other passengers' bookings to be hidden, got %+v", resp.Booking)
+	}
+
+	e.mustPost(e.agentToken, query, &resp, client.Var("ref", e.graceRef))
+	if resp.Booking == nil || resp.Booking.PassengerName != "Grace Hopper" {
+		t.Errorf("expected agents to read any booking, got %+v", resp.Booking)
+	}
+
+	e.mustPost(e.agentToken, query, &resp, client.Var("ref", "ZZZZZZ"))
+	if resp.Booking != nil {
+		t.Errorf("expected null for an unknown reference, got %+v", resp.Booking)
+	}
+
+	e.expectError("", query, apperror.CodeUnauthenticated, client.Var("ref", e.adaRef))
+}
+
+func TestBookings(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	query := `query($email: String, $limit: Int) {
+		bookings(passengerEmail: $email, limit: $limit) { bookingReference }
+	}`
+	var resp struct {
+		Bookings []struct{ BookingReference string }
+	}
+
+	e.mustPost(e.customerToken, query, &resp)
+	if len(resp.Bookings) != 2 {
+		t.Fatalf("expected two bookings, got %+v", resp.Bookings)
+	}
+
+	e.mustPost(e.customerToken, query, &resp, client.Var("limit", 1))
+	if len(resp.Bookings) != 1 {
+		t.Errorf("expected limit to apply, got %+v", resp.Bookings)
+	}
+
+	e.mustPost(e.customerToken, query, &resp, client.Var("email", "nobody@example.com"))
+	if len(resp.Bookings) != 0 {
+		t.Errorf("expected no bookings for another email, got %+v", resp.Bookings)
+	}
+
+	e.expectError("", query, apperror.CodeUnauthenticated)
+}
+
+func TestAncillaryOffers(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	query := `query($id: ID!) {
+		ancillaryOffers(flightId: $id) { product { code maxPerBooking } price remaining }
+	}`
+	var resp struct {
+		AncillaryOffers []struct {
+			Product struct {
+				Code          string
+				MaxPerBooking int
+			}
+			Price     float64
+			Remaining *int
+		}
+	}
+
+	e.mustPost("", query, &resp, client.Var("id", domesticFlightID))
+	if len(resp.AncillaryOffers) != 5 {
+		t.Fatalf("expected the five catalog products, got %+v", resp.AncillaryOffers)
+	}
+	for _, offer := range resp.AncillaryOffers {
+		if offer.Price <= 0 {
+			t.Errorf("expected a price for %s", offer.Product.Code)
+		}
+		if (offer.Product.Code == "LOUNGE_PASS") != (offer.Remaining != nil) {
+			t.Errorf("expected only LOUNGE_PASS to have inventory, got %s with %v", offer.Product.Code, offer.Remaining)
+		}
+	}
+
+	e.expectError("", query, apperror.CodeNotFound, client.Var("id", unknownID))
+}
+
+func TestStaffQueriesRequireAgent(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	queries := map[string]string{
+		"disruptionReport":   `query($id: ID!) { disruptionReport(flightId: $id) { totalAffected } }`,
+		"deniedBoardingList": `query($id: ID!) { deniedBoardingList(flightId: $id) { oversoldSeats } }`,
+		"flightManifest":     `query($id: ID!) { flightManifest(flightId: $id) { downloadUrl } }`,
+	}
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			e := e.in(t)
+			e.expectError("", query, apperror.CodeUnauthenticated, client.Var("id", domesticFlightID))
+			e.expectError(e.customerToken, query, apperror.CodeForbidden, client.Var("id", domesticFlightID))
+			e.expectError(e.opsToken, query, apperror.CodeForbidden, client.Var("id", domesticFlightID))
+
+			var resp map[string]any
+			e.mustPost(e.agentToken, query, &resp, client.Var("id", domesticFlightID))
+			if resp[name] == nil {
+				t.Errorf("expected a result for agents, got %v", resp)
+			}
+
+			e.mustPost(e.agentToken, query, &resp, client.Var("id", unknownID))
+			if resp[name] != nil {
+				t.Errorf("expected null for an unknown flight, got %v", resp)
+			}
+		})
+	}
+}
+
+func TestFlightManifest(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	query := `query($id: ID!) {
+		flightManifest(flightId: $id) {
+			flight { flightNumber }
+			entries { passengerName bookingReference fareClass bookingStatus }
+			downloadUrl
+		}
+	}`
+	var resp struct {
+		FlightManifest struct {
+			Flight  struct{ FlightNumber string }
+			Entries []struct {
+				PassengerName    string
+				BookingReference string
+				FareClass        string
+				BookingStatus    string
+			}
+			DownloadURL string `json:"downloadUrl"`
+		}
+	}
+
+	e.mustPost(e.agentToken, query, &resp, client.Var("id", domesticFlightID))
+	entries := resp.FlightManifest.Entries
+	if len(entries) != 1 || entries[0].BookingReference != e.adaRef || entries[0].FareClass != "BASIC" {
+		t.Fatalf("expected Ada's booking, got %+v", entries)
+	}
+	if resp.FlightManifest.DownloadURL == "" {
+		t.Error("expected a download URL")
+	}
+}
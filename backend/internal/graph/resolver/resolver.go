@@ -1,12 +1,17 @@
 package resolver
 
 import (
-	"github.com/jmoiron/sqlx"
-
-	"github.com/davidalecrim/red-airlines/internal/graph/dataloader"
+	"github.com/davidalecrim/red-airlines/internal/auth"
+	"github.com/davidalecrim/red-airlines/internal/document"
+	"github.com/davidalecrim/red-airlines/internal/pubsub"
+	"github.com/davidalecrim/red-airlines/internal/repository"
 )
 
 type Resolver struct {
-	DB      *sqlx.DB
-	Loaders *dataloader.Loaders
+	Store     repository.Store
+	PubSub    pubsub.Broker
+	Documents *document.Vault
+	Auth      *auth.Issuer
+	// BookingLookups limits failed manageBooking attempts per reference.
+	BookingLookups *auth.LookupLimiter
 }
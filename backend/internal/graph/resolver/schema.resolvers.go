@@ -7,20 +7,59 @@ package resolver
 
 import (
 	"context"
-	"crypto/rand"
-	"fmt"
-	"math/big"
 	"time"
 
-	"github.com/google/uuid"
-
+	"github.com/davidalecrim/red-airlines/internal/ancillary"
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/auth"
+	"github.com/davidalecrim/red-airlines/internal/boardingpass"
+	"github.com/davidalecrim/red-airlines/internal/checkin"
+	"github.com/davidalecrim/red-airlines/internal/disruption"
+	"github.com/davidalecrim/red-airlines/internal/graph/dataloader"
 	"github.com/davidalecrim/red-airlines/internal/graph/generated"
 	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/graph/querylimit"
+	"github.com/davidalecrim/red-airlines/internal/manifest"
+	"github.com/davidalecrim/red-airlines/internal/overbooking"
+	"github.com/davidalecrim/red-airlines/internal/pubsub"
+	"github.com/davidalecrim/red-airlines/internal/ratelimit"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+	"github.com/davidalecrim/red-airlines/internal/waitlist"
 )
 
+// Ancillaries is the resolver for the ancillaries field.
+func (r *bookingResolver) Ancillaries(ctx context.Context, obj *model.Booking) ([]*model.BookingAncillary, error) {
+	result, err := dataloader.FromContext(ctx).AncillariesByBookingLoader.Load(ctx, obj.ID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Document is the resolver for the document field.
+func (r *bookingResolver) Document(ctx context.Context, obj *model.Booking) (*generated.PassengerDocument, error) {
+	ciphertext, err := dataloader.FromContext(ctx).DocumentLoader.Load(ctx, obj.ID)()
+	if err != nil || ciphertext == nil {
+		return nil, err
+	}
+
+	doc, err := r.Documents.Open(obj.ID, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generated.PassengerDocument{
+		DocumentType:   doc.Type,
+		MaskedNumber:   doc.MaskedNumber(),
+		Nationality:    doc.Nationality,
+		IssuingCountry: doc.IssuingCountry,
+		ExpiryDate:     doc.ExpiryDate.Format(time.DateOnly),
+	}, nil
+}
+
 // Flight is the resolver for the flight field.
 func (r *bookingResolver) Flight(ctx context.Context, obj *model.Booking) (*model.Flight, error) {
-	result, err := r.Loaders.FlightLoader.Load(ctx, obj.FlightID)()
+	result, err := dataloader.FromContext(ctx).FlightLoader.Load(ctx, obj.FlightID)()
 	if err != nil {
 		return nil, err
 	}
@@ -29,16 +68,50 @@ func (r *bookingResolver) Flight(ctx context.Context, obj *model.Booking) (*mode
 
 // Fare is the resolver for the fare field.
 func (r *bookingResolver) Fare(ctx context.Context, obj *model.Booking) (*model.Fare, error) {
-	result, err := r.Loaders.FareLoader.Load(ctx, obj.FareID)()
+	result, err := dataloader.FromContext(ctx).FareLoader.Load(ctx, obj.FareID)()
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
+// Product is the resolver for the product field.
+func (r *bookingAncillaryResolver) Product(ctx context.Context, obj *model.BookingAncillary) (*model.AncillaryProduct, error) {
+	result, err := dataloader.FromContext(ctx).AncillaryProductLoader.Load(ctx, obj.ProductID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AvailableSeats is the resolver for the availableSeats field.
+func (r *fareResolver) AvailableSeats(ctx context.Context, obj *model.Fare) (int, error) {
+	// The column goes negative once the fare is overbooked; oversoldSeats
+	// reports that to operations only.
+	return max(0, obj.AvailableSeats), nil
+}
+
+// OverbookingLimit is the resolver for the overbookingLimit field.
+func (r *fareResolver) OverbookingLimit(ctx context.Context, obj *model.Fare) (int, error) {
+	flight, err := dataloader.FromContext(ctx).FlightLoader.Load(ctx, obj.FlightID)()
+	if err != nil {
+		return 0, err
+	}
+	return obj.OverbookingAllowance(flight), nil
+}
+
+// WaitlistCount is the resolver for the waitlistCount field.
+func (r *fareResolver) WaitlistCount(ctx context.Context, obj *model.Fare) (int, error) {
+	result, err := dataloader.FromContext(ctx).WaitlistCountLoader.Load(ctx, obj.ID)()
+	if err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
 // Flight is the resolver for the flight field.
 func (r *fareResolver) Flight(ctx context.Context, obj *model.Fare) (*model.Flight, error) {
-	result, err := r.Loaders.FlightLoader.Load(ctx, obj.FlightID)()
+	result, err := dataloader.FromContext(ctx).FlightLoader.Load(ctx, obj.FlightID)()
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +120,40 @@ func (r *fareResolver) Flight(ctx context.Context, obj *model.Fare) (*model.Flig
 
 // Bookings is the resolver for the bookings field.
 func (r *fareResolver) Bookings(ctx context.Context, obj *model.Fare) ([]*model.Booking, error) {
-	result, err := r.Loaders.BookingsByFareLoader.Load(ctx, obj.ID)()
+	result, err := dataloader.FromContext(ctx).BookingsByFareLoader.Load(ctx, obj.ID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BookedSeats is the resolver for the bookedSeats field.
+func (r *flightResolver) BookedSeats(ctx context.Context, obj *model.Flight) (int, error) {
+	return r.bookedSeats(ctx, obj.ID)
+}
+
+// OversoldSeats is the resolver for the oversoldSeats field.
+func (r *flightResolver) OversoldSeats(ctx context.Context, obj *model.Flight) (*int, error) {
+	booked, err := r.bookedSeats(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	oversold := max(0, booked-obj.TotalSeats)
+	return &oversold, nil
+}
+
+// OverbookingLimit is the resolver for the overbookingLimit field.
+func (r *flightResolver) OverbookingLimit(ctx context.Context, obj *model.Flight) (*int, error) {
+	allowance, ok := obj.OverbookingAllowance()
+	if !ok {
+		return nil, nil
+	}
+	return &allowance, nil
+}
+
+// StatusHistory is the resolver for the statusHistory field.
+func (r *flightResolver) StatusHistory(ctx context.Context, obj *model.Flight) ([]*model.FlightEvent, error) {
+	result, err := dataloader.FromContext(ctx).EventsByFlightLoader.Load(ctx, obj.ID)()
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +162,7 @@ func (r *fareResolver) Bookings(ctx context.Context, obj *model.Fare) ([]*model.
 
 // Fares is the resolver for the fares field.
 func (r *flightResolver) Fares(ctx context.Context, obj *model.Flight) ([]*model.Fare, error) {
-	result, err := r.Loaders.FaresByFlightLoader.Load(ctx, obj.ID)()
+	result, err := dataloader.FromContext(ctx).FaresByFlightLoader.Load(ctx, obj.ID)()
 	if err != nil {
 		return nil, err
 	}
@@ -65,198 +171,774 @@ func (r *flightResolver) Fares(ctx context.Context, obj *model.Flight) ([]*model
 
 // Bookings is the resolver for the bookings field.
 func (r *flightResolver) Bookings(ctx context.Context, obj *model.Flight) ([]*model.Booking, error) {
-	result, err := r.Loaders.BookingsByFlightLoader.Load(ctx, obj.ID)()
+	result, err := dataloader.FromContext(ctx).BookingsByFlightLoader.Load(ctx, obj.ID)()
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
+// Signup is the resolver for the signup field.
+func (r *mutationResolver) Signup(ctx context.Context, input generated.SignupInput) (*auth.Session, error) {
+	return auth.Signup(ctx, r.Store, r.Auth, auth.SignupInput{
+		Email:    input.Email,
+		Name:     input.Name,
+		Password: input.Password,
+	}, time.Now())
+}
+
+// Login is the resolver for the login field.
+func (r *mutationResolver) Login(ctx context.Context, email string, password string) (*auth.Session, error) {
+	return auth.Login(ctx, r.Store, r.Auth, email, password, time.Now())
+}
+
+// RefreshToken is the resolver for the refreshToken field.
+func (r *mutationResolver) RefreshToken(ctx context.Context, refreshToken string) (*auth.Session, error) {
+	return auth.Refresh(ctx, r.Store, r.Auth, refreshToken, time.Now())
+}
+
+// Logout is the resolver for the logout field.
+func (r *mutationResolver) Logout(ctx context.Context, refreshToken string) (bool, error) {
+	if err := auth.Logout(ctx, r.Store, refreshToken, time.Now()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ManageBooking is the resolver for the manageBooking field.
+func (r *mutationResolver) ManageBooking(ctx context.Context, bookingReference string, lastName string) (*auth.BookingSession, error) {
+	return auth.ManageBooking(ctx, r.Store.Bookings(), r.Auth, r.BookingLookups, ratelimit.ClientIP(ctx), bookingReference, lastName, time.Now())
+}
+
 // CreateBooking is the resolver for the createBooking field.
-func (r *mutationResolver) CreateBooking(ctx context.Context, input generated.CreateBookingInput) (*model.Booking, error) {
-	tx, err := r.DB.BeginTxx(ctx, nil)
+func (r *mutationResolver) CreateBooking(ctx context.Context, input generated.CreateBookingInput) (*generated.CreateBookingPayload, error) {
+	if input.SpecialRequests != nil && len(*input.SpecialRequests) > maxSpecialRequestsLength {
+		return nil, apperror.Validation("specialRequests must be at most %d characters", maxSpecialRequestsLength)
+	}
+
+	now := time.Now()
+	doc, err := parseDocument(input.Document, now)
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, err
 	}
-	defer func() {
-		_ = tx.Rollback()
-	}()
 
-	// Validate flight exists
-	var flight model.Flight
-	if err := tx.GetContext(ctx, &flight, "SELECT * FROM flights WHERE id = $1", input.FlightID); err != nil {
-		return nil, fmt.Errorf("flight not found: %w", err)
+	var b *model.Booking
+	err = r.Store.InTx(ctx, func(tx repository.Store) error {
+		// Locking the flight serializes overbooking against the flight limit.
+		flight, err := tx.Flights().GetForUpdate(ctx, input.FlightID)
+		if err != nil {
+			return err
+		}
+
+		if !flight.Status.IsOperable() {
+			return apperror.Conflict("flight %s is %s and can no longer be booked", flight.FlightNumber, flight.Status)
+		}
+
+		// Validate fare exists and has available seats
+		fare, err := tx.Fares().GetForUpdate(ctx, input.FareID)
+		if err != nil {
+			return err
+		}
+
+		if fare.FlightID != flight.ID {
+			return apperror.Validation("fare %s does not belong to flight %s", fare.ID, flight.ID)
+		}
+
+		if err := overbooking.CanSell(ctx, tx.Bookings(), flight, fare); err != nil {
+			return err
+		}
+
+		if input.SeatNumber != nil {
+			seat, err := checkin.ReserveSeat(ctx, tx, flight, *input.SeatNumber)
+			if err != nil {
+				return err
+			}
+			input.SeatNumber = &seat
+		}
+
+		b = &model.Booking{
+			FlightID:        input.FlightID,
+			FareID:          input.FareID,
+			PassengerName:   input.PassengerName,
+			PassengerEmail:  input.PassengerEmail,
+			PassengerPhone:  input.PassengerPhone,
+			SeatNumber:      input.SeatNumber,
+			SpecialRequests: input.SpecialRequests,
+			BookingStatus:   model.BookingStatusConfirmed,
+			TotalPrice:      fare.Price,
+			BookedAt:        now,
+		}
+		if claims := auth.ClaimsFrom(ctx); claims != nil {
+			userID := claims.UserID()
+			b.UserID = &userID
+		}
+
+		if err := tx.Bookings().Create(ctx, b); err != nil {
+			return err
+		}
+
+		// Picking the seat when booking buys seat selection.
+		if b.SeatNumber != nil {
+			if err := ancillary.BuySeatSelection(ctx, tx, b, flight, fare, now); err != nil {
+				return err
+			}
+			if err := tx.Bookings().Update(ctx, b); err != nil {
+				return err
+			}
+		}
+
+		if doc != nil {
+			if err := r.Documents.Save(ctx, tx.Documents(), b, flight, doc, now); err != nil {
+				return err
+			}
+		}
+
+		return tx.Fares().TakeSeat(ctx, fare.ID)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Validate fare exists and has available seats
-	var fare model.Fare
-	if err := tx.GetContext(ctx, &fare, "SELECT * FROM fares WHERE id = $1", input.FareID); err != nil {
-		return nil, fmt.Errorf("fare not found: %w", err)
+	r.publish(ctx, pubsub.BookingTopic(b.BookingReference), b.BookingReference)
+
+	token, expiresAt, err := r.guestToken(b)
+	if err != nil {
+		return nil, err
 	}
+	return &generated.CreateBookingPayload{Booking: b, Token: token, ExpiresAt: expiresAt}, nil
+}
 
-	if fare.AvailableSeats <= 0 {
-		return nil, fmt.Errorf("no available seats for this fare")
+// CancelBooking is the resolver for the cancelBooking field.
+func (r *mutationResolver) CancelBooking(ctx context.Context, bookingReference string) (*model.Booking, error) {
+	authorized, err := auth.BookingAccess(ctx)
+	if err != nil {
+		return nil, err
 	}
+	return r.cancelBooking(ctx, bookingReference, authorized)
+}
 
-	// Generate unique booking reference
-	bookingReference := generateBookingReference()
+// CancelBookingOnBehalf is the resolver for the cancelBookingOnBehalf field.
+func (r *mutationResolver) CancelBookingOnBehalf(ctx context.Context, bookingReference string) (*model.Booking, error) {
+	return r.cancelBooking(ctx, bookingReference, func(*model.Booking) bool { return true })
+}
 
-	// Check uniqueness (very unlikely collision)
-	var exists bool
-	err = tx.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM bookings WHERE booking_reference = $1)", bookingReference)
+// JoinWaitlist is the resolver for the joinWaitlist field.
+func (r *mutationResolver) JoinWaitlist(ctx context.Context, fareID string, passenger generated.PassengerInput) (*model.WaitlistEntry, error) {
+	var userID *string
+	if claims := auth.ClaimsFrom(ctx); claims != nil {
+		id := claims.UserID()
+		userID = &id
+	}
+	return waitlist.Join(ctx, r.Store, fareID, waitlist.Passenger{
+		Name:  passenger.Name,
+		Email: passenger.Email,
+		Phone: passenger.Phone,
+	}, userID, time.Now())
+}
+
+// AcceptWaitlistOffer is the resolver for the acceptWaitlistOffer field.
+func (r *mutationResolver) AcceptWaitlistOffer(ctx context.Context, entryID string, passengerEmail string) (*generated.AcceptWaitlistOfferPayload, error) {
+	b, err := waitlist.Accept(ctx, r.Store, entryID, passengerEmail, time.Now())
 	if err != nil {
-		return nil, fmt.Errorf("failed to check booking reference uniqueness: %w", err)
+		return nil, err
 	}
-	if exists {
-		bookingReference = generateBookingReference()
+
+	r.publish(ctx, pubsub.BookingTopic(b.BookingReference), b.BookingReference)
+
+	token, expiresAt, err := r.guestToken(b)
+	if err != nil {
+		return nil, err
 	}
+	return &generated.AcceptWaitlistOfferPayload{Booking: b, Token: token, ExpiresAt: expiresAt}, nil
+}
 
-	// Create booking
-	booking := &model.Booking{
-		ID:               generateUUID(),
-		BookingReference: bookingReference,
-		FlightID:         input.FlightID,
-		FareID:           input.FareID,
-		PassengerName:    input.PassengerName,
-		PassengerEmail:   input.PassengerEmail,
-		BookingStatus:    "confirmed",
-		TotalPrice:       fare.Price,
-		BookedAt:         time.Now(),
+// UpdateFlightStatus is the resolver for the updateFlightStatus field.
+func (r *mutationResolver) UpdateFlightStatus(ctx context.Context, input generated.UpdateFlightStatusInput) (*model.Flight, error) {
+	actor, err := opsActor(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	if input.PassengerPhone != nil {
-		booking.PassengerPhone = *input.PassengerPhone
+	var flight *model.Flight
+	var rebooked *disruption.Result
+	err = r.Store.InTx(ctx, func(tx repository.Store) error {
+		flight, err = tx.Flights().GetForUpdate(ctx, input.FlightID)
+		if err != nil {
+			return err
+		}
+
+		if !flight.Status.CanTransitionTo(input.Status) {
+			return apperror.Conflict("flight %s cannot move from %s to %s", flight.FlightNumber, flight.Status, input.Status)
+		}
+
+		previousStatus := flight.Status
+		flight.Status = input.Status
+		flight.UpdatedAt = time.Now()
+
+		if err := tx.Flights().Update(ctx, flight); err != nil {
+			return err
+		}
+
+		err = tx.FlightEvents().Create(ctx, &model.FlightEvent{
+			FlightID:       flight.ID,
+			EventType:      model.FlightEventTypeStatusChanged,
+			PreviousStatus: &previousStatus,
+			NewStatus:      &flight.Status,
+			Reason:         input.Reason,
+			Actor:          actor,
+		})
+		if err != nil {
+			return err
+		}
+
+		if flight.Status == model.FlightStatusCancelled {
+			rebooked, err = disruption.RebookCancelledFlight(ctx, tx, flight)
+			if err != nil {
+				return err
+			}
+		}
+		if !flight.Status.IsOperable() {
+			if _, err := waitlist.ExpireFlight(ctx, tx, flight.ID, flight.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if input.SeatNumber != nil {
-		booking.SeatNumber = *input.SeatNumber
+
+	r.publish(ctx, pubsub.FlightTopic(flight.ID), flight.ID)
+	if rebooked != nil {
+		for _, reference := range rebooked.BookingReferences {
+			r.publish(ctx, pubsub.BookingTopic(reference), reference)
+		}
 	}
 
-	query := `
-		INSERT INTO bookings (id, booking_reference, flight_id, fare_id, passenger_name, passenger_email,
-			passenger_phone, seat_number, booking_status, total_price, booked_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-	`
-	_, err = tx.ExecContext(ctx, query,
-		booking.ID, booking.BookingReference, booking.FlightID, booking.FareID,
-		booking.PassengerName, booking.PassengerEmail, booking.PassengerPhone,
-		booking.SeatNumber, booking.BookingStatus, booking.TotalPrice, booking.BookedAt,
-	)
+	return flight, nil
+}
+
+// DelayFlight is the resolver for the delayFlight field.
+func (r *mutationResolver) DelayFlight(ctx context.Context, input generated.DelayFlightInput) (*model.Flight, error) {
+	actor, err := opsActor(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create booking: %w", err)
+		return nil, err
 	}
 
-	// Decrement available seats
-	_, err = tx.ExecContext(ctx, "UPDATE fares SET available_seats = available_seats - 1 WHERE id = $1", input.FareID)
+	var flight *model.Flight
+	err = r.Store.InTx(ctx, func(tx repository.Store) error {
+		flight, err = tx.Flights().GetForUpdate(ctx, input.FlightID)
+		if err != nil {
+			return err
+		}
+
+		if !flight.Status.IsOperable() {
+			return apperror.Conflict("flight %s is %s and can no longer be delayed", flight.FlightNumber, flight.Status)
+		}
+
+		// The delay is always measured against the original schedule, so a later
+		// delayFlight call replaces the previous delay instead of adding to it.
+		delay := input.NewDeparture.Sub(flight.DepartureTime)
+		if delay < 0 {
+			return apperror.Validation("new departure must not be before the scheduled departure")
+		}
+
+		flight.DelayMinutes = int(delay.Round(time.Minute) / time.Minute)
+		flight.UpdatedAt = time.Now()
+
+		if err := tx.Flights().Update(ctx, flight); err != nil {
+			return err
+		}
+
+		return tx.FlightEvents().Create(ctx, &model.FlightEvent{
+			FlightID:     flight.ID,
+			EventType:    model.FlightEventTypeDelayed,
+			DelayMinutes: &flight.DelayMinutes,
+			Reason:       input.Reason,
+			Actor:        actor,
+		})
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update available seats: %w", err)
+		return nil, err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	r.publish(ctx, pubsub.FlightTopic(flight.ID), flight.ID)
+
+	return flight, nil
+}
+
+// AssignGate is the resolver for the assignGate field.
+func (r *mutationResolver) AssignGate(ctx context.Context, input generated.AssignGateInput) (*model.Flight, error) {
+	actor, err := opsActor(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return booking, nil
+	gate, err := normalizeGate(input.Gate)
+	if err != nil {
+		return nil, err
+	}
+
+	var flight *model.Flight
+	err = r.Store.InTx(ctx, func(tx repository.Store) error {
+		flight, err = tx.Flights().GetForUpdate(ctx, input.FlightID)
+		if err != nil {
+			return err
+		}
+
+		if !flight.Status.IsOperable() {
+			return apperror.Conflict("flight %s is %s and can no longer change gate", flight.FlightNumber, flight.Status)
+		}
+
+		flight.Gate = &gate
+		flight.UpdatedAt = time.Now()
+
+		if err := tx.Flights().Update(ctx, flight); err != nil {
+			return err
+		}
+
+		return tx.FlightEvents().Create(ctx, &model.FlightEvent{
+			FlightID:  flight.ID,
+			EventType: model.FlightEventTypeGateAssigned,
+			Gate:      &gate,
+			Actor:     actor,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, pubsub.FlightTopic(flight.ID), flight.ID)
+
+	return flight, nil
 }
 
-// Flights is the resolver for the flights field.
-func (r *queryResolver) Flights(ctx context.Context, origin *string, destination *string, limit *int) ([]*model.Flight, error) {
-	query := "SELECT * FROM flights WHERE 1=1"
-	args := []any{}
+// CheckIn is the resolver for the checkIn field.
+func (r *mutationResolver) CheckIn(ctx context.Context, bookingReference string, document *generated.PassengerDocumentInput) (*generated.BoardingPass, error) {
+	now := time.Now()
+	doc, err := parseDocument(document, now)
+	if err != nil {
+		return nil, err
+	}
 
-	if origin != nil {
-		query += " AND origin = ?"
-		args = append(args, *origin)
+	authorized, err := auth.BookingAccess(ctx)
+	if err != nil {
+		return nil, err
 	}
-	if destination != nil {
-		query += " AND destination = ?"
-		args = append(args, *destination)
+
+	result, err := checkin.CheckIn(ctx, r.Store, r.Documents, bookingReference, authorized, doc, now)
+	if err != nil {
+		return nil, err
 	}
 
-	query += " ORDER BY departure_time"
+	pass, err := boardingpass.New(result.Booking, result.Flight, result.Fare)
+	if err != nil {
+		return nil, err
+	}
+
+	token, _, err := r.Auth.BoardingPassToken(result.Booking)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to sign boarding pass token")
+	}
+
+	r.publish(ctx, pubsub.BookingTopic(result.Booking.BookingReference), result.Booking.BookingReference)
+
+	return &generated.BoardingPass{
+		Booking:        result.Booking,
+		Flight:         result.Flight,
+		SeatNumber:     pass.SeatNumber,
+		SequenceNumber: pass.Sequence,
+		Barcode:        pass.BCBP(),
+		DownloadURL:    boardingPassURL(pass.BookingReference, token),
+	}, nil
+}
+
+// AddPassengerDocument is the resolver for the addPassengerDocument field.
+func (r *mutationResolver) AddPassengerDocument(ctx context.Context, bookingReference string, document generated.PassengerDocumentInput) (*model.Booking, error) {
+	now := time.Now()
+	doc, err := parseDocument(&document, now)
+	if err != nil {
+		return nil, err
+	}
+
+	authorized, err := auth.BookingAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := r.Documents.Attach(ctx, r.Store, bookingReference, authorized, doc, now)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, pubsub.BookingTopic(b.BookingReference), b.BookingReference)
 
-	if limit != nil && *limit > 0 {
-		query += " LIMIT ?"
-		args = append(args, *limit)
+	return b, nil
+}
+
+// SetOverbookingLimit is the resolver for the setOverbookingLimit field.
+func (r *mutationResolver) SetOverbookingLimit(ctx context.Context, input generated.SetOverbookingLimitInput) (*model.Flight, error) {
+	flight, err := overbooking.SetLimit(ctx, r.Store, input.FlightID, input.FareClass, overbooking.Limit{
+		Seats:   input.Seats,
+		Percent: input.Percent,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	query = r.DB.Rebind(query)
+	r.publish(ctx, pubsub.FlightTopic(flight.ID), flight.ID)
+
+	return flight, nil
+}
 
-	var flights []*model.Flight
-	if err := r.DB.SelectContext(ctx, &flights, query, args...); err != nil {
+// AddAncillary is the resolver for the addAncillary field.
+func (r *mutationResolver) AddAncillary(ctx context.Context, bookingReference string, productID string, quantity int) (*model.Booking, error) {
+	authorized, err := auth.BookingAccess(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	return flights, nil
+	b, err := ancillary.Add(ctx, r.Store, bookingReference, authorized, productID, quantity, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, pubsub.BookingTopic(b.BookingReference), b.BookingReference)
+
+	return b, nil
+}
+
+// SelectSeat is the resolver for the selectSeat field.
+func (r *mutationResolver) SelectSeat(ctx context.Context, bookingReference string, seatNumber string) (*model.Booking, error) {
+	authorized, err := auth.BookingAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := checkin.SelectSeat(ctx, r.Store, bookingReference, authorized, seatNumber, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, pubsub.BookingTopic(b.BookingReference), b.BookingReference)
+
+	return b, nil
+}
+
+// VolunteerForDeniedBoarding is the resolver for the volunteerForDeniedBoarding field.
+func (r *mutationResolver) VolunteerForDeniedBoarding(ctx context.Context, bookingReference string, volunteer bool) (*model.Booking, error) {
+	authorized, err := auth.BookingAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := overbooking.Volunteer(ctx, r.Store, bookingReference, authorized, volunteer)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, pubsub.BookingTopic(b.BookingReference), b.BookingReference)
+
+	return b, nil
+}
+
+// Flights is the resolver for the flights field.
+func (r *queryResolver) Flights(ctx context.Context, origin *string, destination *string, limit *int) ([]*model.Flight, error) {
+	return r.Store.Flights().List(ctx, repository.FlightFilter{
+		Origin:      origin,
+		Destination: destination,
+		Limit:       querylimit.ListSize(limit),
+	})
 }
 
 // Flight is the resolver for the flight field.
 func (r *queryResolver) Flight(ctx context.Context, id string) (*model.Flight, error) {
-	var flight model.Flight
-	if err := r.DB.GetContext(ctx, &flight, "SELECT * FROM flights WHERE id = $1", id); err != nil {
-		return nil, err
+	flight, err := r.Store.Flights().Get(ctx, id)
+	if apperror.CodeOf(err) == apperror.CodeNotFound {
+		return nil, nil
+	}
+	return flight, err
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
+	claims := auth.ClaimsFrom(ctx)
+	if claims == nil {
+		return nil, nil
 	}
-	return &flight, nil
+	user, err := r.Store.Users().Get(ctx, claims.UserID())
+	if apperror.CodeOf(err) == apperror.CodeNotFound {
+		// The account was deleted after the token was issued.
+		return nil, nil
+	}
+	return user, err
 }
 
 // Booking is the resolver for the booking field.
 func (r *queryResolver) Booking(ctx context.Context, bookingReference string) (*model.Booking, error) {
-	var booking model.Booking
-	if err := r.DB.GetContext(ctx, &booking, "SELECT * FROM bookings WHERE booking_reference = $1", bookingReference); err != nil {
+	authorized, err := auth.BookingAccess(ctx)
+	if err != nil {
 		return nil, err
 	}
-	return &booking, nil
+
+	booking, err := r.Store.Bookings().GetByReference(ctx, bookingReference)
+	if err != nil {
+		if apperror.CodeOf(err) == apperror.CodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Bookings of other passengers look the same as unknown references.
+	claims := auth.ClaimsFrom(ctx)
+	if !authorized(booking) && (claims == nil || !claims.HasRole(model.RoleAgent)) {
+		return nil, nil
+	}
+	return booking, nil
 }
 
 // Bookings is the resolver for the bookings field.
 func (r *queryResolver) Bookings(ctx context.Context, passengerEmail *string, limit *int) ([]*model.Booking, error) {
-	query := "SELECT * FROM bookings WHERE 1=1"
-	args := []any{}
+	claims, err := auth.RequireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.userBookings(ctx, claims.UserID(), passengerEmail, limit)
+}
 
-	if passengerEmail != nil {
-		query += " AND passenger_email = ?"
-		args = append(args, *passengerEmail)
+// Airports is the resolver for the airports field.
+func (r *queryResolver) Airports(ctx context.Context) ([]string, error) {
+	return r.Store.Flights().Airports(ctx)
+}
+
+// DisruptionReport is the resolver for the disruptionReport field.
+func (r *queryResolver) DisruptionReport(ctx context.Context, flightID string) (*generated.DisruptionReport, error) {
+	flight, err := r.Store.Flights().Get(ctx, flightID)
+	if err != nil {
+		if apperror.CodeOf(err) == apperror.CodeNotFound {
+			return nil, nil
+		}
+		return nil, err
 	}
 
-	query += " ORDER BY booked_at DESC"
+	rebookings, err := r.Store.Rebookings().ListByFlight(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
 
-	if limit != nil && *limit > 0 {
-		query += " LIMIT ?"
-		args = append(args, *limit)
+	report := &generated.DisruptionReport{
+		Flight:        flight,
+		TotalAffected: len(rebookings),
+		Rebookings:    rebookings,
+	}
+	for _, rebooking := range rebookings {
+		if rebooking.Outcome == model.RebookingOutcomeRebooked {
+			report.RebookedCount++
+		} else {
+			report.UnaccommodatedCount++
+		}
 	}
 
-	query = r.DB.Rebind(query)
+	return report, nil
+}
 
-	var bookings []*model.Booking
-	if err := r.DB.SelectContext(ctx, &bookings, query, args...); err != nil {
+// DeniedBoardingList is the resolver for the deniedBoardingList field.
+func (r *queryResolver) DeniedBoardingList(ctx context.Context, flightID string) (*generated.DeniedBoardingList, error) {
+	flight, err := r.Store.Flights().Get(ctx, flightID)
+	if err != nil {
+		if apperror.CodeOf(err) == apperror.CodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	list, err := overbooking.ListDeniedBoarding(ctx, r.Store, flight)
+	if err != nil {
 		return nil, err
 	}
 
-	return bookings, nil
+	return &generated.DeniedBoardingList{
+		Flight:        flight,
+		OversoldSeats: list.OversoldSeats,
+		Volunteers:    list.Volunteers,
+		Candidates:    list.Candidates,
+	}, nil
 }
 
-// Airports is the resolver for the airports field.
-func (r *queryResolver) Airports(ctx context.Context) ([]string, error) {
-	query := `
-		SELECT DISTINCT airport
-		FROM (
-			SELECT origin AS airport FROM flights
-			UNION
-			SELECT destination AS airport FROM flights
-		) AS all_airports
-		ORDER BY airport
-	`
+// AncillaryOffers is the resolver for the ancillaryOffers field.
+func (r *queryResolver) AncillaryOffers(ctx context.Context, flightID string) ([]*generated.AncillaryOffer, error) {
+	flight, err := r.Store.Flights().Get(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+
+	offers, err := ancillary.Offers(ctx, r.Store, flight)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*generated.AncillaryOffer, 0, len(offers))
+	for _, offer := range offers {
+		result = append(result, &generated.AncillaryOffer{
+			Product:   offer.Product,
+			Price:     offer.Price,
+			Remaining: offer.Remaining,
+		})
+	}
+	return result, nil
+}
+
+// FlightManifest is the resolver for the flightManifest field.
+func (r *queryResolver) FlightManifest(ctx context.Context, flightID string) (*generated.FlightManifest, error) {
+	m, err := manifest.Load(ctx, r.Store, flightID)
+	if err != nil {
+		if apperror.CodeOf(err) == apperror.CodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &generated.FlightManifest{
+		Flight:      m.Flight,
+		Entries:     m.Entries,
+		DownloadURL: manifestURL(m.Flight.ID),
+	}, nil
+}
+
+// Booking is the resolver for the booking field.
+func (r *rebookingResolver) Booking(ctx context.Context, obj *model.Rebooking) (*model.Booking, error) {
+	result, err := dataloader.FromContext(ctx).BookingLoader.Load(ctx, obj.BookingID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// OriginalFlight is the resolver for the originalFlight field.
+func (r *rebookingResolver) OriginalFlight(ctx context.Context, obj *model.Rebooking) (*model.Flight, error) {
+	result, err := dataloader.FromContext(ctx).FlightLoader.Load(ctx, obj.OriginalFlightID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-	var airports []string
-	if err := r.DB.SelectContext(ctx, &airports, query); err != nil {
+// OriginalFare is the resolver for the originalFare field.
+func (r *rebookingResolver) OriginalFare(ctx context.Context, obj *model.Rebooking) (*model.Fare, error) {
+	result, err := dataloader.FromContext(ctx).FareLoader.Load(ctx, obj.OriginalFareID)()
+	if err != nil {
 		return nil, err
 	}
+	return result, nil
+}
 
-	return airports, nil
+// NewFlight is the resolver for the newFlight field.
+func (r *rebookingResolver) NewFlight(ctx context.Context, obj *model.Rebooking) (*model.Flight, error) {
+	if obj.NewFlightID == nil {
+		return nil, nil
+	}
+	result, err := dataloader.FromContext(ctx).FlightLoader.Load(ctx, *obj.NewFlightID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NewFare is the resolver for the newFare field.
+func (r *rebookingResolver) NewFare(ctx context.Context, obj *model.Rebooking) (*model.Fare, error) {
+	if obj.NewFareID == nil {
+		return nil, nil
+	}
+	result, err := dataloader.FromContext(ctx).FareLoader.Load(ctx, *obj.NewFareID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FlightStatusChanged is the resolver for the flightStatusChanged field.
+func (r *subscriptionResolver) FlightStatusChanged(ctx context.Context, flightID string) (<-chan *model.Flight, error) {
+	loadFlight := func(ctx context.Context) (*model.Flight, error) {
+		return r.Store.Flights().Get(ctx, flightID)
+	}
+
+	if _, err := loadFlight(ctx); err != nil {
+		return nil, err
+	}
+
+	return watch(ctx, r.PubSub, pubsub.FlightTopic(flightID), loadFlight)
+}
+
+// BookingUpdated is the resolver for the bookingUpdated field.
+func (r *subscriptionResolver) BookingUpdated(ctx context.Context, bookingReference string) (<-chan *model.Booking, error) {
+	loadBooking := func(ctx context.Context) (*model.Booking, error) {
+		return r.Store.Bookings().GetByReference(ctx, bookingReference)
+	}
+
+	authorized, err := auth.BookingAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	booking, err := loadBooking(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !authorized(booking) {
+		return nil, apperror.NotFound("booking %s not found", bookingReference)
+	}
+
+	return watch(ctx, r.PubSub, pubsub.BookingTopic(bookingReference), loadBooking)
+}
+
+// Bookings is the resolver for the bookings field.
+func (r *userResolver) Bookings(ctx context.Context, obj *model.User) ([]*model.Booking, error) {
+	return r.userBookings(ctx, obj.ID, nil, nil)
+}
+
+// Fare is the resolver for the fare field.
+func (r *waitlistEntryResolver) Fare(ctx context.Context, obj *model.WaitlistEntry) (*model.Fare, error) {
+	result, err := dataloader.FromContext(ctx).FareLoader.Load(ctx, obj.FareID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Position is the resolver for the position field.
+func (r *waitlistEntryResolver) Position(ctx context.Context, obj *model.WaitlistEntry) (*int, error) {
+	if obj.Status != model.WaitlistStatusWaiting {
+		return nil, nil
+	}
+
+	ahead, err := r.Store.Waitlist().CountWaitingAhead(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	position := ahead + 1
+	return &position, nil
+}
+
+// Booking is the resolver for the booking field.
+func (r *waitlistEntryResolver) Booking(ctx context.Context, obj *model.WaitlistEntry) (*model.Booking, error) {
+	if obj.BookingID == nil {
+		return nil, nil
+	}
+	result, err := dataloader.FromContext(ctx).BookingLoader.Load(ctx, *obj.BookingID)()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // Booking returns generated.BookingResolver implementation.
 func (r *Resolver) Booking() generated.BookingResolver { return &bookingResolver{r} }
 
+// BookingAncillary returns generated.BookingAncillaryResolver implementation.
+func (r *Resolver) BookingAncillary() generated.BookingAncillaryResolver {
+	return &bookingAncillaryResolver{r}
+}
+
 // Fare returns generated.FareResolver implementation.
 func (r *Resolver) Fare() generated.FareResolver { return &fareResolver{r} }
 
@@ -269,25 +951,25 @@ func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResol
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
-type (
-	bookingResolver  struct{ *Resolver }
-	fareResolver     struct{ *Resolver }
-	flightResolver   struct{ *Resolver }
-	mutationResolver struct{ *Resolver }
-	queryResolver    struct{ *Resolver }
-)
+// Rebooking returns generated.RebookingResolver implementation.
+func (r *Resolver) Rebooking() generated.RebookingResolver { return &rebookingResolver{r} }
 
-func generateUUID() string {
-	return uuid.New().String()
-}
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
-func generateBookingReference() string {
-	const charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
-	const length = 7
-	result := make([]byte, length)
-	for i := range result {
-		num, _ := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
-		result[i] = charset[num.Int64()]
-	}
-	return "RDA" + string(result)
-}
+// User returns generated.UserResolver implementation.
+func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
+
+// WaitlistEntry returns generated.WaitlistEntryResolver implementation.
+func (r *Resolver) WaitlistEntry() generated.WaitlistEntryResolver { return &waitlistEntryResolver{r} }
+
+type bookingResolver struct{ *Resolver }
+type bookingAncillaryResolver struct{ *Resolver }
+type fareResolver struct{ *Resolver }
+type flightResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type rebookingResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
+type waitlistEntryResolver struct{ *Resolver }
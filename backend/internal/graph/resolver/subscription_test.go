@@ -0,0 +1,110 @@
+package resolver_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+// subscribe starts a subscription as the holder of token, anonymously when it
+// is empty, and waits until it listens.
+func (e *testEnv) subscribe(token, query string, options ...client.Option) *client.Subscription {
+	e.t.Helper()
+	var payload map[string]any
+	if token != "" {
+		payload = map[string]any{"Authorization": "Bearer " + token}
+	}
+	sub := e.client.WebsocketWithPayload(query, payload, options...)
+	e.t.Cleanup(func() { _ = sub.Close() })
+
+	select {
+	case <-e.subscribed:
+	case <-time.After(5 * time.Second):
+		// A refused subscription has its error waiting to be read.
+		_ = sub.Close()
+		e.t.Fatalf("subscribe: not listening within 5s: %v", sub.Next(&struct{}{}))
+	}
+	return sub
+}
+
+// next decodes the next update of sub into out.
+func (e *testEnv) next(sub *client.Subscription, out any) {
+	e.t.Helper()
+	done := make(chan error, 1)
+	go func() { done <- sub.Next(out) }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			e.t.Fatalf("next: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		_ = sub.Close()
+		e.t.Fatal("next: no update within 5s")
+	}
+}
+
+// expectSubscribeError asserts that the subscription is refused with code.
+func (e *testEnv) expectSubscribeError(token, query string, code apperror.Code, options ...client.Option) {
+	e.t.Helper()
+	var payload map[string]any
+	if token != "" {
+		payload = map[string]any{"Authorization": "Bearer " + token}
+	}
+	sub := e.client.WebsocketWithPayload(query, payload, options...)
+	defer func() { _ = sub.Close() }()
+
+	err := sub.Next(&struct{}{})
+	if err == nil || !strings.Contains(err.Error(), string(code)) {
+		e.t.Fatalf("expected a %s error, got %v", code, err)
+	}
+}
+
+func TestFlightStatusChanged(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	subscription := `subscription($id: ID!) { flightStatusChanged(flightId: $id) { flightNumber status } }`
+	sub := e.subscribe("", subscription, client.Var("id", domesticFlightID))
+
+	e.mustPost(e.opsToken, `mutation($id: ID!) {
+		updateFlightStatus(input: { flightId: $id, status: BOARDING }) { status }
+	}`, nil, client.Var("id", domesticFlightID))
+
+	var update struct {
+		FlightStatusChanged struct{ FlightNumber, Status string }
+	}
+	e.next(sub, &update)
+	if update.FlightStatusChanged.FlightNumber != "RA100" || update.FlightStatusChanged.Status != "BOARDING" {
+		t.Errorf("expected RA100 boarding, got %+v", update.FlightStatusChanged)
+	}
+
+	e.expectSubscribeError("", subscription, apperror.CodeNotFound, client.Var("id", unknownID))
+}
+
+func TestBookingUpdated(t *testing.T) {
+	t.Parallel()
+	e := newEnv(t)
+
+	subscription := `subscription($ref: String!) { bookingUpdated(bookingReference: $ref) { bookingReference bookingStatus } }`
+	sub := e.subscribe(e.customerToken, subscription, client.Var("ref", e.adaRef))
+
+	e.mustPost(e.customerToken, `mutation($ref: String!) { cancelBooking(bookingReference: $ref) { bookingStatus } }`,
+		nil, client.Var("ref", e.adaRef))
+
+	var update struct {
+		BookingUpdated struct{ BookingReference, BookingStatus string }
+	}
+	e.next(sub, &update)
+	if update.BookingUpdated.BookingReference != e.adaRef || update.BookingUpdated.BookingStatus != "CANCELLED" {
+		t.Errorf("expected Ada's booking cancelled, got %+v", update.BookingUpdated)
+	}
+
+	// Only the holder of the booking may follow it.
+	e.expectSubscribeError("", subscription, apperror.CodeUnauthenticated, client.Var("ref", e.adaRef))
+	e.expectSubscribeError(e.customerToken, subscription, apperror.CodeNotFound, client.Var("ref", e.graceRef))
+}
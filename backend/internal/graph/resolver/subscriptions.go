@@ -0,0 +1,45 @@
+package resolver
+
+import (
+	"context"
+	"log"
+
+	"github.com/davidalecrim/red-airlines/internal/pubsub"
+)
+
+// watch subscribes to topic and reloads the entity with load on every
+// notification. Subscribers always receive the current state, never the
+// payload of the notification itself.
+func watch[T any](ctx context.Context, broker pubsub.Broker, topic string, load func(context.Context) (*T, error)) (<-chan *T, error) {
+	messages, err := broker.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan *T, 1)
+	go func() {
+		defer close(updates)
+		for range messages {
+			entity, err := load(ctx)
+			if err != nil {
+				log.Printf("Failed to reload %s for subscription: %v", topic, err)
+				continue
+			}
+			select {
+			case updates <- entity:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// publish notifies subscribers after a committed change. Failures are logged
+// and never fail the mutation that triggered them.
+func (r *Resolver) publish(ctx context.Context, topic, message string) {
+	if err := r.PubSub.Publish(ctx, topic, message); err != nil {
+		log.Printf("Failed to publish %s: %v", topic, err)
+	}
+}
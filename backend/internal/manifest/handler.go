@@ -0,0 +1,54 @@
+package manifest
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// Handler serves GET /flights/{flightId}/manifest?format=csv|pdf.
+func Handler(store repository.Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m, err := Load(r.Context(), store, r.PathValue("flightId"))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var body []byte
+		filename := "manifest-" + m.Flight.FlightNumber
+		switch format := r.URL.Query().Get("format"); format {
+		case "", "csv":
+			body, err = m.CSV()
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			filename += ".csv"
+		case "pdf":
+			body, err = m.PDF()
+			w.Header().Set("Content-Type", "application/pdf")
+			filename += ".pdf"
+		default:
+			err = apperror.Validation("unsupported format %q, use csv or pdf", format)
+		}
+		if err != nil {
+			w.Header().Del("Content-Type")
+			writeError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+		w.Header().Set("Cache-Control", "no-store")
+		if _, err := w.Write(body); err != nil {
+			log.Printf("Failed to write manifest: %v", err)
+		}
+	})
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := apperror.HTTPStatus(err)
+	if status == http.StatusInternalServerError {
+		log.Printf("Manifest request failed: %v", err)
+	}
+	http.Error(w, apperror.PublicMessage(err), status)
+}
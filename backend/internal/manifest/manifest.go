@@ -0,0 +1,87 @@
+package manifest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// Manifest lists who is on a flight, for ground operations.
+type Manifest struct {
+	Flight  *model.Flight
+	Entries []*Entry
+}
+
+// Entry is a booking on the manifest.
+type Entry struct {
+	model.Booking
+	FareClass model.FareClass
+}
+
+// Load builds the manifest of a flight from every booking that is not
+// cancelled, sorted by seat row. Passengers without a seat come last.
+func Load(ctx context.Context, store repository.Store, flightID string) (*Manifest, error) {
+	flight, err := store.Flights().Get(ctx, flightID)
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, err := store.Bookings().ListByFlight(ctx, flight.ID)
+	if err != nil {
+		return nil, err
+	}
+	fares, err := store.Fares().ListByFlight(ctx, flight.ID)
+	if err != nil {
+		return nil, err
+	}
+	classes := make(map[string]model.FareClass, len(fares))
+	for _, fare := range fares {
+		classes[fare.ID] = fare.FareClass
+	}
+
+	var entries []*Entry
+	for _, b := range bookings {
+		if b.BookingStatus != model.BookingStatusCancelled {
+			entries = append(entries, &Entry{Booking: *b, FareClass: classes[b.FareID]})
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b *Entry) int {
+		return cmp.Or(cmp.Compare(a.PassengerName, b.PassengerName), cmp.Compare(a.ID, b.ID))
+	})
+	slices.SortStableFunc(entries, func(a, b *Entry) int {
+		rowA, letterA := splitSeat(a.SeatNumber)
+		rowB, letterB := splitSeat(b.SeatNumber)
+		if c := cmp.Compare(rowA, rowB); c != 0 {
+			return c
+		}
+		return cmp.Compare(letterA, letterB)
+	})
+
+	return &Manifest{Flight: flight, Entries: entries}, nil
+}
+
+// noSeat sorts after every real row.
+const noSeat = int(^uint(0) >> 1)
+
+// splitSeat splits "12A" into 12 and "A".
+func splitSeat(seat *string) (int, string) {
+	if seat == nil {
+		return noSeat, ""
+	}
+	value := strings.ToUpper(strings.TrimSpace(*seat))
+	digits := strings.IndexFunc(value, func(r rune) bool { return r < '0' || r > '9' })
+	if digits == -1 {
+		digits = len(value)
+	}
+	row, err := strconv.Atoi(value[:digits])
+	if err != nil {
+		return noSeat, value
+	}
+	return row, value[digits:]
+}
@@ -0,0 +1,29 @@
+package manifest
+
+import "testing"
+
+func TestSplitSeat(t *testing.T) {
+	seat := func(s string) *string { return &s }
+
+	tests := []struct {
+		name   string
+		seat   *string
+		row    int
+		letter string
+	}{
+		{"seat", seat("12A"), 12, "A"},
+		{"normalized", seat(" 3c "), 3, "C"},
+		{"row only", seat("7"), 7, ""},
+		{"no seat", nil, noSeat, ""},
+		{"no row", seat("A"), noSeat, "A"},
+		{"empty", seat(""), noSeat, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, letter := splitSeat(tt.seat)
+			if row != tt.row || letter != tt.letter {
+				t.Errorf("expected %d %q, got %d %q", tt.row, tt.letter, row, letter)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+)
+
+var columns = []string{"Seat", "Passenger", "Booking reference", "Fare class", "Status", "Special requests"}
+
+func (e *Entry) row() []string {
+	seat := ""
+	if e.SeatNumber != nil {
+		seat = *e.SeatNumber
+	}
+	requests := ""
+	if e.SpecialRequests != nil {
+		requests = *e.SpecialRequests
+	}
+	return []string{seat, e.PassengerName, e.BookingReference, e.FareClass.String(), e.BookingStatus.String(), requests}
+}
+
+// CSV renders the manifest with a header row.
+func (m *Manifest) CSV() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(columns); err != nil {
+		return nil, err
+	}
+	for _, entry := range m.Entries {
+		row := entry.row()
+		for i := range row {
+			row[i] = csvSafe(row[i])
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("render csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// PDF renders a printable landscape A4 manifest.
+func (m *Manifest) PDF() ([]byte, error) {
+	flight := m.Flight
+	widths := []float64{18, 70, 40, 28, 30, 0}
+
+	pdf := fpdf.New("L", "mm", "A4", "")
+	pdf.SetTitle("Manifest "+flight.FlightNumber, false)
+	pdf.SetAutoPageBreak(true, 12)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	header := func() {
+		pdf.SetFont("Helvetica", "B", 9)
+		for i, column := range columns {
+			pdf.CellFormat(widths[i], 7, column, "B", 0, "L", false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+	pdf.SetHeaderFunc(func() {
+		if pdf.PageNo() > 1 {
+			header()
+		}
+	})
+
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, "Red Airlines - Passenger Manifest", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.CellFormat(0, 6, fmt.Sprintf("Flight %s  %s - %s  %s  %d passengers",
+		flight.FlightNumber, flight.Origin, flight.Destination,
+		flight.EstimatedDeparture().Format("Mon 02 Jan 2006 15:04"), len(m.Entries)), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+	header()
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, entry := range m.Entries {
+		for i, value := range entry.row() {
+			pdf.CellFormat(widths[i], 6, tr(value), "", 0, "L", false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("render pdf: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// csvSafe stops spreadsheets from evaluating passenger input as a formula.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,475 @@
+// Package migrate applies the numbered SQL files of the migrations directory
+// and records them in the schema_migrations table.
+//
+// NNN_name.sql applies migration NNN and the optional NNN_name.down.sql
+// reverts it. Each migration runs in its own transaction together with its
+// schema_migrations row, and runners hold a Postgres advisory lock, so
+// servers deploying at the same time apply every migration once. Databases
+// migrated before schema_migrations existed are adopted with Baseline.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migration is one numbered schema change.
+type Migration struct {
+	Version int
+	// Name is the file name of the up migration without .sql, e.g.
+	// 004_flight_operations.
+	Name string
+	Up   string
+	// Down is empty when the migration has no down file.
+	Down string
+	// Checksum is the SHA-256 of Up, stored when the migration is applied
+	// to detect files edited afterwards.
+	Checksum string
+}
+
+// Status describes a migration of the directory or of the database.
+type Status struct {
+	Version int
+	Name    string
+	// AppliedAt is nil for pending migrations.
+	AppliedAt *time.Time
+	// Modified is set when the file changed after the migration was applied.
+	Modified bool
+	// Missing is set when the database has a migration the directory lacks.
+	Missing bool
+}
+
+// ErrModified is returned when an applied migration no longer matches its
+// file, or is not in the directory anymore. Fix the file, or add a new
+// migration, rather than editing one that already ran.
+var ErrModified = errors.New("applied migrations do not match the migration files")
+
+// ErrPending is returned by Check when the database lacks migrations of the
+// directory.
+var ErrPending = errors.New("database schema is behind the migrations")
+
+// ErrUnversioned is returned when migrating a database that has the schema
+// but no schema_migrations rows, as left by the runner that re-ran every file
+// on each start. Record what it applied with Baseline first.
+var ErrUnversioned = errors.New("database has tables but no recorded migrations")
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)(\.down)?\.sql$`)
+
+// lockKey identifies the advisory lock held while migrating.
+const lockKey = "red-airlines:schema_migrations"
+
+// Migrator runs the migrations of a directory against a database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New reads the migrations of fsys, which holds the .sql files at its root.
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Load reads the migrations of fsys ordered by version. Other files are
+// ignored.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations directory: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	downs := map[int]string{}
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: invalid version: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		if match[3] != "" {
+			if _, ok := downs[version]; ok {
+				return nil, fmt.Errorf("migration %d has more than one down file", version)
+			}
+			downs[version] = string(content)
+			continue
+		}
+		if existing, ok := byVersion[version]; ok {
+			return nil, fmt.Errorf("migrations %s and %s share version %d", existing.Name, entry.Name(), version)
+		}
+		sum := sha256.Sum256(content)
+		byVersion[version] = &Migration{
+			Version:  version,
+			Name:     match[1] + "_" + match[2],
+			Up:       string(content),
+			Checksum: hex.EncodeToString(sum[:]),
+		}
+	}
+
+	for version, down := range downs {
+		m, ok := byVersion[version]
+		if !ok {
+			return nil, fmt.Errorf("down migration %d has no up migration", version)
+		}
+		m.Down = down
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int { return a.Version - b.Version })
+	return migrations, nil
+}
+
+// Latest returns the version of the last migration, 0 when there are none.
+func (m *Migrator) Latest() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Up applies the pending migrations in version order. Migrations a newer
+// release applied stay applied.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.locked(ctx, func(conn *sql.Conn, applied map[int]appliedMigration) error {
+		target := m.Latest()
+		if versions := appliedVersions(applied); len(versions) > 0 {
+			target = max(target, versions[len(versions)-1])
+		}
+		return m.migrate(ctx, conn, applied, target)
+	})
+}
+
+// Down reverts the last steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("down needs at least one step, got %d", steps)
+	}
+
+	return m.locked(ctx, func(conn *sql.Conn, applied map[int]appliedMigration) error {
+		versions := appliedVersions(applied)
+		if steps > len(versions) {
+			return fmt.Errorf("cannot revert %d migrations, only %d are applied", steps, len(versions))
+		}
+		target := 0
+		if steps < len(versions) {
+			target = versions[len(versions)-steps-1]
+		}
+		return m.migrate(ctx, conn, applied, target)
+	})
+}
+
+// Goto applies or reverts migrations until version is the last applied one.
+// Version 0 reverts every migration.
+func (m *Migrator) Goto(ctx context.Context, version int) error {
+	if version != 0 && !slices.ContainsFunc(m.migrations, func(mig Migration) bool { return mig.Version == version }) {
+		return fmt.Errorf("migration %d does not exist", version)
+	}
+
+	return m.locked(ctx, func(conn *sql.Conn, applied map[int]appliedMigration) error {
+		return m.migrate(ctx, conn, applied, version)
+	})
+}
+
+// Baseline records the migrations up to version as applied without running
+// them, for databases migrated before schema_migrations existed. It fails
+// once any migration is recorded.
+func (m *Migrator) Baseline(ctx context.Context, version int) error {
+	if !slices.ContainsFunc(m.migrations, func(mig Migration) bool { return mig.Version == version }) {
+		return fmt.Errorf("migration %d does not exist", version)
+	}
+
+	return m.locked(ctx, func(conn *sql.Conn, applied map[int]appliedMigration) error {
+		if len(applied) > 0 {
+			return errors.New("baseline needs a database without recorded migrations")
+		}
+
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = tx.Rollback()
+		}()
+
+		for _, mig := range m.migrations {
+			if mig.Version > version {
+				break
+			}
+			log.Printf("Recording migration as applied: %s", mig.Name)
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)",
+				mig.Version, mig.Name, mig.Checksum)
+			if err != nil {
+				return fmt.Errorf("record migration %s: %w", mig.Name, err)
+			}
+		}
+		return tx.Commit()
+	})
+}
+
+// Status lists the migrations of the directory, followed by the applied
+// migrations missing from it.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close()
+
+	var exists bool
+	if err := conn.QueryRowContext(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("check schema_migrations: %w", err)
+	}
+	applied := map[int]appliedMigration{}
+	if exists {
+		if applied, err = loadApplied(ctx, conn); err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		status := Status{Version: mig.Version, Name: mig.Name}
+		if a, ok := applied[mig.Version]; ok {
+			status.AppliedAt = &a.AppliedAt
+			status.Modified = a.Checksum != mig.Checksum
+			delete(applied, mig.Version)
+		}
+		statuses = append(statuses, status)
+	}
+	for _, version := range appliedVersions(applied) {
+		a := applied[version]
+		statuses = append(statuses, Status{Version: version, Name: a.Name, AppliedAt: &a.AppliedAt, Missing: true})
+	}
+	return statuses, nil
+}
+
+// Check fails with ErrPending when migrations of the directory are not
+// applied, and with ErrModified when applied ones were edited. Applied
+// migrations missing from the directory are accepted, as a newer release
+// may have run them.
+func (m *Migrator) Check(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pending, modified []string
+	for _, s := range statuses {
+		switch {
+		case s.AppliedAt == nil:
+			pending = append(pending, s.Name)
+		case s.Modified:
+			modified = append(modified, s.Name)
+		}
+	}
+	if len(modified) > 0 {
+		return fmt.Errorf("%w: edited after they were applied: %s", ErrModified, strings.Join(modified, ", "))
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: pending %s", ErrPending, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+type appliedMigration struct {
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// locked runs fn on a connection holding the migration lock, after checking
+// the applied migrations against the files.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn, applied map[int]appliedMigration) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close()
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", lockKey).Scan(&acquired); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	if !acquired {
+		log.Println("Waiting for another migration run to finish")
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", lockKey); err != nil {
+			return fmt.Errorf("acquire migration lock: %w", err)
+		}
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", lockKey); err != nil {
+			log.Printf("Failed to release migration lock: %v", err)
+		}
+	}()
+
+	_, err = conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT PRIMARY KEY,
+			name TEXT NOT NULL,
+			checksum CHAR(64) NOT NULL,
+			applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	applied, err := loadApplied(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if err := m.verify(applied); err != nil {
+		return err
+	}
+	return fn(conn, applied)
+}
+
+// verify fails with ErrModified when applied migrations were edited, or have
+// no file while older than the last one. Migrations above Latest have no file
+// when a newer release applied them, which Check accepts too.
+func (m *Migrator) verify(applied map[int]appliedMigration) error {
+	var problems []error
+	for _, version := range appliedVersions(applied) {
+		i := slices.IndexFunc(m.migrations, func(mig Migration) bool { return mig.Version == version })
+		switch {
+		case i < 0 && version > m.Latest():
+			continue
+		case i < 0:
+			problems = append(problems, fmt.Errorf("%s is applied but has no file", applied[version].Name))
+		case m.migrations[i].Checksum != applied[version].Checksum:
+			problems = append(problems, fmt.Errorf("%s was edited after it was applied", m.migrations[i].Name))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %w", ErrModified, errors.Join(problems...))
+	}
+	return nil
+}
+
+// migrate reverts the applied migrations after target, newest first, then
+// applies the pending ones up to target.
+func (m *Migrator) migrate(ctx context.Context, conn *sql.Conn, applied map[int]appliedMigration, target int) error {
+	// Only the release that applied a migration has its down file.
+	for _, version := range appliedVersions(applied) {
+		if version > target && version > m.Latest() {
+			return fmt.Errorf("%s was applied by a newer release, revert it with that release first", applied[version].Name)
+		}
+	}
+
+	var down, up []Migration
+	for _, mig := range m.migrations {
+		_, isApplied := applied[mig.Version]
+		switch {
+		case isApplied && mig.Version > target:
+			if mig.Down == "" {
+				return fmt.Errorf("migration %s has no down file", mig.Name)
+			}
+			down = append(down, mig)
+		case !isApplied && mig.Version <= target:
+			up = append(up, mig)
+		}
+	}
+	slices.Reverse(down)
+
+	// The old runner left no record, and re-running its migrations would
+	// undo the later ones, such as 003 dropping the statuses 005 allows.
+	if len(applied) == 0 && len(up) > 0 {
+		var legacy bool
+		if err := conn.QueryRowContext(ctx, "SELECT to_regclass('flights') IS NOT NULL").Scan(&legacy); err != nil {
+			return fmt.Errorf("check for an unversioned schema: %w", err)
+		}
+		if legacy {
+			return fmt.Errorf("%w: run `migrate baseline VERSION` with the last migration it has", ErrUnversioned)
+		}
+	}
+
+	for _, mig := range down {
+		log.Printf("Reverting migration: %s", mig.Name)
+		err := inTx(ctx, conn, mig.Down, "DELETE FROM schema_migrations WHERE version = $1", mig.Version)
+		if err != nil {
+			return fmt.Errorf("revert migration %s: %w", mig.Name, err)
+		}
+	}
+	for _, mig := range up {
+		log.Printf("Running migration: %s", mig.Name)
+		err := inTx(ctx, conn, mig.Up,
+			"INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)",
+			mig.Version, mig.Name, mig.Checksum)
+		if err != nil {
+			return fmt.Errorf("migration %s failed: %w", mig.Name, err)
+		}
+	}
+	return nil
+}
+
+// inTx runs script, then record with args, in one transaction.
+func inTx(ctx context.Context, conn *sql.Conn, script, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func loadApplied(ctx context.Context, conn *sql.Conn) (map[int]appliedMigration, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, name, checksum, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("load schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[int]appliedMigration{}
+	for rows.Next() {
+		var version int
+		var a appliedMigration
+		if err := rows.Scan(&version, &a.Name, &a.Checksum, &a.AppliedAt); err != nil {
+			return nil, fmt.Errorf("load schema_migrations: %w", err)
+		}
+		applied[version] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("load schema_migrations: %w", err)
+	}
+	return applied, nil
+}
+
+func appliedVersions(applied map[int]appliedMigration) []int {
+	return slices.Sorted(maps.Keys(applied))
+}
@@ -0,0 +1,136 @@
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	shipped "github.com/davidalecrim/red-airlines/migrations"
+)
+
+func TestLoad(t *testing.T) {
+	file := func(content string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(content)} }
+
+	fsys := fstest.MapFS{
+		"010_bookings.sql":      file("CREATE TABLE bookings ();"),
+		"002_flights.sql":       file("CREATE TABLE flights ();"),
+		"002_flights.down.sql":  file("DROP TABLE flights;"),
+		"001_init.sql":          file("SELECT 1;"),
+		"README.md":             file("notes"),
+		"003_notes.txt":         file("not a migration"),
+		"archive/004_old.sql":   file("SELECT 4;"),
+		"005-invalid-name.sql":  file("SELECT 5;"),
+		"010_bookings.down.sql": file("DROP TABLE bookings;"),
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	want := []struct {
+		version int
+		name    string
+		down    string
+	}{
+		{1, "001_init", ""},
+		{2, "002_flights", "DROP TABLE flights;"},
+		{10, "010_bookings", "DROP TABLE bookings;"},
+	}
+	if len(migrations) != len(want) {
+		t.Fatalf("expected %d migrations, got %+v", len(want), migrations)
+	}
+	for i, w := range want {
+		m := migrations[i]
+		if m.Version != w.version || m.Name != w.name || m.Down != w.down {
+			t.Errorf("migration %d: expected %d %s down %q, got %d %s down %q", i, w.version, w.name, w.down, m.Version, m.Name, m.Down)
+		}
+	}
+	sum := sha256.Sum256([]byte("SELECT 1;"))
+	if got := migrations[0].Checksum; got != hex.EncodeToString(sum[:]) {
+		t.Errorf("expected the SHA-256 of the up file, got %q", got)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	file := &fstest.MapFile{Data: []byte("SELECT 1;")}
+
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		want string
+	}{
+		{"shared version", fstest.MapFS{"001_a.sql": file, "1_b.sql": file}, "share version 1"},
+		{"down without up", fstest.MapFS{"001_a.sql": file, "002_b.down.sql": file}, "down migration 2 has no up migration"},
+		{"two downs", fstest.MapFS{"001_a.sql": file, "001_a.down.sql": file, "01_a.down.sql": file}, "more than one down file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.fsys)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected an error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLoadShippedMigrations(t *testing.T) {
+	migrations, err := Load(shipped.FS)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			t.Errorf("expected version %d, got %s", i+1, m.Name)
+		}
+		if m.Down == "" {
+			t.Errorf("migration %s has no down file", m.Name)
+		}
+	}
+}
+
+// TestShippedMigrationsUnchanged pins the checksum of every shipped
+// migration, since databases refuse files edited after they ran. Fix a
+// migration with a new one, as 017 does for the constraints of 003, and add
+// a row for each new file.
+func TestShippedMigrationsUnchanged(t *testing.T) {
+	migrations, err := Load(shipped.FS)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	want := []struct {
+		name     string
+		checksum string
+	}{
+		{"001_initial_schema", "38b9e31cbd42f5e3d59a3c6f9cb9ba5a8a5c9426a01f6f048d88bb8330e2c2e8"},
+		{"002_update_flight_status_case", "9b14b40c93813b34181e5e6ba7f86873778bd5709d048b4fbffd2fa26f7b9f46"},
+		{"003_status_and_fare_class_constraints", "a7d1f03e30e4f26b82c7d31fa08450f952b0f464a42676592f038697a9100de5"},
+		{"004_flight_operations", "6cb46a94ce867dbb469b14798e46875608072c2de8cd11565b1416248edb23d2"},
+		{"005_flight_disruptions", "c21ed0fc5368b1e08118113e5dc4df5017f184b33a4d8f423c506e380e7a9625"},
+		{"006_check_in", "d9df6c9e541e61dc9a887237f55dff13a2a0fb7989d31deb9535a0687f844d74"},
+		{"007_waitlist", "ef1fac5dfe552d57abf26c8f067b374848b5909911d63be08e68c2440759cc0f"},
+		{"008_overbooking", "70d292f6012425a111e8f3fbc80c7e82c6e58e26a1af049c4a7a1860484cb7e0"},
+		{"009_ancillaries", "807fa52c8131a87d9f8fabbacfec33860537892e20a03b96f7cb3388b11034d8"},
+		{"010_passenger_documents", "d861de153223e337e98ca16fe84b7ac063c40021958592a221fb5c0c0ba9e4ea"},
+		{"011_special_requests", "a63b42f1edbb897c079c25bc0b8932be112b2ca582fb6711127977b02eb59a12"},
+		{"012_users", "97f2958ea161f5512426f6a48b0ee1ee64ae68fef985c64ef876ed7322d33e13"},
+		{"013_user_roles", "0a4487d0c574d9b2c117f93f7467200ab699db4bda50a076bc908a95f4ec7eb3"},
+		{"014_rate_limits", "540e7223435e5e80314140ce05fc17035758f0b9d6c4f04851f7c686756fb13f"},
+		{"015_persisted_queries", "4480e5f70ee4268ed4d11a0823be4c6638bdcb27ba7b429826edb89454194c91"},
+		{"016_persisted_queries_last_used", "a3bb2e8274edae0ed787ea36c6b04e67b7705acc1da10ba46385cba64f150cbb"},
+		{"017_status_constraints", "ed12024dc289a8c96ba29c355da84b2eb5c0155fedd9891c2eb3194226d2b0f0"},
+		{"018_waitlist_user", "f7f5409dfb0bb3f5f5a8c61a9dc08f32fc8ec262118354b1a698ca95a87c95e9"},
+	}
+	if len(migrations) < len(want) {
+		t.Fatalf("expected at least %d migrations, got %d", len(want), len(migrations))
+	}
+	for i, w := range want {
+		m := migrations[i]
+		if m.Name != w.name || m.Checksum != w.checksum {
+			t.Errorf("migration %d: expected %s with checksum %s, got %s with %s", i+1, w.name, w.checksum, m.Name, m.Checksum)
+		}
+	}
+}
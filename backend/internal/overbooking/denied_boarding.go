@@ -0,0 +1,118 @@
+package overbooking
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// DeniedBoarding is the list gate agents work through when more passengers
+// hold a seat than the aircraft has.
+type DeniedBoarding struct {
+	OversoldSeats int
+	// Volunteers are asked first, in booking order.
+	Volunteers []*model.Booking
+	// Candidates are denied boarding involuntarily, in this order, once the
+	// volunteers are exhausted.
+	Candidates []*model.Booking
+}
+
+type seatHolder struct {
+	*model.Booking
+	fareClass model.FareClass
+}
+
+// ListDeniedBoarding builds the denied-boarding list of flight. Involuntary
+// candidates are ordered by: not checked in first, lowest fare class first,
+// then latest booking first.
+func ListDeniedBoarding(ctx context.Context, store repository.Store, flight *model.Flight) (*DeniedBoarding, error) {
+	bookings, err := store.Bookings().ListByFlight(ctx, flight.ID)
+	if err != nil {
+		return nil, err
+	}
+	fares, err := store.Fares().ListByFlight(ctx, flight.ID)
+	if err != nil {
+		return nil, err
+	}
+	classes := make(map[string]model.FareClass, len(fares))
+	for _, fare := range fares {
+		classes[fare.ID] = fare.FareClass
+	}
+
+	var holders []*seatHolder
+	for _, b := range bookings {
+		if b.BookingStatus.HoldsSeat() {
+			holders = append(holders, &seatHolder{Booking: b, fareClass: classes[b.FareID]})
+		}
+	}
+
+	list := &DeniedBoarding{
+		OversoldSeats: max(0, len(holders)-flight.TotalSeats),
+		Volunteers:    []*model.Booking{},
+		Candidates:    []*model.Booking{},
+	}
+
+	var candidates []*seatHolder
+	for _, holder := range holders {
+		if holder.DeniedBoardingVolunteer {
+			list.Volunteers = append(list.Volunteers, holder.Booking)
+		} else {
+			candidates = append(candidates, holder)
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b *seatHolder) int {
+		if checkedIn(a) != checkedIn(b) {
+			if checkedIn(a) {
+				return 1
+			}
+			return -1
+		}
+		if c := a.fareClass.Rank() - b.fareClass.Rank(); c != 0 {
+			return c
+		}
+		return b.BookedAt.Compare(a.BookedAt)
+	})
+	for _, candidate := range candidates {
+		list.Candidates = append(list.Candidates, candidate.Booking)
+	}
+
+	return list, nil
+}
+
+func checkedIn(holder *seatHolder) bool {
+	return holder.BookingStatus == model.BookingStatusCheckedIn
+}
+
+// Volunteer records whether the passenger of bookingReference is willing to
+// give up their seat. authorized decides whether the caller may change the
+// booking.
+func Volunteer(ctx context.Context, store repository.Store, bookingReference string, authorized func(*model.Booking) bool, volunteer bool) (*model.Booking, error) {
+	var booking *model.Booking
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		var err error
+		booking, err = tx.Bookings().GetByReferenceForUpdate(ctx, bookingReference)
+		if err != nil && apperror.CodeOf(err) != apperror.CodeNotFound {
+			return err
+		}
+		if err != nil || !authorized(booking) {
+			return apperror.NotFound("booking %s not found", bookingReference)
+		}
+
+		if !booking.BookingStatus.HoldsSeat() {
+			return apperror.Conflict("booking is %s and holds no seat", booking.BookingStatus)
+		}
+
+		booking.DeniedBoardingVolunteer = volunteer
+		booking.UpdatedAt = time.Now()
+		return tx.Bookings().Update(ctx, booking)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
@@ -0,0 +1,103 @@
+package overbooking
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// Limit is an overbooking limit given either as Seats or as a Percent of the
+// flight's total seats. Both nil clears the limit.
+type Limit struct {
+	Seats   *int
+	Percent *float64
+}
+
+func (l Limit) validate() error {
+	if l.Seats != nil && l.Percent != nil {
+		return apperror.Validation("set either seats or percent, not both")
+	}
+	if l.Seats != nil && *l.Seats < 0 {
+		return apperror.Validation("seats must not be negative")
+	}
+	if l.Percent != nil && (*l.Percent < 0 || *l.Percent > 100) {
+		return apperror.Validation("percent must be between 0 and 100")
+	}
+	return nil
+}
+
+// CanSell returns a SOLD_OUT error when fare cannot sell one more seat. A fare
+// sells its allocation first and then up to its overbooking allowance, as long
+// as the flight stays within its own limit. Callers should hold a lock on the
+// flight so concurrent bookings cannot both take the last oversold seat.
+func CanSell(ctx context.Context, bookings repository.BookingRepository, flight *model.Flight, fare *model.Fare) error {
+	if fare.AvailableSeats > 0 {
+		return nil
+	}
+	if fare.OversoldSeats() >= fare.OverbookingAllowance(flight) {
+		return apperror.SoldOut("no available seats for this fare")
+	}
+
+	allowance, ok := flight.OverbookingAllowance()
+	if !ok {
+		return nil
+	}
+	booked, err := bookings.CountHeldSeats(ctx, flight.ID)
+	if err != nil {
+		return err
+	}
+	if booked >= flight.TotalSeats+allowance {
+		return apperror.SoldOut("flight %s reached its overbooking limit", flight.FlightNumber)
+	}
+	return nil
+}
+
+// SetLimit sets the overbooking limit of a flight or, when fareClass is given,
+// of a single fare of the flight.
+func SetLimit(ctx context.Context, store repository.Store, flightID string, fareClass *model.FareClass, limit Limit) (*model.Flight, error) {
+	if err := limit.validate(); err != nil {
+		return nil, err
+	}
+
+	var flight *model.Flight
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		// Locking the flight serializes the change with bookings, which check
+		// the limits under the same lock, and with other status updates that
+		// save the whole flight.
+		var err error
+		flight, err = tx.Flights().GetForUpdate(ctx, flightID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+
+		if fareClass == nil {
+			flight.OverbookingSeats = limit.Seats
+			flight.OverbookingPercent = limit.Percent
+			flight.UpdatedAt = now
+			return tx.Flights().Update(ctx, flight)
+		}
+
+		fares, err := tx.Fares().ListByFlight(ctx, flight.ID)
+		if err != nil {
+			return err
+		}
+		i := slices.IndexFunc(fares, func(f *model.Fare) bool { return f.FareClass == *fareClass })
+		if i < 0 {
+			return apperror.NotFound("flight %s has no %s fare", flight.FlightNumber, *fareClass)
+		}
+		fare := fares[i]
+		fare.OverbookingSeats = limit.Seats
+		fare.OverbookingPercent = limit.Percent
+		fare.UpdatedAt = now
+		return tx.Fares().Update(ctx, fare)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return flight, nil
+}
@@ -0,0 +1,58 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+const subscriberBuffer = 16
+
+// MemoryHub is an in-process Broker. It only reaches subscribers connected to
+// the same server instance.
+type MemoryHub struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan string]struct{}
+}
+
+func NewMemoryHub() *MemoryHub {
+	return &MemoryHub{subscribers: make(map[string]map[chan string]struct{})}
+}
+
+// Publish never blocks: when a subscriber's buffer is full the message is
+// dropped, which is safe because the queued messages already trigger a reload.
+func (h *MemoryHub) Publish(_ context.Context, topic, message string) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers[topic] {
+		select {
+		case ch <- message:
+		default:
+		}
+	}
+	return nil
+}
+
+func (h *MemoryHub) Subscribe(ctx context.Context, topic string) (<-chan string, error) {
+	ch := make(chan string, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[topic] == nil {
+		h.subscribers[topic] = make(map[chan string]struct{})
+	}
+	h.subscribers[topic][ch] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		delete(h.subscribers[topic], ch)
+		if len(h.subscribers[topic]) == 0 {
+			delete(h.subscribers, topic)
+		}
+		h.mu.Unlock()
+		close(ch)
+	}()
+
+	return ch, nil
+}
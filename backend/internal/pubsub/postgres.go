@@ -0,0 +1,69 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const notifyChannel = "red_airlines_events"
+
+type notification struct {
+	Topic   string `json:"topic"`
+	Message string `json:"message"`
+}
+
+// PostgresHub is a Broker backed by LISTEN/NOTIFY so that every server
+// instance connected to the same database receives every message. Messages
+// published while the listener is reconnecting are lost.
+type PostgresHub struct {
+	db       *sqlx.DB
+	listener *pq.Listener
+	local    *MemoryHub
+}
+
+func NewPostgresHub(db *sqlx.DB, listener *pq.Listener) (*PostgresHub, error) {
+	if err := listener.Listen(notifyChannel); err != nil {
+		return nil, err
+	}
+
+	h := &PostgresHub{db: db, listener: listener, local: NewMemoryHub()}
+	go h.dispatch()
+	return h, nil
+}
+
+func (h *PostgresHub) Publish(ctx context.Context, topic, message string) error {
+	payload, err := json.Marshal(notification{Topic: topic, Message: message})
+	if err != nil {
+		return err
+	}
+	_, err = h.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", notifyChannel, string(payload))
+	return err
+}
+
+func (h *PostgresHub) Subscribe(ctx context.Context, topic string) (<-chan string, error) {
+	return h.local.Subscribe(ctx, topic)
+}
+
+func (h *PostgresHub) Close() error {
+	return h.listener.Close()
+}
+
+func (h *PostgresHub) dispatch() {
+	for n := range h.listener.Notify {
+		// A nil notification signals that the connection was re-established.
+		if n == nil {
+			continue
+		}
+
+		var msg notification
+		if err := json.Unmarshal([]byte(n.Extra), &msg); err != nil {
+			log.Printf("Discarding malformed notification: %v", err)
+			continue
+		}
+		_ = h.local.Publish(context.Background(), msg.Topic, msg.Message)
+	}
+}
@@ -0,0 +1,21 @@
+package pubsub
+
+import "context"
+
+// Broker fans out change notifications to subscribers. Messages are only
+// signals (an entity key); subscribers reload the current state themselves, so
+// a dropped or coalesced message never leaves a client with stale data for long.
+type Broker interface {
+	Publish(ctx context.Context, topic, message string) error
+	// Subscribe returns a channel that receives messages published to topic
+	// until ctx is cancelled, after which the channel is closed.
+	Subscribe(ctx context.Context, topic string) (<-chan string, error)
+}
+
+func FlightTopic(flightID string) string {
+	return "flight:" + flightID
+}
+
+func BookingTopic(bookingReference string) string {
+	return "booking:" + bookingReference
+}
@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+)
+
+// GuardTokens wraps auth.Middleware, which answers 401 to an invalid bearer
+// token before Middleware can charge the request. Every rejected token is
+// charged to the InvalidTokens budget of the client IP; once it is spent,
+// requests carrying a token are refused with 429 before the token is checked.
+// Requests without an Authorization header pass through.
+func (l *Limiter) GuardTokens(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		client := "ip:" + l.clientIP(r)
+		if result := l.Peek(r.Context(), client, InvalidTokens); !result.Allowed {
+			reject(w, result.RetryAfter)
+			http.Error(w, "too many invalid tokens", http.StatusTooManyRequests)
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(recorder, r)
+		if recorder.status == http.StatusUnauthorized {
+			l.Take(r.Context(), client, InvalidTokens)
+		}
+	})
+}
+
+// statusRecorder remembers the status code written through it. It passes
+// Hijack on so WebSocket upgrades still work.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(r.ResponseWriter).Hijack()
+}
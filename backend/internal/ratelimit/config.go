@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Config is read from the environment:
+//
+//	RATE_LIMIT_QUERIES_PER_MINUTE, RATE_LIMIT_QUERIES_BURST (300, 100)
+//	RATE_LIMIT_MUTATIONS_PER_MINUTE, RATE_LIMIT_MUTATIONS_BURST (60, 20)
+//	RATE_LIMIT_INVALID_TOKENS_PER_MINUTE, RATE_LIMIT_INVALID_TOKENS_BURST (10, 10)
+//	RATE_LIMIT_API_KEYS     comma-separated keys that get their own budget
+//	RATE_LIMIT_TRUST_PROXY  "true" to take the client IP from X-Forwarded-For
+//
+// A per-minute value of 0 disables that budget.
+type Config struct {
+	Queries       Limit
+	Mutations     Limit
+	InvalidTokens Limit
+	APIKeys       map[string]bool
+	TrustProxy    bool
+}
+
+func ConfigFromEnv() (Config, error) {
+	var (
+		config = Config{APIKeys: map[string]bool{}}
+		err    error
+	)
+
+	if config.Queries, err = limitFromEnv("QUERIES", Limit{PerMinute: 300, Burst: 100}); err != nil {
+		return Config{}, err
+	}
+	if config.Mutations, err = limitFromEnv("MUTATIONS", Limit{PerMinute: 60, Burst: 20}); err != nil {
+		return Config{}, err
+	}
+	if config.InvalidTokens, err = limitFromEnv("INVALID_TOKENS", Limit{PerMinute: 10, Burst: 10}); err != nil {
+		return Config{}, err
+	}
+
+	for _, key := range strings.Split(os.Getenv("RATE_LIMIT_API_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			config.APIKeys[key] = true
+		}
+	}
+
+	if value := os.Getenv("RATE_LIMIT_TRUST_PROXY"); value != "" {
+		if config.TrustProxy, err = strconv.ParseBool(value); err != nil {
+			return Config{}, fmt.Errorf("RATE_LIMIT_TRUST_PROXY: %w", err)
+		}
+	}
+
+	return config, nil
+}
+
+func limitFromEnv(name string, defaults Limit) (Limit, error) {
+	limit := defaults
+	for env, target := range map[string]*int{
+		"RATE_LIMIT_" + name + "_PER_MINUTE": &limit.PerMinute,
+		"RATE_LIMIT_" + name + "_BURST":      &limit.Burst,
+	} {
+		value := os.Getenv(env)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return Limit{}, fmt.Errorf("%s must be a non-negative integer", env)
+		}
+		*target = n
+	}
+	if !limit.disabled() && limit.Burst < 1 {
+		return Limit{}, fmt.Errorf("RATE_LIMIT_%s_BURST must be at least 1", name)
+	}
+	return limit, nil
+}
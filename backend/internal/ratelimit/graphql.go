@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+)
+
+type clientKey struct{}
+
+type client struct {
+	key string
+	ip  string
+	// apiKey is set when the request sent a registered API key, even if
+	// it is charged to the signed-in user.
+	apiKey bool
+	// reject turns the HTTP response into a 429. It is nil for WebSocket
+	// connections, whose response has already been hijacked.
+	reject func(retryAfter time.Duration)
+}
+
+// Middleware identifies the client of a GraphQL request for Extension, which
+// charges it once the operation type is known. It must run after
+// auth.Middleware.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, apiKey := l.apiKey(r)
+		c := &client{key: l.clientKey(r), ip: l.clientIP(r), apiKey: apiKey}
+		if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			c.reject = func(retryAfter time.Duration) {
+				reject(w, retryAfter)
+				w.WriteHeader(http.StatusTooManyRequests)
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientKey{}, c)))
+	})
+}
+
+// Extension charges mutations to the mutation budget and queries and
+// subscriptions to the query budget. Persisted queries are charged after they
+// are resolved, so a hash cannot hide a mutation.
+type Extension struct {
+	Limiter *Limiter
+}
+
+var (
+	_ graphql.HandlerExtension     = Extension{}
+	_ graphql.OperationInterceptor = Extension{}
+)
+
+func (Extension) ExtensionName() string {
+	return "RateLimit"
+}
+
+func (Extension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (e Extension) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	c, ok := ctx.Value(clientKey{}).(*client)
+	if !ok {
+		return next(ctx)
+	}
+
+	kind := Queries
+	if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Mutation {
+		kind = Mutations
+	}
+
+	result := e.Limiter.Take(ctx, c.key, kind)
+	if result.Allowed {
+		return next(ctx)
+	}
+
+	if c.reject != nil {
+		c.reject(result.RetryAfter)
+	}
+	return graphql.OneShot(&graphql.Response{
+		Errors: gqlerror.List{{
+			Message: "rate limit exceeded, retry later",
+			Extensions: map[string]any{
+				"code":       apperror.CodeRateLimited,
+				"retryAfter": retryAfterSeconds(result.RetryAfter),
+			},
+		}},
+	})
+}
+
+// ClientIP returns the IP address of the client of the request of ctx, or ""
+// outside of Middleware.
+func ClientIP(ctx context.Context) string {
+	c, ok := ctx.Value(clientKey{}).(*client)
+	if !ok {
+		return ""
+	}
+	return c.ip
+}
+
+// HasAPIKey reports whether the request of ctx sent a registered API key,
+// whether or not it was also signed in.
+func HasAPIKey(ctx context.Context) bool {
+	c, ok := ctx.Value(clientKey{}).(*client)
+	return ok && c.apiKey
+}
@@ -0,0 +1,143 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/auth"
+)
+
+// Kind selects the budget a request is charged to.
+type Kind string
+
+const (
+	Queries   Kind = "query"
+	Mutations Kind = "mutation"
+	// InvalidTokens counts the bearer tokens auth.Middleware rejected, per
+	// IP address.
+	InvalidTokens Kind = "invalid_token"
+)
+
+const apiKeyPrefix = "key:"
+
+// Limiter charges requests to per-client token buckets. Clients are
+// identified by user, then by a configured API key, then by IP address.
+type Limiter struct {
+	store  Store
+	config Config
+	now    func() time.Time
+}
+
+func New(store Store, config Config) *Limiter {
+	return &Limiter{store: store, config: config, now: time.Now}
+}
+
+// Take charges a request of kind to client. Store failures are logged and
+// let the request through, so an outage of the store does not take the API
+// down with it.
+func (l *Limiter) Take(ctx context.Context, client string, kind Kind) Result {
+	return l.charge(ctx, client, kind, l.store.Take)
+}
+
+// Peek reports whether Take would let a request of kind through, without
+// charging client.
+func (l *Limiter) Peek(ctx context.Context, client string, kind Kind) Result {
+	return l.charge(ctx, client, kind, l.store.Peek)
+}
+
+func (l *Limiter) charge(ctx context.Context, client string, kind Kind,
+	op func(ctx context.Context, key string, limit Limit, now time.Time) (Result, error),
+) Result {
+	limit := l.limit(kind)
+	if limit.disabled() {
+		return Result{Allowed: true}
+	}
+
+	result, err := op(ctx, string(kind)+":"+client, limit, l.now())
+	if err != nil {
+		log.Printf("Rate limiter unavailable, allowing request: %v", err)
+		return Result{Allowed: true}
+	}
+	return result
+}
+
+func (l *Limiter) limit(kind Kind) Limit {
+	switch kind {
+	case Mutations:
+		return l.config.Mutations
+	case InvalidTokens:
+		return l.config.InvalidTokens
+	default:
+		return l.config.Queries
+	}
+}
+
+// Handler charges every request to next to the budget of kind and answers
+// 429 once it is spent.
+func (l *Limiter) Handler(kind Kind, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		result := l.Take(r.Context(), l.clientKey(r), kind)
+		if !result.Allowed {
+			reject(w, result.RetryAfter)
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientKey identifies the client of r. It must run after auth.Middleware.
+func (l *Limiter) clientKey(r *http.Request) string {
+	if claims := auth.ClaimsFrom(r.Context()); claims != nil {
+		return "user:" + claims.UserID()
+	}
+	if id, ok := l.apiKey(r); ok {
+		return apiKeyPrefix + id
+	}
+	return "ip:" + l.clientIP(r)
+}
+
+// apiKey returns a short hash of the registered API key sent with r, and
+// whether there is one. Signed-in clients may send a key too.
+func (l *Limiter) apiKey(r *http.Request) (string, bool) {
+	key := r.Header.Get("X-API-Key")
+	if key == "" || !l.config.APIKeys[key] {
+		return "", false
+	}
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8]), true
+}
+
+// clientIP returns the address of the client. Behind a trusted proxy it is
+// the last X-Forwarded-For entry, the one added by the proxy itself; earlier
+// entries are set by the client and cannot be trusted.
+func (l *Limiter) clientIP(r *http.Request) string {
+	if l.config.TrustProxy {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			hops := strings.Split(forwarded, ",")
+			return strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// reject sets the headers of a 429 response.
+func reject(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(retryAfter)))
+}
+
+func retryAfterSeconds(d time.Duration) int {
+	return max(1, int(math.Ceil(d.Seconds())))
+}
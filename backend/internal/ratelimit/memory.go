@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// pruneThreshold bounds the number of buckets before idle ones are dropped.
+const pruneThreshold = 10_000
+
+// MemoryStore is an in-process Store. Each server instance keeps its own
+// buckets, so clients get the budget once per instance.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+}
+
+type memoryBucket struct {
+	bucket
+	limit Limit
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket)}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.buckets) >= pruneThreshold {
+		s.prune(now)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &memoryBucket{bucket: newBucket(limit, now), limit: limit}
+		s.buckets[key] = b
+	}
+	return b.take(limit, now), nil
+}
+
+func (s *MemoryStore) Peek(_ context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		return Result{Allowed: true}, nil
+	}
+	return b.peek(limit, now), nil
+}
+
+func (s *MemoryStore) prune(now time.Time) {
+	for key, b := range s.buckets {
+		if now.Sub(b.UpdatedAt) >= b.limit.idleAfter() {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// PostgresStore keeps buckets in rate_limit_buckets so every server instance
+// shares the same budget. Each Take is a short transaction holding the row
+// lock of the client's bucket.
+type PostgresStore struct {
+	db *sqlx.DB
+}
+
+func NewPostgresStore(db *sqlx.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return Result{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// The no-op update locks an existing bucket and returns it unchanged.
+	initial := newBucket(limit, now)
+	var b bucket
+	err = tx.GetContext(ctx, &b, `
+		INSERT INTO rate_limit_buckets AS b (key, tokens, updated_at, idle_at)
+		VALUES ($1, $2, $3, $3)
+		ON CONFLICT (key) DO UPDATE SET tokens = b.tokens
+		RETURNING tokens, updated_at
+	`, key, initial.Tokens, initial.UpdatedAt)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := b.take(limit, now)
+	_, err = tx.ExecContext(ctx, `
+		UPDATE rate_limit_buckets SET tokens = $1, updated_at = $2, idle_at = $3 WHERE key = $4
+	`, b.Tokens, b.UpdatedAt, now.Add(limit.idleAfter()), key)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result, tx.Commit()
+}
+
+func (s *PostgresStore) Peek(ctx context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	var b bucket
+	err := s.db.GetContext(ctx, &b, "SELECT tokens, updated_at FROM rate_limit_buckets WHERE key = $1", key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Result{Allowed: true}, nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+	return b.peek(limit, now), nil
+}
+
+// Prune deletes the buckets that have refilled completely.
+func (s *PostgresStore) Prune(ctx context.Context, now time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM rate_limit_buckets WHERE idle_at <= $1", now)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// RunPrune calls Prune every interval until ctx is cancelled.
+func (s *PostgresStore) RunPrune(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := s.Prune(ctx, now); err != nil {
+				log.Printf("Failed to prune rate limit buckets: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit configures a token bucket: Burst requests at once, refilled at
+// PerMinute requests per minute. A zero PerMinute disables the limit.
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+func (l Limit) disabled() bool {
+	return l.PerMinute <= 0
+}
+
+func (l Limit) ratePerSecond() float64 {
+	return float64(l.PerMinute) / 60
+}
+
+// Result is the outcome of taking a token.
+type Result struct {
+	Allowed bool
+	// RetryAfter is how long until a token is available when not Allowed.
+	RetryAfter time.Duration
+}
+
+// Store keeps token buckets. Implementations must take the token atomically
+// so concurrent requests of one client cannot overspend.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error)
+	// Peek reports whether Take would be allowed, without spending a token.
+	Peek(ctx context.Context, key string, limit Limit, now time.Time) (Result, error)
+}
+
+// bucket is the state of one token bucket.
+type bucket struct {
+	Tokens    float64   `db:"tokens"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func newBucket(limit Limit, now time.Time) bucket {
+	return bucket{Tokens: float64(limit.Burst), UpdatedAt: now}
+}
+
+// take refills b for the time elapsed since its last update and spends a
+// token if one is available.
+func (b *bucket) take(limit Limit, now time.Time) Result {
+	if elapsed := now.Sub(b.UpdatedAt).Seconds(); elapsed > 0 {
+		b.Tokens = math.Min(float64(limit.Burst), b.Tokens+elapsed*limit.ratePerSecond())
+	}
+	b.UpdatedAt = now
+
+	if b.Tokens >= 1 {
+		b.Tokens--
+		return Result{Allowed: true}
+	}
+
+	wait := (1 - b.Tokens) / limit.ratePerSecond()
+	return Result{RetryAfter: time.Duration(math.Ceil(wait * float64(time.Second)))}
+}
+
+// peek is take on a copy of b.
+func (b bucket) peek(limit Limit, now time.Time) Result {
+	return b.take(limit, now)
+}
+
+// idleAfter is how long a bucket takes to refill completely, after which
+// forgetting it is the same as keeping it.
+func (l Limit) idleAfter() time.Duration {
+	return time.Duration(float64(l.Burst) / l.ratePerSecond() * float64(time.Second))
+}
@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBucketTake(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	limit := Limit{PerMinute: 60, Burst: 2}
+
+	tests := []struct {
+		name       string
+		tokens     float64
+		elapsed    time.Duration
+		allowed    bool
+		retryAfter time.Duration
+		left       float64
+	}{
+		{"full", 2, 0, true, 0, 1},
+		{"last token", 1, 0, true, 0, 0},
+		{"empty", 0, 0, false, time.Second, 0},
+		{"half refilled", 0.5, 0, false, 500 * time.Millisecond, 0.5},
+		{"refilled", 0, time.Second, true, 0, 0},
+		{"refill capped at burst", 0, time.Hour, true, 0, 1},
+		{"clock went back", 1, -time.Minute, true, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := bucket{Tokens: tt.tokens, UpdatedAt: start}
+			now := start.Add(tt.elapsed)
+
+			result := b.take(limit, now)
+			if result.Allowed != tt.allowed || result.RetryAfter != tt.retryAfter {
+				t.Errorf("expected allowed %v retry after %v, got %+v", tt.allowed, tt.retryAfter, result)
+			}
+			if b.Tokens != tt.left || !b.UpdatedAt.Equal(now) {
+				t.Errorf("expected %v tokens at %v, got %v at %v", tt.left, now, b.Tokens, b.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestBucketPeek(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	b := bucket{Tokens: 1, UpdatedAt: now}
+
+	for range 2 {
+		if result := b.peek(Limit{PerMinute: 60, Burst: 2}, now); !result.Allowed {
+			t.Fatalf("expected the token available, got %+v", result)
+		}
+	}
+	if b.Tokens != 1 {
+		t.Errorf("expected peek to spend nothing, got %v tokens", b.Tokens)
+	}
+}
+
+func TestLimitIdleAfter(t *testing.T) {
+	tests := []struct {
+		limit Limit
+		want  time.Duration
+	}{
+		{Limit{PerMinute: 60, Burst: 20}, 20 * time.Second},
+		{Limit{PerMinute: 300, Burst: 100}, 20 * time.Second},
+		{Limit{PerMinute: 10, Burst: 10}, time.Minute},
+		{Limit{PerMinute: 120, Burst: 1}, 500 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if got := tt.limit.idleAfter(); got != tt.want {
+			t.Errorf("%+v: expected %v, got %v", tt.limit, tt.want, got)
+		}
+	}
+}
+
+func TestGuardTokens(t *testing.T) {
+	limiter := New(NewMemoryStore(), Config{InvalidTokens: Limit{PerMinute: 1, Burst: 2}})
+	handler := limiter.GuardTokens(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get("Authorization"); token != "" && token != "Bearer valid" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	serve := func(authorization, remoteAddr string) int {
+		r := httptest.NewRequest(http.MethodPost, "/query", nil)
+		r.RemoteAddr = remoteAddr
+		if authorization != "" {
+			r.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		remoteAddr    string
+		want          int
+	}{
+		{"first invalid token", "Bearer forged", "192.0.2.1:1000", http.StatusUnauthorized},
+		{"valid token is free", "Bearer valid", "192.0.2.1:1000", http.StatusOK},
+		{"last invalid token", "Bearer forged", "192.0.2.1:1001", http.StatusUnauthorized},
+		{"budget spent", "Bearer forged", "192.0.2.1:1000", http.StatusTooManyRequests},
+		{"valid token refused too", "Bearer valid", "192.0.2.1:1000", http.StatusTooManyRequests},
+		{"no token", "", "192.0.2.1:1000", http.StatusOK},
+		{"other address", "Bearer forged", "192.0.2.2:1000", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		if got := serve(tt.authorization, tt.remoteAddr); got != tt.want {
+			t.Errorf("%s: expected %d, got %d", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestMemoryStorePeekUnknownKey(t *testing.T) {
+	result, err := NewMemoryStore().Peek(context.Background(), "query:ip:192.0.2.1", Limit{PerMinute: 1, Burst: 1}, time.Now())
+	if err != nil || !result.Allowed {
+		t.Errorf("expected an unknown key allowed, got %+v, %v", result, err)
+	}
+}
@@ -0,0 +1,972 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/booking"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+// MemoryStore keeps records in maps and enforces the same keys and
+// constraints as the database. Operations and transactions run one at a
+// time, so transactions are serializable; a transaction that fails leaves
+// no trace. Records are copied in and out, so callers may modify them.
+//
+// A new MemoryStore holds the reference data the migrations seed: the
+// ancillary catalog and the countries of the airports.
+type MemoryStore struct {
+	data *memoryData
+	// inTx is set on the Store passed to InTx, whose caller holds the lock.
+	inTx bool
+}
+
+type memoryData struct {
+	mu sync.Mutex
+	memoryTables
+}
+
+type memoryTables struct {
+	flights       map[string]model.Flight
+	fares         map[string]model.Fare
+	bookings      map[string]model.Booking
+	flightEvents  map[string]model.FlightEvent
+	rebookings    map[string]model.Rebooking
+	waitlist      map[string]model.WaitlistEntry
+	products      map[string]model.AncillaryProduct
+	routePrices   map[routePriceKey]float64
+	inventory     map[inventoryKey]int
+	lines         map[string]model.BookingAncillary
+	documents     map[string][]byte
+	airports      map[string]string
+	users         map[string]model.User
+	refreshTokens map[string]model.RefreshToken
+}
+
+type routePriceKey struct {
+	productID, origin, destination string
+}
+
+type inventoryKey struct {
+	flightID, productID string
+}
+
+func (t *memoryTables) clone() memoryTables {
+	return memoryTables{
+		flights:       maps.Clone(t.flights),
+		fares:         maps.Clone(t.fares),
+		bookings:      maps.Clone(t.bookings),
+		flightEvents:  maps.Clone(t.flightEvents),
+		rebookings:    maps.Clone(t.rebookings),
+		waitlist:      maps.Clone(t.waitlist),
+		products:      maps.Clone(t.products),
+		routePrices:   maps.Clone(t.routePrices),
+		inventory:     maps.Clone(t.inventory),
+		lines:         maps.Clone(t.lines),
+		documents:     maps.Clone(t.documents),
+		airports:      maps.Clone(t.airports),
+		users:         maps.Clone(t.users),
+		refreshTokens: maps.Clone(t.refreshTokens),
+	}
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+func NewMemoryStore() *MemoryStore {
+	s := &MemoryStore{data: &memoryData{memoryTables: memoryTables{
+		flights:       map[string]model.Flight{},
+		fares:         map[string]model.Fare{},
+		bookings:      map[string]model.Booking{},
+		flightEvents:  map[string]model.FlightEvent{},
+		rebookings:    map[string]model.Rebooking{},
+		waitlist:      map[string]model.WaitlistEntry{},
+		products:      map[string]model.AncillaryProduct{},
+		routePrices:   map[routePriceKey]float64{},
+		inventory:     map[inventoryKey]int{},
+		lines:         map[string]model.BookingAncillary{},
+		documents:     map[string][]byte{},
+		airports:      map[string]string{},
+		users:         map[string]model.User{},
+		refreshTokens: map[string]model.RefreshToken{},
+	}}}
+	s.seed()
+	return s
+}
+
+// seed adds the products of migration 009 and the airports of migration 010.
+func (s *MemoryStore) seed() {
+	loungePasses := 20
+	products := []model.AncillaryProduct{
+		{Code: "EXTRA_BAG", Name: "Extra checked bag", Category: model.AncillaryCategoryBaggage, Description: "One additional checked bag up to 23 kg", BasePrice: 45, MaxPerBooking: 3, AllowedClasses: []string{"PROMO", "BASIC", "PRO"}},
+		{Code: "HOT_MEAL", Name: "Hot meal", Category: model.AncillaryCategoryMeal, Description: "Hot meal with a soft drink", BasePrice: 18, MaxPerBooking: 2, AllowedClasses: []string{"PROMO", "BASIC", "PRO"}},
+		{Code: "PRIORITY_BOARDING", Name: "Priority boarding", Category: model.AncillaryCategoryPriorityBoarding, Description: "Board in the first group", BasePrice: 15, MaxPerBooking: 1, AllowedClasses: []string{"PROMO", "BASIC"}},
+		{Code: "SEAT_SELECTION", Name: "Seat selection", Category: model.AncillaryCategorySeatSelection, Description: "Choose your seat before check-in", BasePrice: 20, MaxPerBooking: 1, AllowedClasses: []string{"BASIC", "PRO"}},
+		{Code: "LOUNGE_PASS", Name: "Lounge pass", Category: model.AncillaryCategoryLoungePass, Description: "Departure lounge access", BasePrice: 50, MaxPerBooking: 2, AllowedClasses: []string{"PROMO", "BASIC", "PRO"}, FlightInventory: &loungePasses},
+	}
+	for _, p := range products {
+		stamp(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+		s.data.products[p.ID] = p
+	}
+
+	countries := map[string][]string{
+		"USA": {"JFK", "LAX", "ORD", "DFW", "ATL", "BOS", "SEA", "MIA", "SFO", "LAS", "DEN", "PHX", "IAD", "SAN", "DAL", "EWR"},
+		"CAN": {"YYZ"}, "MEX": {"MEX"}, "GBR": {"LHR"}, "FRA": {"CDG"}, "BRA": {"GRU"}, "JPN": {"NRT"},
+	}
+	for country, codes := range countries {
+		for _, code := range codes {
+			s.data.airports[code] = country
+		}
+	}
+}
+
+func (s *MemoryStore) Flights() FlightRepository             { return memoryFlights{s} }
+func (s *MemoryStore) Fares() FareRepository                 { return memoryFares{s} }
+func (s *MemoryStore) Bookings() BookingRepository           { return memoryBookings{s} }
+func (s *MemoryStore) FlightEvents() FlightEventRepository   { return memoryFlightEvents{s} }
+func (s *MemoryStore) Rebookings() RebookingRepository       { return memoryRebookings{s} }
+func (s *MemoryStore) Waitlist() WaitlistRepository          { return memoryWaitlist{s} }
+func (s *MemoryStore) Ancillaries() AncillaryRepository      { return memoryAncillaries{s} }
+func (s *MemoryStore) Documents() DocumentRepository         { return memoryDocuments{s} }
+func (s *MemoryStore) Airports() AirportRepository           { return memoryAirports{s} }
+func (s *MemoryStore) Users() UserRepository                 { return memoryUsers{s} }
+func (s *MemoryStore) RefreshTokens() RefreshTokenRepository { return memoryRefreshTokens{s} }
+
+func (s *MemoryStore) InTx(_ context.Context, fn func(tx Store) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+
+	s.data.mu.Lock()
+	defer s.data.mu.Unlock()
+
+	saved := s.data.clone()
+	if err := fn(&MemoryStore{data: s.data, inTx: true}); err != nil {
+		s.data.memoryTables = saved
+		return err
+	}
+	return nil
+}
+
+// do runs fn with the lock held, unless the store belongs to a transaction.
+func (s *MemoryStore) do(fn func(d *memoryData) error) error {
+	if !s.inTx {
+		s.data.mu.Lock()
+		defer s.data.mu.Unlock()
+	}
+	return fn(s.data)
+}
+
+// collect returns a copy of the records of table that match, sorted by
+// compare.
+func collect[K comparable, V any](table map[K]V, match func(*V) bool, compare func(a, b *V) int) []*V {
+	records := []*V{}
+	for _, record := range table {
+		if match(&record) {
+			records = append(records, &record)
+		}
+	}
+	slices.SortFunc(records, compare)
+	return records
+}
+
+// byKeys matches the records whose key is one of keys.
+func byKeys[V any](keys []string, key func(*V) string) func(*V) bool {
+	set := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		set[k] = true
+	}
+	return func(record *V) bool { return set[key(record)] }
+}
+
+func limit[V any](records []*V, n int) []*V {
+	if n > 0 {
+		return records[:min(len(records), n)]
+	}
+	return records
+}
+
+type memoryFlights struct {
+	s *MemoryStore
+}
+
+func (r memoryFlights) Get(_ context.Context, id string) (*model.Flight, error) {
+	var flight *model.Flight
+	err := r.s.do(func(d *memoryData) error {
+		f, ok := d.flights[id]
+		if !ok {
+			return apperror.NotFound("flight %s not found", id)
+		}
+		flight = &f
+		return nil
+	})
+	return flight, err
+}
+
+func (r memoryFlights) GetForUpdate(ctx context.Context, id string) (*model.Flight, error) {
+	return r.Get(ctx, id)
+}
+
+func (r memoryFlights) ListByIDs(_ context.Context, ids []string) ([]*model.Flight, error) {
+	var flights []*model.Flight
+	err := r.s.do(func(d *memoryData) error {
+		flights = collect(d.flights, byKeys(ids, func(f *model.Flight) string { return f.ID }), compareFlights)
+		return nil
+	})
+	return flights, err
+}
+
+func (r memoryFlights) List(_ context.Context, filter FlightFilter) ([]*model.Flight, error) {
+	var flights []*model.Flight
+	err := r.s.do(func(d *memoryData) error {
+		flights = collect(d.flights, func(f *model.Flight) bool {
+			return (filter.Origin == nil || f.Origin == *filter.Origin) &&
+				(filter.Destination == nil || f.Destination == *filter.Destination) &&
+				(filter.Status == nil || f.Status == *filter.Status) &&
+				(filter.DepartsAfter == nil || f.DepartureTime.After(*filter.DepartsAfter)) &&
+				(filter.DepartsBy == nil || !f.DepartureTime.After(*filter.DepartsBy))
+		}, compareFlights)
+		return nil
+	})
+	return limit(flights, filter.Limit), err
+}
+
+func compareFlights(a, b *model.Flight) int {
+	return cmp.Or(a.DepartureTime.Compare(b.DepartureTime), cmp.Compare(a.ID, b.ID))
+}
+
+func (r memoryFlights) Airports(_ context.Context) ([]string, error) {
+	var airports []string
+	err := r.s.do(func(d *memoryData) error {
+		for _, f := range d.flights {
+			airports = append(airports, f.Origin, f.Destination)
+		}
+		return nil
+	})
+
+	slices.Sort(airports)
+	return slices.Compact(airports), err
+}
+
+func (r memoryFlights) Create(_ context.Context, flight *model.Flight) error {
+	stamp(&flight.ID, &flight.CreatedAt, &flight.UpdatedAt)
+
+	return r.s.do(func(d *memoryData) error {
+		for _, f := range d.flights {
+			if f.ID == flight.ID || f.FlightNumber == flight.FlightNumber {
+				return apperror.Conflict("flight %s already exists", flight.FlightNumber)
+			}
+		}
+		d.flights[flight.ID] = *flight
+		return nil
+	})
+}
+
+func (r memoryFlights) Update(_ context.Context, flight *model.Flight) error {
+	return r.s.do(func(d *memoryData) error {
+		if f, ok := d.flights[flight.ID]; ok {
+			f.Status = flight.Status
+			f.Gate = flight.Gate
+			f.DelayMinutes = flight.DelayMinutes
+			f.OverbookingSeats = flight.OverbookingSeats
+			f.OverbookingPercent = flight.OverbookingPercent
+			f.UpdatedAt = flight.UpdatedAt
+			d.flights[f.ID] = f
+		}
+		return nil
+	})
+}
+
+type memoryFares struct {
+	s *MemoryStore
+}
+
+func (r memoryFares) Get(_ context.Context, id string) (*model.Fare, error) {
+	var fare *model.Fare
+	err := r.s.do(func(d *memoryData) error {
+		f, ok := d.fares[id]
+		if !ok {
+			return apperror.NotFound("fare %s not found", id)
+		}
+		fare = &f
+		return nil
+	})
+	return fare, err
+}
+
+func (r memoryFares) GetForUpdate(ctx context.Context, id string) (*model.Fare, error) {
+	return r.Get(ctx, id)
+}
+
+func (r memoryFares) ListByIDs(_ context.Context, ids []string) ([]*model.Fare, error) {
+	return r.list(byKeys(ids, func(f *model.Fare) string { return f.ID }))
+}
+
+func (r memoryFares) ListByFlight(ctx context.Context, flightID string) ([]*model.Fare, error) {
+	return r.ListByFlights(ctx, []string{flightID})
+}
+
+func (r memoryFares) ListByFlights(_ context.Context, flightIDs []string) ([]*model.Fare, error) {
+	return r.list(byKeys(flightIDs, func(f *model.Fare) string { return f.FlightID }))
+}
+
+func (r memoryFares) ListByFlightsForUpdate(ctx context.Context, flightIDs []string) ([]*model.Fare, error) {
+	return r.ListByFlights(ctx, flightIDs)
+}
+
+func (r memoryFares) list(match func(*model.Fare) bool) ([]*model.Fare, error) {
+	var fares []*model.Fare
+	err := r.s.do(func(d *memoryData) error {
+		fares = collect(d.fares, match, func(a, b *model.Fare) int {
+			return cmp.Or(cmp.Compare(a.Price, b.Price), cmp.Compare(a.ID, b.ID))
+		})
+		return nil
+	})
+	return fares, err
+}
+
+func (r memoryFares) Create(_ context.Context, fare *model.Fare) error {
+	stamp(&fare.ID, &fare.CreatedAt, &fare.UpdatedAt)
+
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.flights[fare.FlightID]; !ok {
+			return apperror.Internal(fmt.Errorf("flight %s does not exist", fare.FlightID), "failed to create fare")
+		}
+		for _, f := range d.fares {
+			if f.ID == fare.ID || (f.FlightID == fare.FlightID && f.FareClass == fare.FareClass) {
+				return apperror.Conflict("flight %s already has a %s fare", fare.FlightID, fare.FareClass)
+			}
+		}
+		d.fares[fare.ID] = *fare
+		return nil
+	})
+}
+
+func (r memoryFares) Update(_ context.Context, fare *model.Fare) error {
+	return r.s.do(func(d *memoryData) error {
+		if f, ok := d.fares[fare.ID]; ok {
+			f.OverbookingSeats = fare.OverbookingSeats
+			f.OverbookingPercent = fare.OverbookingPercent
+			f.UpdatedAt = fare.UpdatedAt
+			d.fares[f.ID] = f
+		}
+		return nil
+	})
+}
+
+func (r memoryFares) TakeSeat(_ context.Context, id string) error {
+	return r.addSeats(id, -1)
+}
+
+func (r memoryFares) ReleaseSeat(_ context.Context, id string) error {
+	return r.addSeats(id, 1)
+}
+
+func (r memoryFares) addSeats(id string, n int) error {
+	return r.s.do(func(d *memoryData) error {
+		if f, ok := d.fares[id]; ok {
+			f.AvailableSeats += n
+			d.fares[id] = f
+		}
+		return nil
+	})
+}
+
+type memoryBookings struct {
+	s *MemoryStore
+}
+
+func (r memoryBookings) GetByReference(_ context.Context, reference string) (*model.Booking, error) {
+	var found *model.Booking
+	err := r.s.do(func(d *memoryData) error {
+		for _, b := range d.bookings {
+			if b.BookingReference == reference {
+				found = &b
+				return nil
+			}
+		}
+		return apperror.NotFound("booking %s not found", reference)
+	})
+	return found, err
+}
+
+func (r memoryBookings) GetByReferenceForUpdate(ctx context.Context, reference string) (*model.Booking, error) {
+	return r.GetByReference(ctx, reference)
+}
+
+func (r memoryBookings) ListByIDs(_ context.Context, ids []string) ([]*model.Booking, error) {
+	return r.list(byKeys(ids, func(b *model.Booking) string { return b.ID }))
+}
+
+func (r memoryBookings) ListByFlight(ctx context.Context, flightID string) ([]*model.Booking, error) {
+	return r.ListByFlights(ctx, []string{flightID})
+}
+
+func (r memoryBookings) ListByFlightForUpdate(ctx context.Context, flightID string) ([]*model.Booking, error) {
+	return r.ListByFlights(ctx, []string{flightID})
+}
+
+func (r memoryBookings) ListByFlights(_ context.Context, flightIDs []string) ([]*model.Booking, error) {
+	return r.list(byKeys(flightIDs, func(b *model.Booking) string { return b.FlightID }))
+}
+
+func (r memoryBookings) ListByFares(_ context.Context, fareIDs []string) ([]*model.Booking, error) {
+	return r.list(byKeys(fareIDs, func(b *model.Booking) string { return b.FareID }))
+}
+
+func (r memoryBookings) list(match func(*model.Booking) bool) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	err := r.s.do(func(d *memoryData) error {
+		bookings = collect(d.bookings, match, func(a, b *model.Booking) int {
+			return cmp.Or(a.BookedAt.Compare(b.BookedAt), cmp.Compare(a.ID, b.ID))
+		})
+		return nil
+	})
+	return bookings, err
+}
+
+func (r memoryBookings) ListByUser(_ context.Context, filter BookingFilter) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	err := r.s.do(func(d *memoryData) error {
+		bookings = collect(d.bookings, func(b *model.Booking) bool {
+			return b.UserID != nil && *b.UserID == filter.UserID &&
+				(filter.PassengerEmail == nil || b.PassengerEmail == *filter.PassengerEmail)
+		}, func(a, b *model.Booking) int {
+			return cmp.Or(b.BookedAt.Compare(a.BookedAt), cmp.Compare(a.ID, b.ID))
+		})
+		return nil
+	})
+	return limit(bookings, filter.Limit), err
+}
+
+func (r memoryBookings) CountHeldSeats(_ context.Context, flightID string) (int, error) {
+	held := 0
+	err := r.s.do(func(d *memoryData) error {
+		for _, b := range d.bookings {
+			if b.FlightID == flightID && b.BookingStatus.HoldsSeat() {
+				held++
+			}
+		}
+		return nil
+	})
+	return held, err
+}
+
+func (r memoryBookings) Create(_ context.Context, b *model.Booking) error {
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.flights[b.FlightID]; !ok {
+			return apperror.Internal(fmt.Errorf("flight %s does not exist", b.FlightID), "failed to create booking")
+		}
+		if _, ok := d.fares[b.FareID]; !ok {
+			return apperror.Internal(fmt.Errorf("fare %s does not exist", b.FareID), "failed to create booking")
+		}
+		if b.UserID != nil {
+			if _, ok := d.users[*b.UserID]; !ok {
+				return apperror.Internal(fmt.Errorf("user %s does not exist", *b.UserID), "failed to create booking")
+			}
+		}
+
+		reference := booking.NewReference()
+		for _, existing := range d.bookings {
+			if existing.BookingReference == reference {
+				return apperror.Conflict("booking could not be created, please retry")
+			}
+		}
+
+		b.ID = uuid.New().String()
+		b.BookingReference = reference
+		b.CreatedAt = time.Now()
+		b.UpdatedAt = b.CreatedAt
+		d.bookings[b.ID] = *b
+		return nil
+	})
+}
+
+func (r memoryBookings) Update(_ context.Context, b *model.Booking) error {
+	return r.s.do(func(d *memoryData) error {
+		if stored, ok := d.bookings[b.ID]; ok {
+			stored.FlightID = b.FlightID
+			stored.FareID = b.FareID
+			stored.SeatNumber = b.SeatNumber
+			stored.BookingStatus = b.BookingStatus
+			stored.TotalPrice = b.TotalPrice
+			stored.CheckedInAt = b.CheckedInAt
+			stored.CheckInSequence = b.CheckInSequence
+			stored.DeniedBoardingVolunteer = b.DeniedBoardingVolunteer
+			stored.UpdatedAt = b.UpdatedAt
+			d.bookings[b.ID] = stored
+		}
+		return nil
+	})
+}
+
+func (r memoryBookings) UpdateStatus(_ context.Context, id string, status model.BookingStatus) error {
+	return r.s.do(func(d *memoryData) error {
+		if b, ok := d.bookings[id]; ok {
+			b.BookingStatus = status
+			d.bookings[id] = b
+		}
+		return nil
+	})
+}
+
+type memoryFlightEvents struct {
+	s *MemoryStore
+}
+
+func (r memoryFlightEvents) ListByFlights(_ context.Context, flightIDs []string) ([]*model.FlightEvent, error) {
+	var events []*model.FlightEvent
+	err := r.s.do(func(d *memoryData) error {
+		events = collect(d.flightEvents, byKeys(flightIDs, func(e *model.FlightEvent) string { return e.FlightID }),
+			func(a, b *model.FlightEvent) int {
+				return cmp.Or(a.OccurredAt.Compare(b.OccurredAt), cmp.Compare(a.ID, b.ID))
+			})
+		return nil
+	})
+	return events, err
+}
+
+func (r memoryFlightEvents) Create(_ context.Context, event *model.FlightEvent) error {
+	stampOnce(&event.ID, &event.OccurredAt)
+
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.flights[event.FlightID]; !ok {
+			return apperror.Internal(fmt.Errorf("flight %s does not exist", event.FlightID), "failed to record flight event")
+		}
+		d.flightEvents[event.ID] = *event
+		return nil
+	})
+}
+
+type memoryRebookings struct {
+	s *MemoryStore
+}
+
+func (r memoryRebookings) ListByFlight(_ context.Context, originalFlightID string) ([]*model.Rebooking, error) {
+	var rebookings []*model.Rebooking
+	err := r.s.do(func(d *memoryData) error {
+		rebookings = collect(d.rebookings, func(rb *model.Rebooking) bool {
+			return rb.OriginalFlightID == originalFlightID
+		}, func(a, b *model.Rebooking) int {
+			return cmp.Or(cmp.Compare(b.Outcome, a.Outcome), a.ProcessedAt.Compare(b.ProcessedAt), cmp.Compare(a.ID, b.ID))
+		})
+		return nil
+	})
+	return rebookings, err
+}
+
+func (r memoryRebookings) Create(_ context.Context, rebooking *model.Rebooking) error {
+	stampOnce(&rebooking.ID, &rebooking.ProcessedAt)
+
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.bookings[rebooking.BookingID]; !ok {
+			return apperror.Internal(fmt.Errorf("booking %s does not exist", rebooking.BookingID), "failed to record rebooking")
+		}
+		d.rebookings[rebooking.ID] = *rebooking
+		return nil
+	})
+}
+
+type memoryWaitlist struct {
+	s *MemoryStore
+}
+
+func (r memoryWaitlist) Get(_ context.Context, id string) (*model.WaitlistEntry, error) {
+	var entry *model.WaitlistEntry
+	err := r.s.do(func(d *memoryData) error {
+		e, ok := d.waitlist[id]
+		if !ok {
+			return apperror.NotFound("waitlist entry %s not found", id)
+		}
+		entry = &e
+		return nil
+	})
+	return entry, err
+}
+
+func (r memoryWaitlist) GetForUpdate(ctx context.Context, id string) (*model.WaitlistEntry, error) {
+	return r.Get(ctx, id)
+}
+
+func (r memoryWaitlist) NextWaitingForUpdate(_ context.Context, fareID string) (*model.WaitlistEntry, error) {
+	var next *model.WaitlistEntry
+	err := r.s.do(func(d *memoryData) error {
+		waiting := collect(d.waitlist, func(e *model.WaitlistEntry) bool {
+			return e.FareID == fareID && e.Status == model.WaitlistStatusWaiting
+		}, compareQueued)
+		if len(waiting) > 0 {
+			next = waiting[0]
+		}
+		return nil
+	})
+	return next, err
+}
+
+func (r memoryWaitlist) ListByFlight(_ context.Context, flightID string) ([]*model.WaitlistEntry, error) {
+	var entries []*model.WaitlistEntry
+	err := r.s.do(func(d *memoryData) error {
+		entries = collect(d.waitlist, func(e *model.WaitlistEntry) bool { return e.FlightID == flightID }, compareQueued)
+		return nil
+	})
+	return entries, err
+}
+
+func (r memoryWaitlist) ListExpiredOffers(_ context.Context, now time.Time) ([]*model.WaitlistEntry, error) {
+	var entries []*model.WaitlistEntry
+	err := r.s.do(func(d *memoryData) error {
+		entries = collect(d.waitlist, func(e *model.WaitlistEntry) bool {
+			return e.Status == model.WaitlistStatusOffered && e.OfferExpiresAt != nil && e.OfferExpiresAt.Before(now)
+		}, func(a, b *model.WaitlistEntry) int {
+			return cmp.Or(a.OfferExpiresAt.Compare(*b.OfferExpiresAt), cmp.Compare(a.ID, b.ID))
+		})
+		return nil
+	})
+	return entries, err
+}
+
+func (r memoryWaitlist) CountActiveByFares(_ context.Context, fareIDs []string) (map[string]int, error) {
+	counts := map[string]int{}
+	err := r.s.do(func(d *memoryData) error {
+		for _, e := range d.waitlist {
+			if slices.Contains(fareIDs, e.FareID) && isActive(&e) {
+				counts[e.FareID]++
+			}
+		}
+		return nil
+	})
+	return counts, err
+}
+
+func (r memoryWaitlist) CountWaitingAhead(_ context.Context, entry *model.WaitlistEntry) (int, error) {
+	ahead := 0
+	err := r.s.do(func(d *memoryData) error {
+		for _, e := range d.waitlist {
+			if e.FareID == entry.FareID && e.Status == model.WaitlistStatusWaiting && compareQueued(&e, entry) < 0 {
+				ahead++
+			}
+		}
+		return nil
+	})
+	return ahead, err
+}
+
+func compareQueued(a, b *model.WaitlistEntry) int {
+	return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+}
+
+func isActive(e *model.WaitlistEntry) bool {
+	return e.Status == model.WaitlistStatusWaiting || e.Status == model.WaitlistStatusOffered
+}
+
+func (r memoryWaitlist) Create(_ context.Context, entry *model.WaitlistEntry) error {
+	stamp(&entry.ID, &entry.CreatedAt, &entry.UpdatedAt)
+
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.fares[entry.FareID]; !ok {
+			return apperror.Internal(fmt.Errorf("fare %s does not exist", entry.FareID), "failed to join waitlist")
+		}
+		for _, e := range d.waitlist {
+			if e.FareID == entry.FareID && e.PassengerEmail == entry.PassengerEmail && isActive(&e) && isActive(entry) {
+				return apperror.Conflict("%s is already on the waitlist for this fare", entry.PassengerEmail)
+			}
+		}
+		d.waitlist[entry.ID] = *entry
+		return nil
+	})
+}
+
+func (r memoryWaitlist) Update(_ context.Context, entry *model.WaitlistEntry) error {
+	return r.s.do(func(d *memoryData) error {
+		if e, ok := d.waitlist[entry.ID]; ok {
+			e.Status = entry.Status
+			e.OfferExpiresAt = entry.OfferExpiresAt
+			e.BookingID = entry.BookingID
+			e.UpdatedAt = entry.UpdatedAt
+			d.waitlist[e.ID] = e
+		}
+		return nil
+	})
+}
+
+type memoryAncillaries struct {
+	s *MemoryStore
+}
+
+func (r memoryAncillaries) ListProducts(_ context.Context) ([]*model.AncillaryProduct, error) {
+	return r.listProducts(func(*model.AncillaryProduct) bool { return true })
+}
+
+func (r memoryAncillaries) GetProduct(_ context.Context, id string) (*model.AncillaryProduct, error) {
+	var product *model.AncillaryProduct
+	err := r.s.do(func(d *memoryData) error {
+		p, ok := d.products[id]
+		if !ok {
+			return apperror.NotFound("ancillary product %s not found", id)
+		}
+		p.AllowedClasses = slices.Clone(p.AllowedClasses)
+		product = &p
+		return nil
+	})
+	return product, err
+}
+
+func (r memoryAncillaries) ListProductsByIDs(_ context.Context, ids []string) ([]*model.AncillaryProduct, error) {
+	return r.listProducts(byKeys(ids, func(p *model.AncillaryProduct) string { return p.ID }))
+}
+
+func (r memoryAncillaries) listProducts(match func(*model.AncillaryProduct) bool) ([]*model.AncillaryProduct, error) {
+	var products []*model.AncillaryProduct
+	err := r.s.do(func(d *memoryData) error {
+		products = collect(d.products, match, func(a, b *model.AncillaryProduct) int {
+			return cmp.Or(cmp.Compare(a.Category, b.Category), cmp.Compare(a.Name, b.Name))
+		})
+		return nil
+	})
+	for _, p := range products {
+		p.AllowedClasses = slices.Clone(p.AllowedClasses)
+	}
+	return products, err
+}
+
+func (r memoryAncillaries) RoutePrices(_ context.Context, origin, destination string) (map[string]float64, error) {
+	prices := map[string]float64{}
+	err := r.s.do(func(d *memoryData) error {
+		for key, price := range d.routePrices {
+			if key.origin == origin && key.destination == destination {
+				prices[key.productID] = price
+			}
+		}
+		return nil
+	})
+	return prices, err
+}
+
+func (r memoryAncillaries) SoldByFlight(_ context.Context, flightID string) (map[string]int, error) {
+	sold := map[string]int{}
+	err := r.s.do(func(d *memoryData) error {
+		for key, n := range d.inventory {
+			if key.flightID == flightID {
+				sold[key.productID] = n
+			}
+		}
+		return nil
+	})
+	return sold, err
+}
+
+func (r memoryAncillaries) GetSoldForUpdate(_ context.Context, flightID, productID string) (int, error) {
+	var sold int
+	err := r.s.do(func(d *memoryData) error {
+		sold = d.inventory[inventoryKey{flightID, productID}]
+		return nil
+	})
+	return sold, err
+}
+
+func (r memoryAncillaries) AddSold(_ context.Context, flightID, productID string, quantity int) error {
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.flights[flightID]; !ok {
+			return apperror.Internal(fmt.Errorf("flight %s does not exist", flightID), "failed to update ancillary inventory")
+		}
+		key := inventoryKey{flightID, productID}
+		d.inventory[key] = max(0, d.inventory[key]+quantity)
+		return nil
+	})
+}
+
+func (r memoryAncillaries) ListLinesByBookings(_ context.Context, bookingIDs []string) ([]*model.BookingAncillary, error) {
+	var lines []*model.BookingAncillary
+	err := r.s.do(func(d *memoryData) error {
+		lines = collect(d.lines, byKeys(bookingIDs, func(l *model.BookingAncillary) string { return l.BookingID }),
+			func(a, b *model.BookingAncillary) int {
+				return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+			})
+		return nil
+	})
+	return lines, err
+}
+
+func (r memoryAncillaries) CreateLine(_ context.Context, line *model.BookingAncillary) error {
+	stampOnce(&line.ID, &line.CreatedAt)
+
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.bookings[line.BookingID]; !ok {
+			return apperror.Internal(fmt.Errorf("booking %s does not exist", line.BookingID), "failed to add ancillary")
+		}
+		if _, ok := d.products[line.ProductID]; !ok {
+			return apperror.Internal(fmt.Errorf("ancillary product %s does not exist", line.ProductID), "failed to add ancillary")
+		}
+		d.lines[line.ID] = *line
+		return nil
+	})
+}
+
+func (r memoryAncillaries) DeleteLines(_ context.Context, bookingID, productID string) error {
+	return r.s.do(func(d *memoryData) error {
+		maps.DeleteFunc(d.lines, func(_ string, l model.BookingAncillary) bool {
+			return l.BookingID == bookingID && l.ProductID == productID
+		})
+		return nil
+	})
+}
+
+type memoryDocuments struct {
+	s *MemoryStore
+}
+
+func (r memoryDocuments) Get(_ context.Context, bookingID string) ([]byte, error) {
+	var ciphertext []byte
+	err := r.s.do(func(d *memoryData) error {
+		ciphertext = slices.Clone(d.documents[bookingID])
+		return nil
+	})
+	return ciphertext, err
+}
+
+func (r memoryDocuments) ListByBookings(_ context.Context, bookingIDs []string) (map[string][]byte, error) {
+	documents := map[string][]byte{}
+	err := r.s.do(func(d *memoryData) error {
+		for _, id := range bookingIDs {
+			if ciphertext, ok := d.documents[id]; ok {
+				documents[id] = slices.Clone(ciphertext)
+			}
+		}
+		return nil
+	})
+	return documents, err
+}
+
+func (r memoryDocuments) Save(_ context.Context, bookingID string, ciphertext []byte, _ time.Time) error {
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.bookings[bookingID]; !ok {
+			return apperror.Internal(fmt.Errorf("booking %s does not exist", bookingID), "failed to save document")
+		}
+		d.documents[bookingID] = slices.Clone(ciphertext)
+		return nil
+	})
+}
+
+type memoryAirports struct {
+	s *MemoryStore
+}
+
+func (r memoryAirports) Countries(_ context.Context, codes ...string) (map[string]string, error) {
+	countries := map[string]string{}
+	err := r.s.do(func(d *memoryData) error {
+		for _, code := range codes {
+			if country, ok := d.airports[code]; ok {
+				countries[code] = country
+			}
+		}
+		return nil
+	})
+	return countries, err
+}
+
+type memoryUsers struct {
+	s *MemoryStore
+}
+
+func (r memoryUsers) Get(_ context.Context, id string) (*model.User, error) {
+	return r.find(id, func(u *model.User) bool { return u.ID == id })
+}
+
+func (r memoryUsers) GetByEmail(_ context.Context, email string) (*model.User, error) {
+	return r.find(email, func(u *model.User) bool { return u.Email == email })
+}
+
+func (r memoryUsers) find(key string, match func(*model.User) bool) (*model.User, error) {
+	var user *model.User
+	err := r.s.do(func(d *memoryData) error {
+		for _, u := range d.users {
+			if match(&u) {
+				u.RoleNames = slices.Clone(u.RoleNames)
+				user = &u
+				return nil
+			}
+		}
+		return apperror.NotFound("user %s not found", key)
+	})
+	return user, err
+}
+
+func (r memoryUsers) Create(_ context.Context, user *model.User) error {
+	stamp(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+
+	return r.s.do(func(d *memoryData) error {
+		for _, u := range d.users {
+			if u.ID == user.ID || u.Email == user.Email {
+				return apperror.Conflict("an account with this email already exists")
+			}
+		}
+		stored := *user
+		stored.RoleNames = slices.Clone(user.RoleNames)
+		d.users[user.ID] = stored
+		return nil
+	})
+}
+
+type memoryRefreshTokens struct {
+	s *MemoryStore
+}
+
+func (r memoryRefreshTokens) GetByHashForUpdate(_ context.Context, hash []byte) (*model.RefreshToken, error) {
+	var token *model.RefreshToken
+	err := r.s.do(func(d *memoryData) error {
+		for _, t := range d.refreshTokens {
+			if string(t.TokenHash) == string(hash) {
+				t.TokenHash = slices.Clone(t.TokenHash)
+				token = &t
+				return nil
+			}
+		}
+		return apperror.NotFound("refresh token not found")
+	})
+	return token, err
+}
+
+func (r memoryRefreshTokens) Create(_ context.Context, token *model.RefreshToken) error {
+	stampOnce(&token.ID, &token.CreatedAt)
+
+	return r.s.do(func(d *memoryData) error {
+		if _, ok := d.users[token.UserID]; !ok {
+			return apperror.Internal(fmt.Errorf("user %s does not exist", token.UserID), "failed to store refresh token")
+		}
+		for _, t := range d.refreshTokens {
+			if t.ID == token.ID || string(t.TokenHash) == string(token.TokenHash) {
+				return apperror.Internal(fmt.Errorf("duplicate refresh token"), "failed to store refresh token")
+			}
+		}
+		stored := *token
+		stored.TokenHash = slices.Clone(token.TokenHash)
+		d.refreshTokens[token.ID] = stored
+		return nil
+	})
+}
+
+func (r memoryRefreshTokens) Revoke(_ context.Context, hash []byte, now time.Time) error {
+	return r.revoke(now, func(t *model.RefreshToken) bool { return string(t.TokenHash) == string(hash) })
+}
+
+func (r memoryRefreshTokens) RevokeAll(_ context.Context, userID string, now time.Time) error {
+	return r.revoke(now, func(t *model.RefreshToken) bool { return t.UserID == userID })
+}
+
+func (r memoryRefreshTokens) revoke(now time.Time, match func(*model.RefreshToken) bool) error {
+	return r.s.do(func(d *memoryData) error {
+		for id, t := range d.refreshTokens {
+			if t.RevokedAt == nil && match(&t) {
+				t.RevokedAt = &now
+				d.refreshTokens[id] = t
+			}
+		}
+		return nil
+	})
+}
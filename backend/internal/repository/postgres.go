@@ -0,0 +1,916 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/booking"
+	"github.com/davidalecrim/red-airlines/internal/database"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+// PostgresStore runs its queries on a database, or on a transaction when it
+// is the Store passed to the function of InTx.
+type PostgresStore struct {
+	db *sqlx.DB
+	tx *sqlx.Tx
+}
+
+var _ Store = (*PostgresStore)(nil)
+
+func NewPostgresStore(db *sqlx.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Flights() FlightRepository           { return postgresFlights{s.q()} }
+func (s *PostgresStore) Fares() FareRepository               { return postgresFares{s.q()} }
+func (s *PostgresStore) Bookings() BookingRepository         { return postgresBookings{s.q()} }
+func (s *PostgresStore) FlightEvents() FlightEventRepository { return postgresFlightEvents{s.q()} }
+func (s *PostgresStore) Rebookings() RebookingRepository     { return postgresRebookings{s.q()} }
+func (s *PostgresStore) Waitlist() WaitlistRepository        { return postgresWaitlist{s.q()} }
+func (s *PostgresStore) Ancillaries() AncillaryRepository    { return postgresAncillaries{s.q()} }
+func (s *PostgresStore) Documents() DocumentRepository       { return postgresDocuments{s.q()} }
+func (s *PostgresStore) Airports() AirportRepository         { return postgresAirports{s.q()} }
+func (s *PostgresStore) Users() UserRepository               { return postgresUsers{s.q()} }
+func (s *PostgresStore) RefreshTokens() RefreshTokenRepository {
+	return postgresRefreshTokens{s.q()}
+}
+
+func (s *PostgresStore) InTx(ctx context.Context, fn func(tx Store) error) error {
+	if s.tx != nil {
+		return fn(s)
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return apperror.Internal(err, "failed to begin transaction")
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := fn(&PostgresStore{tx: tx}); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return apperror.Internal(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+func (s *PostgresStore) q() sqlx.ExtContext {
+	if s.tx != nil {
+		return s.tx
+	}
+	return s.db
+}
+
+type postgresFlights struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresFlights) Get(ctx context.Context, id string) (*model.Flight, error) {
+	return r.get(ctx, "SELECT * FROM flights WHERE id = $1", id)
+}
+
+func (r postgresFlights) GetForUpdate(ctx context.Context, id string) (*model.Flight, error) {
+	return r.get(ctx, "SELECT * FROM flights WHERE id = $1 FOR UPDATE", id)
+}
+
+func (r postgresFlights) get(ctx context.Context, query, id string) (*model.Flight, error) {
+	var flight model.Flight
+	if err := sqlx.GetContext(ctx, r.q, &flight, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound("flight %s not found", id)
+		}
+		return nil, apperror.Internal(err, "failed to load flight")
+	}
+	return &flight, nil
+}
+
+func (r postgresFlights) ListByIDs(ctx context.Context, ids []string) ([]*model.Flight, error) {
+	var flights []*model.Flight
+	if err := selectIn(ctx, r.q, &flights, "SELECT * FROM flights WHERE id IN (?)", ids); err != nil {
+		return nil, apperror.Internal(err, "failed to load flights")
+	}
+	return flights, nil
+}
+
+func (r postgresFlights) List(ctx context.Context, filter FlightFilter) ([]*model.Flight, error) {
+	query := "SELECT * FROM flights WHERE 1=1"
+	args := []any{}
+
+	if filter.Origin != nil {
+		query += " AND origin = ?"
+		args = append(args, *filter.Origin)
+	}
+	if filter.Destination != nil {
+		query += " AND destination = ?"
+		args = append(args, *filter.Destination)
+	}
+	if filter.Status != nil {
+		query += " AND status = ?"
+		args = append(args, *filter.Status)
+	}
+	if filter.DepartsAfter != nil {
+		query += " AND departure_time > ?"
+		args = append(args, *filter.DepartsAfter)
+	}
+	if filter.DepartsBy != nil {
+		query += " AND departure_time <= ?"
+		args = append(args, *filter.DepartsBy)
+	}
+
+	query += " ORDER BY departure_time, id"
+
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	var flights []*model.Flight
+	if err := sqlx.SelectContext(ctx, r.q, &flights, r.q.Rebind(query), args...); err != nil {
+		return nil, apperror.Internal(err, "failed to list flights")
+	}
+	return flights, nil
+}
+
+func (r postgresFlights) Airports(ctx context.Context) ([]string, error) {
+	query := `
+		SELECT DISTINCT airport
+		FROM (
+			SELECT origin AS airport FROM flights
+			UNION
+			SELECT destination AS airport FROM flights
+		) AS all_airports
+		ORDER BY airport
+	`
+
+	var airports []string
+	if err := sqlx.SelectContext(ctx, r.q, &airports, query); err != nil {
+		return nil, apperror.Internal(err, "failed to list airports")
+	}
+	return airports, nil
+}
+
+func (r postgresFlights) Create(ctx context.Context, flight *model.Flight) error {
+	stamp(&flight.ID, &flight.CreatedAt, &flight.UpdatedAt)
+
+	query := `
+		INSERT INTO flights (id, flight_number, origin, destination, departure_time, arrival_time,
+			aircraft_type, total_seats, available_seats, status, gate, delay_minutes,
+			overbooking_seats, overbooking_percent, created_at, updated_at)
+		VALUES (:id, :flight_number, :origin, :destination, :departure_time, :arrival_time,
+			:aircraft_type, :total_seats, :available_seats, :status, :gate, :delay_minutes,
+			:overbooking_seats, :overbooking_percent, :created_at, :updated_at)
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, flight); err != nil {
+		if database.IsUniqueViolation(err) {
+			return apperror.Conflict("flight %s already exists", flight.FlightNumber)
+		}
+		return apperror.Internal(err, "failed to create flight")
+	}
+	return nil
+}
+
+func (r postgresFlights) Update(ctx context.Context, flight *model.Flight) error {
+	query := `
+		UPDATE flights
+		SET status = :status, gate = :gate, delay_minutes = :delay_minutes,
+			overbooking_seats = :overbooking_seats, overbooking_percent = :overbooking_percent,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, flight); err != nil {
+		return apperror.Internal(err, "failed to update flight")
+	}
+	return nil
+}
+
+type postgresFares struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresFares) Get(ctx context.Context, id string) (*model.Fare, error) {
+	return r.get(ctx, "SELECT * FROM fares WHERE id = $1", id)
+}
+
+func (r postgresFares) GetForUpdate(ctx context.Context, id string) (*model.Fare, error) {
+	return r.get(ctx, "SELECT * FROM fares WHERE id = $1 FOR UPDATE", id)
+}
+
+func (r postgresFares) get(ctx context.Context, query, id string) (*model.Fare, error) {
+	var fare model.Fare
+	if err := sqlx.GetContext(ctx, r.q, &fare, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound("fare %s not found", id)
+		}
+		return nil, apperror.Internal(err, "failed to load fare")
+	}
+	return &fare, nil
+}
+
+func (r postgresFares) ListByIDs(ctx context.Context, ids []string) ([]*model.Fare, error) {
+	var fares []*model.Fare
+	if err := selectIn(ctx, r.q, &fares, "SELECT * FROM fares WHERE id IN (?)", ids); err != nil {
+		return nil, apperror.Internal(err, "failed to load fares")
+	}
+	return fares, nil
+}
+
+func (r postgresFares) ListByFlight(ctx context.Context, flightID string) ([]*model.Fare, error) {
+	var fares []*model.Fare
+	if err := sqlx.SelectContext(ctx, r.q, &fares, "SELECT * FROM fares WHERE flight_id = $1 ORDER BY price, id", flightID); err != nil {
+		return nil, apperror.Internal(err, "failed to list fares")
+	}
+	return fares, nil
+}
+
+func (r postgresFares) ListByFlights(ctx context.Context, flightIDs []string) ([]*model.Fare, error) {
+	return r.listByFlights(ctx, "SELECT * FROM fares WHERE flight_id IN (?) ORDER BY price, id", flightIDs)
+}
+
+func (r postgresFares) ListByFlightsForUpdate(ctx context.Context, flightIDs []string) ([]*model.Fare, error) {
+	return r.listByFlights(ctx, "SELECT * FROM fares WHERE flight_id IN (?) ORDER BY id FOR UPDATE", flightIDs)
+}
+
+func (r postgresFares) listByFlights(ctx context.Context, query string, flightIDs []string) ([]*model.Fare, error) {
+	var fares []*model.Fare
+	if err := selectIn(ctx, r.q, &fares, query, flightIDs); err != nil {
+		return nil, apperror.Internal(err, "failed to list fares")
+	}
+	return fares, nil
+}
+
+func (r postgresFares) Create(ctx context.Context, fare *model.Fare) error {
+	stamp(&fare.ID, &fare.CreatedAt, &fare.UpdatedAt)
+
+	query := `
+		INSERT INTO fares (id, flight_id, fare_class, price, baggage_allowance, is_refundable,
+			is_changeable, available_seats, overbooking_seats, overbooking_percent, created_at, updated_at)
+		VALUES (:id, :flight_id, :fare_class, :price, :baggage_allowance, :is_refundable,
+			:is_changeable, :available_seats, :overbooking_seats, :overbooking_percent, :created_at, :updated_at)
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, fare); err != nil {
+		if database.IsUniqueViolation(err) {
+			return apperror.Conflict("flight %s already has a %s fare", fare.FlightID, fare.FareClass)
+		}
+		return apperror.Internal(err, "failed to create fare")
+	}
+	return nil
+}
+
+func (r postgresFares) Update(ctx context.Context, fare *model.Fare) error {
+	query := `
+		UPDATE fares
+		SET overbooking_seats = :overbooking_seats, overbooking_percent = :overbooking_percent,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, fare); err != nil {
+		return apperror.Internal(err, "failed to update fare")
+	}
+	return nil
+}
+
+func (r postgresFares) TakeSeat(ctx context.Context, id string) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE fares SET available_seats = available_seats - 1 WHERE id = $1", id)
+	if err != nil {
+		return apperror.Internal(err, "failed to update available seats")
+	}
+	return nil
+}
+
+func (r postgresFares) ReleaseSeat(ctx context.Context, id string) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE fares SET available_seats = available_seats + 1 WHERE id = $1", id)
+	if err != nil {
+		return apperror.Internal(err, "failed to release seat")
+	}
+	return nil
+}
+
+type postgresBookings struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresBookings) GetByReference(ctx context.Context, reference string) (*model.Booking, error) {
+	return r.get(ctx, "SELECT * FROM bookings WHERE booking_reference = $1", reference)
+}
+
+func (r postgresBookings) GetByReferenceForUpdate(ctx context.Context, reference string) (*model.Booking, error) {
+	return r.get(ctx, "SELECT * FROM bookings WHERE booking_reference = $1 FOR UPDATE", reference)
+}
+
+func (r postgresBookings) get(ctx context.Context, query, reference string) (*model.Booking, error) {
+	var b model.Booking
+	if err := sqlx.GetContext(ctx, r.q, &b, query, reference); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound("booking %s not found", reference)
+		}
+		return nil, apperror.Internal(err, "failed to load booking")
+	}
+	return &b, nil
+}
+
+func (r postgresBookings) ListByIDs(ctx context.Context, ids []string) ([]*model.Booking, error) {
+	return r.list(ctx, "SELECT * FROM bookings WHERE id IN (?)", ids)
+}
+
+func (r postgresBookings) ListByFlight(ctx context.Context, flightID string) ([]*model.Booking, error) {
+	return r.list(ctx, "SELECT * FROM bookings WHERE flight_id IN (?) ORDER BY booked_at, id", []string{flightID})
+}
+
+func (r postgresBookings) ListByFlightForUpdate(ctx context.Context, flightID string) ([]*model.Booking, error) {
+	return r.list(ctx, "SELECT * FROM bookings WHERE flight_id IN (?) ORDER BY booked_at, id FOR UPDATE", []string{flightID})
+}
+
+func (r postgresBookings) ListByFlights(ctx context.Context, flightIDs []string) ([]*model.Booking, error) {
+	return r.list(ctx, "SELECT * FROM bookings WHERE flight_id IN (?) ORDER BY booked_at, id", flightIDs)
+}
+
+func (r postgresBookings) ListByFares(ctx context.Context, fareIDs []string) ([]*model.Booking, error) {
+	return r.list(ctx, "SELECT * FROM bookings WHERE fare_id IN (?) ORDER BY booked_at, id", fareIDs)
+}
+
+func (r postgresBookings) list(ctx context.Context, query string, keys []string) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	if err := selectIn(ctx, r.q, &bookings, query, keys); err != nil {
+		return nil, apperror.Internal(err, "failed to list bookings")
+	}
+	return bookings, nil
+}
+
+func (r postgresBookings) ListByUser(ctx context.Context, filter BookingFilter) ([]*model.Booking, error) {
+	query := "SELECT * FROM bookings WHERE user_id = ?"
+	args := []any{filter.UserID}
+
+	if filter.PassengerEmail != nil {
+		query += " AND passenger_email = ?"
+		args = append(args, *filter.PassengerEmail)
+	}
+
+	query += " ORDER BY booked_at DESC, id"
+
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	var bookings []*model.Booking
+	if err := sqlx.SelectContext(ctx, r.q, &bookings, r.q.Rebind(query), args...); err != nil {
+		return nil, apperror.Internal(err, "failed to list bookings")
+	}
+	return bookings, nil
+}
+
+func (r postgresBookings) CountHeldSeats(ctx context.Context, flightID string) (int, error) {
+	var booked int
+	err := sqlx.GetContext(ctx, r.q, &booked,
+		"SELECT COUNT(*) FROM bookings WHERE flight_id = $1 AND booking_status IN ($2, $3)",
+		flightID, model.BookingStatusConfirmed, model.BookingStatusCheckedIn)
+	if err != nil {
+		return 0, apperror.Internal(err, "failed to count booked seats")
+	}
+	return booked, nil
+}
+
+func (r postgresBookings) Create(ctx context.Context, b *model.Booking) error {
+	b.ID = uuid.New().String()
+	b.BookingReference = booking.NewReference()
+
+	// Check uniqueness (very unlikely collision)
+	var exists bool
+	err := sqlx.GetContext(ctx, r.q, &exists, "SELECT EXISTS(SELECT 1 FROM bookings WHERE booking_reference = $1)", b.BookingReference)
+	if err != nil {
+		return apperror.Internal(err, "failed to check booking reference uniqueness")
+	}
+	if exists {
+		b.BookingReference = booking.NewReference()
+	}
+
+	query := `
+		INSERT INTO bookings (id, booking_reference, flight_id, fare_id, passenger_name, passenger_email,
+			passenger_phone, seat_number, special_requests, booking_status, total_price, booked_at, user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING created_at, updated_at
+	`
+	err = sqlx.GetContext(ctx, r.q, b, query,
+		b.ID, b.BookingReference, b.FlightID, b.FareID,
+		b.PassengerName, b.PassengerEmail, b.PassengerPhone,
+		b.SeatNumber, b.SpecialRequests, b.BookingStatus, b.TotalPrice, b.BookedAt, b.UserID,
+	)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return apperror.Conflict("booking could not be created, please retry")
+		}
+		return apperror.Internal(err, "failed to create booking")
+	}
+	return nil
+}
+
+func (r postgresBookings) Update(ctx context.Context, b *model.Booking) error {
+	query := `
+		UPDATE bookings
+		SET flight_id = :flight_id, fare_id = :fare_id, seat_number = :seat_number,
+			booking_status = :booking_status, total_price = :total_price, checked_in_at = :checked_in_at,
+			check_in_sequence = :check_in_sequence, denied_boarding_volunteer = :denied_boarding_volunteer,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, b); err != nil {
+		return apperror.Internal(err, "failed to update booking")
+	}
+	return nil
+}
+
+func (r postgresBookings) UpdateStatus(ctx context.Context, id string, status model.BookingStatus) error {
+	if _, err := r.q.ExecContext(ctx, "UPDATE bookings SET booking_status = $1 WHERE id = $2", status, id); err != nil {
+		return apperror.Internal(err, "failed to update booking status")
+	}
+	return nil
+}
+
+type postgresFlightEvents struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresFlightEvents) ListByFlights(ctx context.Context, flightIDs []string) ([]*model.FlightEvent, error) {
+	var events []*model.FlightEvent
+	err := selectIn(ctx, r.q, &events, "SELECT * FROM flight_events WHERE flight_id IN (?) ORDER BY occurred_at, id", flightIDs)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to list flight events")
+	}
+	return events, nil
+}
+
+func (r postgresFlightEvents) Create(ctx context.Context, event *model.FlightEvent) error {
+	stampOnce(&event.ID, &event.OccurredAt)
+
+	query := `
+		INSERT INTO flight_events (id, flight_id, event_type, previous_status, new_status, gate,
+			delay_minutes, reason, actor, occurred_at)
+		VALUES (:id, :flight_id, :event_type, :previous_status, :new_status, :gate,
+			:delay_minutes, :reason, :actor, :occurred_at)
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, event); err != nil {
+		return apperror.Internal(err, "failed to record flight event")
+	}
+	return nil
+}
+
+type postgresRebookings struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresRebookings) ListByFlight(ctx context.Context, originalFlightID string) ([]*model.Rebooking, error) {
+	var rebookings []*model.Rebooking
+	query := "SELECT * FROM rebookings WHERE original_flight_id = $1 ORDER BY outcome DESC, processed_at, id"
+	if err := sqlx.SelectContext(ctx, r.q, &rebookings, query, originalFlightID); err != nil {
+		return nil, apperror.Internal(err, "failed to list rebookings")
+	}
+	return rebookings, nil
+}
+
+func (r postgresRebookings) Create(ctx context.Context, rebooking *model.Rebooking) error {
+	stampOnce(&rebooking.ID, &rebooking.ProcessedAt)
+
+	query := `
+		INSERT INTO rebookings (id, booking_id, original_flight_id, original_fare_id, new_flight_id,
+			new_fare_id, outcome, processed_at)
+		VALUES (:id, :booking_id, :original_flight_id, :original_fare_id, :new_flight_id,
+			:new_fare_id, :outcome, :processed_at)
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, rebooking); err != nil {
+		return apperror.Internal(err, "failed to record rebooking")
+	}
+	return nil
+}
+
+type postgresWaitlist struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresWaitlist) Get(ctx context.Context, id string) (*model.WaitlistEntry, error) {
+	return r.get(ctx, "SELECT * FROM waitlist_entries WHERE id = $1", id)
+}
+
+func (r postgresWaitlist) GetForUpdate(ctx context.Context, id string) (*model.WaitlistEntry, error) {
+	return r.get(ctx, "SELECT * FROM waitlist_entries WHERE id = $1 FOR UPDATE", id)
+}
+
+func (r postgresWaitlist) get(ctx context.Context, query, id string) (*model.WaitlistEntry, error) {
+	var entry model.WaitlistEntry
+	if err := sqlx.GetContext(ctx, r.q, &entry, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound("waitlist entry %s not found", id)
+		}
+		return nil, apperror.Internal(err, "failed to load waitlist entry")
+	}
+	return &entry, nil
+}
+
+func (r postgresWaitlist) NextWaitingForUpdate(ctx context.Context, fareID string) (*model.WaitlistEntry, error) {
+	var entry model.WaitlistEntry
+	err := sqlx.GetContext(ctx, r.q, &entry, `
+		SELECT * FROM waitlist_entries
+		WHERE fare_id = $1 AND status = $2
+		ORDER BY created_at, id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`, fareID, model.WaitlistStatusWaiting)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load waitlist")
+	}
+	return &entry, nil
+}
+
+func (r postgresWaitlist) ListByFlight(ctx context.Context, flightID string) ([]*model.WaitlistEntry, error) {
+	var entries []*model.WaitlistEntry
+	err := sqlx.SelectContext(ctx, r.q, &entries,
+		"SELECT * FROM waitlist_entries WHERE flight_id = $1 ORDER BY created_at, id", flightID)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load waitlist")
+	}
+	return entries, nil
+}
+
+func (r postgresWaitlist) ListExpiredOffers(ctx context.Context, now time.Time) ([]*model.WaitlistEntry, error) {
+	var entries []*model.WaitlistEntry
+	err := sqlx.SelectContext(ctx, r.q, &entries, `
+		SELECT * FROM waitlist_entries
+		WHERE status = $1 AND offer_expires_at < $2
+		ORDER BY offer_expires_at, id
+	`, model.WaitlistStatusOffered, now)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load expired offers")
+	}
+	return entries, nil
+}
+
+func (r postgresWaitlist) CountActiveByFares(ctx context.Context, fareIDs []string) (map[string]int, error) {
+	var rows []struct {
+		FareID string `db:"fare_id"`
+		Count  int    `db:"count"`
+	}
+	err := selectIn(ctx, r.q, &rows, `
+		SELECT fare_id, COUNT(*) AS count FROM waitlist_entries
+		WHERE fare_id IN (?) AND status IN (?)
+		GROUP BY fare_id
+	`, fareIDs, []model.WaitlistStatus{model.WaitlistStatusWaiting, model.WaitlistStatusOffered})
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to count waitlist entries")
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.FareID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r postgresWaitlist) CountWaitingAhead(ctx context.Context, entry *model.WaitlistEntry) (int, error) {
+	var ahead int
+	err := sqlx.GetContext(ctx, r.q, &ahead, `
+		SELECT COUNT(*) FROM waitlist_entries
+		WHERE fare_id = $1 AND status = $2 AND (created_at, id) < ($3, $4)
+	`, entry.FareID, model.WaitlistStatusWaiting, entry.CreatedAt, entry.ID)
+	if err != nil {
+		return 0, apperror.Internal(err, "failed to load waitlist position")
+	}
+	return ahead, nil
+}
+
+func (r postgresWaitlist) Create(ctx context.Context, entry *model.WaitlistEntry) error {
+	stamp(&entry.ID, &entry.CreatedAt, &entry.UpdatedAt)
+
+	query := `
+		INSERT INTO waitlist_entries (id, fare_id, flight_id, passenger_name, passenger_email,
+			passenger_phone, status, offer_expires_at, booking_id, user_id, created_at, updated_at)
+		VALUES (:id, :fare_id, :flight_id, :passenger_name, :passenger_email,
+			:passenger_phone, :status, :offer_expires_at, :booking_id, :user_id, :created_at, :updated_at)
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, entry); err != nil {
+		if database.IsUniqueViolation(err) {
+			return apperror.Conflict("%s is already on the waitlist for this fare", entry.PassengerEmail)
+		}
+		return apperror.Internal(err, "failed to join waitlist")
+	}
+	return nil
+}
+
+func (r postgresWaitlist) Update(ctx context.Context, entry *model.WaitlistEntry) error {
+	query := `
+		UPDATE waitlist_entries
+		SET status = :status, offer_expires_at = :offer_expires_at, booking_id = :booking_id, updated_at = :updated_at
+		WHERE id = :id
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, entry); err != nil {
+		return apperror.Internal(err, "failed to update waitlist entry")
+	}
+	return nil
+}
+
+type postgresAncillaries struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresAncillaries) ListProducts(ctx context.Context) ([]*model.AncillaryProduct, error) {
+	var products []*model.AncillaryProduct
+	if err := sqlx.SelectContext(ctx, r.q, &products, "SELECT * FROM ancillary_products ORDER BY category, name"); err != nil {
+		return nil, apperror.Internal(err, "failed to load ancillary products")
+	}
+	return products, nil
+}
+
+func (r postgresAncillaries) GetProduct(ctx context.Context, id string) (*model.AncillaryProduct, error) {
+	var product model.AncillaryProduct
+	if err := sqlx.GetContext(ctx, r.q, &product, "SELECT * FROM ancillary_products WHERE id = $1", id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound("ancillary product %s not found", id)
+		}
+		return nil, apperror.Internal(err, "failed to load ancillary product")
+	}
+	return &product, nil
+}
+
+func (r postgresAncillaries) ListProductsByIDs(ctx context.Context, ids []string) ([]*model.AncillaryProduct, error) {
+	var products []*model.AncillaryProduct
+	if err := selectIn(ctx, r.q, &products, "SELECT * FROM ancillary_products WHERE id IN (?)", ids); err != nil {
+		return nil, apperror.Internal(err, "failed to load ancillary products")
+	}
+	return products, nil
+}
+
+func (r postgresAncillaries) RoutePrices(ctx context.Context, origin, destination string) (map[string]float64, error) {
+	var rows []struct {
+		ProductID string  `db:"product_id"`
+		Price     float64 `db:"price"`
+	}
+	err := sqlx.SelectContext(ctx, r.q, &rows,
+		"SELECT product_id, price FROM ancillary_route_prices WHERE origin = $1 AND destination = $2",
+		origin, destination)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load ancillary prices")
+	}
+
+	prices := make(map[string]float64, len(rows))
+	for _, row := range rows {
+		prices[row.ProductID] = row.Price
+	}
+	return prices, nil
+}
+
+func (r postgresAncillaries) SoldByFlight(ctx context.Context, flightID string) (map[string]int, error) {
+	var rows []struct {
+		ProductID string `db:"product_id"`
+		Sold      int    `db:"sold"`
+	}
+	err := sqlx.SelectContext(ctx, r.q, &rows,
+		"SELECT product_id, sold FROM flight_ancillary_inventory WHERE flight_id = $1", flightID)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load ancillary inventory")
+	}
+
+	sold := make(map[string]int, len(rows))
+	for _, row := range rows {
+		sold[row.ProductID] = row.Sold
+	}
+	return sold, nil
+}
+
+func (r postgresAncillaries) GetSoldForUpdate(ctx context.Context, flightID, productID string) (int, error) {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO flight_ancillary_inventory (flight_id, product_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, flightID, productID)
+	if err != nil {
+		return 0, apperror.Internal(err, "failed to initialize ancillary inventory")
+	}
+
+	var sold int
+	err = sqlx.GetContext(ctx, r.q, &sold,
+		"SELECT sold FROM flight_ancillary_inventory WHERE flight_id = $1 AND product_id = $2 FOR UPDATE",
+		flightID, productID)
+	if err != nil {
+		return 0, apperror.Internal(err, "failed to load ancillary inventory")
+	}
+	return sold, nil
+}
+
+func (r postgresAncillaries) AddSold(ctx context.Context, flightID, productID string, quantity int) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO flight_ancillary_inventory (flight_id, product_id, sold) VALUES ($1, $2, GREATEST(0, $3::INTEGER))
+		ON CONFLICT (flight_id, product_id)
+		DO UPDATE SET sold = GREATEST(0, flight_ancillary_inventory.sold + $3::INTEGER)
+	`, flightID, productID, quantity)
+	if err != nil {
+		return apperror.Internal(err, "failed to update ancillary inventory")
+	}
+	return nil
+}
+
+func (r postgresAncillaries) ListLinesByBookings(ctx context.Context, bookingIDs []string) ([]*model.BookingAncillary, error) {
+	var lines []*model.BookingAncillary
+	err := selectIn(ctx, r.q, &lines,
+		"SELECT * FROM booking_ancillaries WHERE booking_id IN (?) ORDER BY created_at, id", bookingIDs)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load booking ancillaries")
+	}
+	return lines, nil
+}
+
+func (r postgresAncillaries) CreateLine(ctx context.Context, line *model.BookingAncillary) error {
+	stampOnce(&line.ID, &line.CreatedAt)
+
+	query := `
+		INSERT INTO booking_ancillaries (id, booking_id, product_id, quantity, unit_price, total_price, created_at)
+		VALUES (:id, :booking_id, :product_id, :quantity, :unit_price, :total_price, :created_at)
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, line); err != nil {
+		return apperror.Internal(err, "failed to add ancillary")
+	}
+	return nil
+}
+
+func (r postgresAncillaries) DeleteLines(ctx context.Context, bookingID, productID string) error {
+	_, err := r.q.ExecContext(ctx, "DELETE FROM booking_ancillaries WHERE booking_id = $1 AND product_id = $2",
+		bookingID, productID)
+	if err != nil {
+		return apperror.Internal(err, "failed to remove ancillary")
+	}
+	return nil
+}
+
+type postgresDocuments struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresDocuments) Get(ctx context.Context, bookingID string) ([]byte, error) {
+	var ciphertext []byte
+	err := sqlx.GetContext(ctx, r.q, &ciphertext, "SELECT ciphertext FROM passenger_documents WHERE booking_id = $1", bookingID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load document")
+	}
+	return ciphertext, nil
+}
+
+func (r postgresDocuments) ListByBookings(ctx context.Context, bookingIDs []string) (map[string][]byte, error) {
+	var rows []struct {
+		BookingID  string `db:"booking_id"`
+		Ciphertext []byte `db:"ciphertext"`
+	}
+	err := selectIn(ctx, r.q, &rows,
+		"SELECT booking_id, ciphertext FROM passenger_documents WHERE booking_id IN (?)", bookingIDs)
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load documents")
+	}
+
+	documents := make(map[string][]byte, len(rows))
+	for _, row := range rows {
+		documents[row.BookingID] = row.Ciphertext
+	}
+	return documents, nil
+}
+
+func (r postgresDocuments) Save(ctx context.Context, bookingID string, ciphertext []byte, now time.Time) error {
+	_, err := r.q.ExecContext(ctx, `
+		INSERT INTO passenger_documents (booking_id, ciphertext, created_at, updated_at)
+		VALUES ($1, $2, $3, $3)
+		ON CONFLICT (booking_id) DO UPDATE SET ciphertext = EXCLUDED.ciphertext, updated_at = EXCLUDED.updated_at
+	`, bookingID, ciphertext, now)
+	if err != nil {
+		return apperror.Internal(err, "failed to save document")
+	}
+	return nil
+}
+
+type postgresAirports struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresAirports) Countries(ctx context.Context, codes ...string) (map[string]string, error) {
+	var rows []struct {
+		Code    string `db:"code"`
+		Country string `db:"country"`
+	}
+	if err := selectIn(ctx, r.q, &rows, "SELECT code, country FROM airports WHERE code IN (?)", codes); err != nil {
+		return nil, apperror.Internal(err, "failed to load airports")
+	}
+
+	countries := make(map[string]string, len(rows))
+	for _, row := range rows {
+		countries[row.Code] = row.Country
+	}
+	return countries, nil
+}
+
+type postgresUsers struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresUsers) Get(ctx context.Context, id string) (*model.User, error) {
+	return r.get(ctx, "SELECT * FROM users WHERE id = $1", id)
+}
+
+func (r postgresUsers) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	return r.get(ctx, "SELECT * FROM users WHERE email = $1", email)
+}
+
+func (r postgresUsers) get(ctx context.Context, query, key string) (*model.User, error) {
+	var user model.User
+	if err := sqlx.GetContext(ctx, r.q, &user, query, key); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound("user %s not found", key)
+		}
+		return nil, apperror.Internal(err, "failed to load user")
+	}
+	return &user, nil
+}
+
+func (r postgresUsers) Create(ctx context.Context, user *model.User) error {
+	stamp(&user.ID, &user.CreatedAt, &user.UpdatedAt)
+
+	query := `
+		INSERT INTO users (id, email, name, password_hash, roles, created_at, updated_at)
+		VALUES (:id, :email, :name, :password_hash, :roles, :created_at, :updated_at)
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, user); err != nil {
+		if database.IsUniqueViolation(err) {
+			return apperror.Conflict("an account with this email already exists")
+		}
+		return apperror.Internal(err, "failed to create user")
+	}
+	return nil
+}
+
+type postgresRefreshTokens struct {
+	q sqlx.ExtContext
+}
+
+func (r postgresRefreshTokens) GetByHashForUpdate(ctx context.Context, hash []byte) (*model.RefreshToken, error) {
+	var token model.RefreshToken
+	err := sqlx.GetContext(ctx, r.q, &token, "SELECT * FROM refresh_tokens WHERE token_hash = $1 FOR UPDATE", hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperror.NotFound("refresh token not found")
+	}
+	if err != nil {
+		return nil, apperror.Internal(err, "failed to load refresh token")
+	}
+	return &token, nil
+}
+
+func (r postgresRefreshTokens) Create(ctx context.Context, token *model.RefreshToken) error {
+	stampOnce(&token.ID, &token.CreatedAt)
+
+	query := `
+		INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, revoked_at, created_at)
+		VALUES (:id, :user_id, :token_hash, :expires_at, :revoked_at, :created_at)
+	`
+	if _, err := sqlx.NamedExecContext(ctx, r.q, query, token); err != nil {
+		return apperror.Internal(err, "failed to store refresh token")
+	}
+	return nil
+}
+
+func (r postgresRefreshTokens) Revoke(ctx context.Context, hash []byte, now time.Time) error {
+	_, err := r.q.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = $1 WHERE token_hash = $2 AND revoked_at IS NULL", now, hash)
+	if err != nil {
+		return apperror.Internal(err, "failed to revoke refresh token")
+	}
+	return nil
+}
+
+func (r postgresRefreshTokens) RevokeAll(ctx context.Context, userID string, now time.Time) error {
+	_, err := r.q.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL", now, userID)
+	if err != nil {
+		return apperror.Internal(err, "failed to revoke refresh tokens")
+	}
+	return nil
+}
+
+// selectIn expands the IN (?) placeholders of query with keys, then args,
+// and scans the rows into dest. No keys select nothing.
+func selectIn(ctx context.Context, q sqlx.ExtContext, dest any, query string, keys []string, args ...any) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	query, args, err := sqlx.In(query, append([]any{keys}, args...)...)
+	if err != nil {
+		return err
+	}
+	return sqlx.SelectContext(ctx, q, dest, q.Rebind(query), args...)
+}
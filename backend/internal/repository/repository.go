@@ -0,0 +1,242 @@
+// Package repository stores the records of the airline. Store has a Postgres
+// implementation for the server and an in-memory one for tests and tools that
+// run without a database.
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+)
+
+// Store groups the repositories. The repositories of the Store passed to the
+// function of InTx share one transaction, which is committed when the
+// function returns nil and rolled back otherwise.
+type Store interface {
+	Flights() FlightRepository
+	Fares() FareRepository
+	Bookings() BookingRepository
+	FlightEvents() FlightEventRepository
+	Rebookings() RebookingRepository
+	Waitlist() WaitlistRepository
+	Ancillaries() AncillaryRepository
+	Documents() DocumentRepository
+	Airports() AirportRepository
+	Users() UserRepository
+	RefreshTokens() RefreshTokenRepository
+	InTx(ctx context.Context, fn func(tx Store) error) error
+}
+
+// FlightFilter selects the flights of List, ordered by departure. A zero
+// Limit lists every match.
+type FlightFilter struct {
+	Origin      *string
+	Destination *string
+	Status      *model.FlightStatus
+	// DepartsAfter and DepartsBy bound the scheduled departure, exclusive and
+	// inclusive respectively.
+	DepartsAfter *time.Time
+	DepartsBy    *time.Time
+	Limit        int
+}
+
+// BookingFilter selects the bookings of ListByUser, most recent first. A zero
+// Limit lists every match.
+type BookingFilter struct {
+	UserID         string
+	PassengerEmail *string
+	Limit          int
+}
+
+// Getters return NOT_FOUND errors for unknown records. The ForUpdate
+// variants also lock the records until the end of the transaction. The
+// ListBy variants taking several keys return the records of every key, in
+// no particular order unless documented.
+
+type FlightRepository interface {
+	Get(ctx context.Context, id string) (*model.Flight, error)
+	GetForUpdate(ctx context.Context, id string) (*model.Flight, error)
+	ListByIDs(ctx context.Context, ids []string) ([]*model.Flight, error)
+	List(ctx context.Context, filter FlightFilter) ([]*model.Flight, error)
+	// Airports lists every origin and destination, sorted.
+	Airports(ctx context.Context) ([]string, error)
+	// Create stores flight, filling its ID and timestamps when unset.
+	Create(ctx context.Context, flight *model.Flight) error
+	// Update saves the status, gate, delay and overbooking limit of flight.
+	Update(ctx context.Context, flight *model.Flight) error
+}
+
+type FareRepository interface {
+	Get(ctx context.Context, id string) (*model.Fare, error)
+	GetForUpdate(ctx context.Context, id string) (*model.Fare, error)
+	ListByIDs(ctx context.Context, ids []string) ([]*model.Fare, error)
+	// The fares of flights are listed cheapest first.
+	ListByFlight(ctx context.Context, flightID string) ([]*model.Fare, error)
+	ListByFlights(ctx context.Context, flightIDs []string) ([]*model.Fare, error)
+	ListByFlightsForUpdate(ctx context.Context, flightIDs []string) ([]*model.Fare, error)
+	// Create stores fare, filling its ID and timestamps when unset.
+	Create(ctx context.Context, fare *model.Fare) error
+	// Update saves the overbooking limit of fare. Seats only change through
+	// TakeSeat and ReleaseSeat.
+	Update(ctx context.Context, fare *model.Fare) error
+	// TakeSeat decrements the inventory of a fare.
+	TakeSeat(ctx context.Context, id string) error
+	// ReleaseSeat gives a seat back to the inventory of a fare.
+	ReleaseSeat(ctx context.Context, id string) error
+}
+
+type BookingRepository interface {
+	GetByReference(ctx context.Context, reference string) (*model.Booking, error)
+	GetByReferenceForUpdate(ctx context.Context, reference string) (*model.Booking, error)
+	ListByIDs(ctx context.Context, ids []string) ([]*model.Booking, error)
+	// Bookings of flights and fares are listed by booking time, cancelled
+	// ones included.
+	ListByFlight(ctx context.Context, flightID string) ([]*model.Booking, error)
+	ListByFlightForUpdate(ctx context.Context, flightID string) ([]*model.Booking, error)
+	ListByFlights(ctx context.Context, flightIDs []string) ([]*model.Booking, error)
+	ListByFares(ctx context.Context, fareIDs []string) ([]*model.Booking, error)
+	ListByUser(ctx context.Context, filter BookingFilter) ([]*model.Booking, error)
+	// CountHeldSeats counts the bookings holding a seat on a flight.
+	CountHeldSeats(ctx context.Context, flightID string) (int, error)
+	// Create stores b, filling its ID, reference and timestamps. Seat
+	// inventory is not touched; callers decide whether the seat comes from
+	// the fare or a hold.
+	Create(ctx context.Context, b *model.Booking) error
+	// Update saves what changes on a booking after it was made: its flight
+	// and fare, seat, status, check-in, price and denied-boarding choice.
+	Update(ctx context.Context, b *model.Booking) error
+	UpdateStatus(ctx context.Context, id string, status model.BookingStatus) error
+}
+
+type FlightEventRepository interface {
+	// ListByFlights returns the events of the flights, oldest first.
+	ListByFlights(ctx context.Context, flightIDs []string) ([]*model.FlightEvent, error)
+	// Create stores event, filling its ID and time when unset.
+	Create(ctx context.Context, event *model.FlightEvent) error
+}
+
+type RebookingRepository interface {
+	// ListByFlight returns the rebookings of a cancelled flight, the
+	// unaccommodated bookings first, then by processing time.
+	ListByFlight(ctx context.Context, originalFlightID string) ([]*model.Rebooking, error)
+	// Create stores rebooking, filling its ID when unset.
+	Create(ctx context.Context, rebooking *model.Rebooking) error
+}
+
+// Waitlist entries are active while WAITING or OFFERED. A passenger has at
+// most one active entry per fare.
+type WaitlistRepository interface {
+	Get(ctx context.Context, id string) (*model.WaitlistEntry, error)
+	GetForUpdate(ctx context.Context, id string) (*model.WaitlistEntry, error)
+	// NextWaitingForUpdate locks the entry of a fare that has been waiting the
+	// longest, skipping entries locked by other transactions. It returns nil
+	// when nobody is waiting.
+	NextWaitingForUpdate(ctx context.Context, fareID string) (*model.WaitlistEntry, error)
+	// ListByFlight lists the entries of a flight by queueing time.
+	ListByFlight(ctx context.Context, flightID string) ([]*model.WaitlistEntry, error)
+	// ListExpiredOffers lists the offers that expired before now, oldest
+	// first.
+	ListExpiredOffers(ctx context.Context, now time.Time) ([]*model.WaitlistEntry, error)
+	// CountActiveByFares counts the active entries of each fare. Fares without
+	// entries are left out.
+	CountActiveByFares(ctx context.Context, fareIDs []string) (map[string]int, error)
+	// CountWaitingAhead counts the entries of the fare of entry that started
+	// waiting before it.
+	CountWaitingAhead(ctx context.Context, entry *model.WaitlistEntry) (int, error)
+	// Create stores entry, filling its ID and timestamps when unset.
+	Create(ctx context.Context, entry *model.WaitlistEntry) error
+	// Update saves the status, offer expiry and booking of entry.
+	Update(ctx context.Context, entry *model.WaitlistEntry) error
+}
+
+type AncillaryRepository interface {
+	// ListProducts returns the catalog by category and name.
+	ListProducts(ctx context.Context) ([]*model.AncillaryProduct, error)
+	GetProduct(ctx context.Context, id string) (*model.AncillaryProduct, error)
+	ListProductsByIDs(ctx context.Context, ids []string) ([]*model.AncillaryProduct, error)
+	// RoutePrices returns the prices overriding the base price of products on
+	// a route, by product ID.
+	RoutePrices(ctx context.Context, origin, destination string) (map[string]float64, error)
+	// SoldByFlight returns the units of each product sold on a flight, by
+	// product ID. Products with nothing sold may be left out.
+	SoldByFlight(ctx context.Context, flightID string) (map[string]int, error)
+	// GetSoldForUpdate returns the units of a product sold on a flight and
+	// locks the count.
+	GetSoldForUpdate(ctx context.Context, flightID, productID string) (int, error)
+	// AddSold adds quantity, which may be negative, to the units of a product
+	// sold on a flight. The count never goes below zero.
+	AddSold(ctx context.Context, flightID, productID string, quantity int) error
+	// ListLinesByBookings returns the ancillaries bought on the bookings, by
+	// purchase time.
+	ListLinesByBookings(ctx context.Context, bookingIDs []string) ([]*model.BookingAncillary, error)
+	// CreateLine stores line, filling its ID and time when unset.
+	CreateLine(ctx context.Context, line *model.BookingAncillary) error
+	// DeleteLines removes every unit of a product from a booking.
+	DeleteLines(ctx context.Context, bookingID, productID string) error
+}
+
+// Documents are stored encrypted; the repository only sees ciphertext.
+type DocumentRepository interface {
+	// Get returns the document of a booking, nil when it has none.
+	Get(ctx context.Context, bookingID string) ([]byte, error)
+	// ListByBookings returns the documents of the bookings that have one, by
+	// booking ID.
+	ListByBookings(ctx context.Context, bookingIDs []string) (map[string][]byte, error)
+	// Save stores the document of a booking, replacing any previous one.
+	Save(ctx context.Context, bookingID string, ciphertext []byte, now time.Time) error
+}
+
+type AirportRepository interface {
+	// Countries returns the country of each known airport of codes.
+	Countries(ctx context.Context, codes ...string) (map[string]string, error)
+}
+
+type UserRepository interface {
+	Get(ctx context.Context, id string) (*model.User, error)
+	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	// Create stores user, filling its ID and timestamps when unset. Emails
+	// are unique.
+	Create(ctx context.Context, user *model.User) error
+}
+
+type RefreshTokenRepository interface {
+	GetByHashForUpdate(ctx context.Context, hash []byte) (*model.RefreshToken, error)
+	// Create stores token, filling its ID and creation time when unset.
+	Create(ctx context.Context, token *model.RefreshToken) error
+	// Revoke revokes a token unless it already was. Unknown hashes are
+	// ignored.
+	Revoke(ctx context.Context, hash []byte, now time.Time) error
+	// RevokeAll revokes every token of a user that is not revoked yet.
+	RevokeAll(ctx context.Context, userID string, now time.Time) error
+}
+
+// newID returns id, or a new UUID when it is empty.
+func newID(id string) string {
+	if id == "" {
+		return uuid.New().String()
+	}
+	return id
+}
+
+// stamp fills the ID and timestamps of a new record when they are unset.
+func stamp(id *string, createdAt, updatedAt *time.Time) {
+	*id = newID(*id)
+	now := time.Now()
+	if createdAt.IsZero() {
+		*createdAt = now
+	}
+	if updatedAt.IsZero() {
+		*updatedAt = *createdAt
+	}
+}
+
+// stampOnce fills the ID and time of a record that is never updated.
+func stampOnce(id *string, at *time.Time) {
+	*id = newID(*id)
+	if at.IsZero() {
+		*at = time.Now()
+	}
+}
@@ -0,0 +1,271 @@
+package waitlist
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidalecrim/red-airlines/internal/apperror"
+	"github.com/davidalecrim/red-airlines/internal/graph/model"
+	"github.com/davidalecrim/red-airlines/internal/overbooking"
+	"github.com/davidalecrim/red-airlines/internal/repository"
+)
+
+// OfferTTL is how long a promoted passenger holds a seat before the offer
+// expires and moves on to the next entry.
+const OfferTTL = 30 * time.Minute
+
+type Passenger struct {
+	Name  string
+	Email string
+	Phone *string
+}
+
+// Join queues a passenger on a sold-out fare. Fares that still have seats
+// must be booked directly. userID is the signed-in account joining, nil for
+// guests; the booking of an accepted offer belongs to it.
+func Join(ctx context.Context, store repository.Store, fareID string, passenger Passenger, userID *string, now time.Time) (*model.WaitlistEntry, error) {
+	var entry *model.WaitlistEntry
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		fare, err := tx.Fares().GetForUpdate(ctx, fareID)
+		if err != nil {
+			return err
+		}
+		flight, err := tx.Flights().Get(ctx, fare.FlightID)
+		if err != nil {
+			return err
+		}
+
+		if !flight.Status.IsOperable() {
+			return apperror.Conflict("flight %s is %s and can no longer be booked", flight.FlightNumber, flight.Status)
+		}
+		// Only fares that cannot sell another seat, overbooking included, have a
+		// waitlist.
+		if err := overbooking.CanSell(ctx, tx.Bookings(), flight, fare); err == nil {
+			return apperror.Validation("fare %s still has available seats, book it directly", fare.ID)
+		} else if apperror.CodeOf(err) != apperror.CodeSoldOut {
+			return err
+		}
+
+		entry = &model.WaitlistEntry{
+			FareID:         fare.ID,
+			FlightID:       fare.FlightID,
+			PassengerName:  passenger.Name,
+			PassengerEmail: passenger.Email,
+			PassengerPhone: passenger.Phone,
+			Status:         model.WaitlistStatusWaiting,
+			UserID:         userID,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		return tx.Waitlist().Create(ctx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// PromoteNext offers a seat of fareID to the first waiting passenger. It must
+// run in the transaction that released the seat to the fare inventory; the
+// seat is taken back from the inventory and held for the offer. Nobody is
+// promoted once the flight no longer operates.
+func PromoteNext(ctx context.Context, tx repository.Store, fareID string, now time.Time) (*model.WaitlistEntry, error) {
+	fare, err := tx.Fares().Get(ctx, fareID)
+	if err != nil {
+		return nil, err
+	}
+	flight, err := tx.Flights().GetForUpdate(ctx, fare.FlightID)
+	if err != nil {
+		return nil, err
+	}
+	if !flight.Status.IsOperable() {
+		return nil, nil
+	}
+
+	entry, err := tx.Waitlist().NextWaitingForUpdate(ctx, fareID)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	if err := tx.Fares().TakeSeat(ctx, fareID); err != nil {
+		return nil, err
+	}
+
+	expiresAt := now.Add(OfferTTL)
+	entry.Status = model.WaitlistStatusOffered
+	entry.OfferExpiresAt = &expiresAt
+	entry.UpdatedAt = now
+	if err := tx.Waitlist().Update(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Accept turns an offer into a confirmed booking on the held seat.
+func Accept(ctx context.Context, store repository.Store, entryID, passengerEmail string, now time.Time) (*model.Booking, error) {
+	var b *model.Booking
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		entry, err := tx.Waitlist().Get(ctx, entryID)
+		if err != nil {
+			return err
+		}
+		// The flight is locked before the entry, as a status change locks the
+		// flight before expiring its waitlist.
+		flight, err := tx.Flights().GetForUpdate(ctx, entry.FlightID)
+		if err != nil {
+			return err
+		}
+		entry, err = tx.Waitlist().GetForUpdate(ctx, entryID)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(entry.PassengerEmail, strings.TrimSpace(passengerEmail)) {
+			return apperror.NotFound("waitlist entry %s not found", entryID)
+		}
+
+		if !flight.Status.IsOperable() {
+			return apperror.Conflict("flight %s is %s and can no longer be booked", flight.FlightNumber, flight.Status)
+		}
+		if entry.Status != model.WaitlistStatusOffered {
+			return apperror.Conflict("waitlist entry is %s, there is no seat offer to accept", entry.Status)
+		}
+		if entry.OfferExpiresAt == nil || now.After(*entry.OfferExpiresAt) {
+			return apperror.Conflict("the seat offer expired")
+		}
+
+		fare, err := tx.Fares().Get(ctx, entry.FareID)
+		if err != nil {
+			return err
+		}
+
+		b = &model.Booking{
+			FlightID:       entry.FlightID,
+			FareID:         entry.FareID,
+			PassengerName:  entry.PassengerName,
+			PassengerEmail: entry.PassengerEmail,
+			PassengerPhone: entry.PassengerPhone,
+			BookingStatus:  model.BookingStatusConfirmed,
+			TotalPrice:     fare.Price,
+			UserID:         entry.UserID,
+			BookedAt:       now,
+		}
+		// The seat was already taken from the inventory when the offer was made.
+		if err := tx.Bookings().Create(ctx, b); err != nil {
+			return err
+		}
+
+		entry.Status = model.WaitlistStatusBooked
+		entry.BookingID = &b.ID
+		entry.UpdatedAt = now
+		return tx.Waitlist().Update(ctx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// ExpireOffers expires offers that were not accepted in time and passes
+// their held seats on to the next waiting passengers.
+func ExpireOffers(ctx context.Context, store repository.Store, now time.Time) (int, error) {
+	expired := 0
+	err := store.InTx(ctx, func(tx repository.Store) error {
+		offers, err := tx.Waitlist().ListExpiredOffers(ctx, now)
+		if err != nil {
+			return err
+		}
+
+		// Lock the flights first, in a fixed order, as every other writer of the
+		// waitlist does.
+		flightIDs := make([]string, 0, len(offers))
+		for _, offer := range offers {
+			flightIDs = append(flightIDs, offer.FlightID)
+		}
+		slices.Sort(flightIDs)
+		for _, id := range slices.Compact(flightIDs) {
+			if _, err := tx.Flights().GetForUpdate(ctx, id); err != nil {
+				return err
+			}
+		}
+
+		for _, offer := range offers {
+			// The offer may have been accepted or expired since it was listed.
+			entry, err := tx.Waitlist().GetForUpdate(ctx, offer.ID)
+			if err != nil {
+				return err
+			}
+			if entry.Status != model.WaitlistStatusOffered || entry.OfferExpiresAt == nil || !entry.OfferExpiresAt.Before(now) {
+				continue
+			}
+
+			entry.Status = model.WaitlistStatusExpired
+			entry.UpdatedAt = now
+			if err := tx.Waitlist().Update(ctx, entry); err != nil {
+				return err
+			}
+			if err := tx.Fares().ReleaseSeat(ctx, entry.FareID); err != nil {
+				return err
+			}
+			// PromoteNext leaves the seat alone once the flight stopped operating.
+			if _, err := PromoteNext(ctx, tx, entry.FareID, now); err != nil {
+				return err
+			}
+			expired++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+// ExpireFlight expires every waiting passenger and open offer of a flight that
+// no longer operates. It must run in the transaction that changed the flight
+// status. Held seats stay taken, as seats of a cancelled flight are not resold.
+func ExpireFlight(ctx context.Context, tx repository.Store, flightID string, now time.Time) (int, error) {
+	entries, err := tx.Waitlist().ListByFlight(ctx, flightID)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, entry := range entries {
+		if entry.Status != model.WaitlistStatusWaiting && entry.Status != model.WaitlistStatusOffered {
+			continue
+		}
+		entry.Status = model.WaitlistStatusExpired
+		entry.UpdatedAt = now
+		if err := tx.Waitlist().Update(ctx, entry); err != nil {
+			return 0, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// RunExpiry calls ExpireOffers every interval until ctx is cancelled.
+func RunExpiry(ctx context.Context, store repository.Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			count, err := ExpireOffers(ctx, store, now)
+			if err != nil {
+				log.Printf("Failed to expire waitlist offers: %v", err)
+				continue
+			}
+			if count > 0 {
+				log.Printf("Expired %d waitlist offers", count)
+			}
+		}
+	}
+}
@@ -0,0 +1,4 @@
+-- uuid-ossp is left installed, other schemas of the database may use it
+DROP TABLE IF EXISTS bookings;
+DROP TABLE IF EXISTS fares;
+DROP TABLE IF EXISTS flights;
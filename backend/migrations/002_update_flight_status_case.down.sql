@@ -0,0 +1,13 @@
+ALTER TABLE bookings
+ALTER COLUMN booking_status SET DEFAULT 'confirmed';
+
+UPDATE bookings
+SET booking_status = LOWER(booking_status)
+WHERE booking_status IS NOT NULL;
+
+ALTER TABLE flights
+ALTER COLUMN status SET DEFAULT 'scheduled';
+
+UPDATE flights
+SET status = LOWER(status)
+WHERE status IS NOT NULL;
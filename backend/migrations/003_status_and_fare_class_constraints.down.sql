@@ -0,0 +1,4 @@
+-- Fare classes stay uppercase, the original casing is not known anymore
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_booking_status_check;
+ALTER TABLE fares DROP CONSTRAINT IF EXISTS fares_fare_class_check;
+ALTER TABLE flights DROP CONSTRAINT IF EXISTS flights_status_check;
@@ -0,0 +1,20 @@
+-- Normalize fare classes produced by the seeder ('Promo', 'Basic', 'Pro')
+UPDATE fares
+SET fare_class = UPPER(fare_class)
+WHERE fare_class <> UPPER(fare_class);
+
+-- Restrict status and class columns to the values of the GraphQL enums
+ALTER TABLE flights DROP CONSTRAINT IF EXISTS flights_status_check;
+ALTER TABLE flights
+ADD CONSTRAINT flights_status_check
+CHECK (status IN ('SCHEDULED', 'BOARDING', 'DEPARTED', 'ARRIVED', 'CANCELLED'));
+
+ALTER TABLE fares DROP CONSTRAINT IF EXISTS fares_fare_class_check;
+ALTER TABLE fares
+ADD CONSTRAINT fares_fare_class_check
+CHECK (fare_class IN ('PROMO', 'BASIC', 'PRO'));
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_booking_status_check;
+ALTER TABLE bookings
+ADD CONSTRAINT bookings_booking_status_check
+CHECK (booking_status IN ('CONFIRMED', 'CANCELLED', 'CHECKED_IN', 'COMPLETED'));
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS flight_events;
+
+ALTER TABLE flights DROP COLUMN IF EXISTS delay_minutes;
+ALTER TABLE flights DROP COLUMN IF EXISTS gate;
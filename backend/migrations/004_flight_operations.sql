@@ -0,0 +1,19 @@
+-- Operational attributes of a flight
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS gate VARCHAR(10);
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS delay_minutes INTEGER NOT NULL DEFAULT 0;
+
+-- History of operational changes applied to a flight
+CREATE TABLE IF NOT EXISTS flight_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    flight_id UUID NOT NULL REFERENCES flights(id) ON DELETE CASCADE,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('STATUS_CHANGED', 'DELAYED', 'GATE_ASSIGNED')),
+    previous_status VARCHAR(20),
+    new_status VARCHAR(20),
+    gate VARCHAR(10),
+    delay_minutes INTEGER,
+    reason TEXT,
+    actor VARCHAR(100) NOT NULL,
+    occurred_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_flight_events_flight_id ON flight_events(flight_id, occurred_at);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_flights_route_departure;
+DROP TABLE IF EXISTS rebookings;
+
+-- Disrupted bookings have no seat, the closest earlier status is CANCELLED
+UPDATE bookings SET booking_status = 'CANCELLED' WHERE booking_status = 'DISRUPTED';
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_booking_status_check;
+ALTER TABLE bookings
+ADD CONSTRAINT bookings_booking_status_check
+CHECK (booking_status IN ('CONFIRMED', 'CANCELLED', 'CHECKED_IN', 'COMPLETED'));
@@ -0,0 +1,21 @@
+-- Bookings on a cancelled flight that could not be moved
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_booking_status_check;
+ALTER TABLE bookings
+ADD CONSTRAINT bookings_booking_status_check
+CHECK (booking_status IN ('CONFIRMED', 'CANCELLED', 'CHECKED_IN', 'COMPLETED', 'DISRUPTED'));
+
+-- Outcome of re-accommodating each booking of a cancelled flight
+CREATE TABLE IF NOT EXISTS rebookings (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    original_flight_id UUID NOT NULL REFERENCES flights(id) ON DELETE CASCADE,
+    original_fare_id UUID NOT NULL REFERENCES fares(id) ON DELETE CASCADE,
+    new_flight_id UUID REFERENCES flights(id) ON DELETE SET NULL,
+    new_fare_id UUID REFERENCES fares(id) ON DELETE SET NULL,
+    outcome VARCHAR(20) NOT NULL CHECK (outcome IN ('REBOOKED', 'UNACCOMMODATED')),
+    processed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_rebookings_original_flight_id ON rebookings(original_flight_id);
+CREATE INDEX IF NOT EXISTS idx_rebookings_booking_id ON rebookings(booking_id);
+CREATE INDEX IF NOT EXISTS idx_flights_route_departure ON flights(origin, destination, departure_time);
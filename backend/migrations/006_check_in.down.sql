@@ -0,0 +1,2 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS check_in_sequence;
+ALTER TABLE bookings DROP COLUMN IF EXISTS checked_in_at;
@@ -0,0 +1,3 @@
+-- Online check-in
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS check_in_sequence INTEGER;
@@ -0,0 +1,21 @@
+-- Passengers waiting for a seat on a sold-out fare
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    fare_id UUID NOT NULL REFERENCES fares(id) ON DELETE CASCADE,
+    flight_id UUID NOT NULL REFERENCES flights(id) ON DELETE CASCADE,
+    passenger_name VARCHAR(100) NOT NULL,
+    passenger_email VARCHAR(100) NOT NULL,
+    passenger_phone VARCHAR(20),
+    status VARCHAR(20) NOT NULL DEFAULT 'WAITING' CHECK (status IN ('WAITING', 'OFFERED', 'BOOKED', 'EXPIRED')),
+    offer_expires_at TIMESTAMP,
+    booking_id UUID REFERENCES bookings(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_fare_status ON waitlist_entries(fare_id, status, created_at);
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_offer_expires_at ON waitlist_entries(offer_expires_at) WHERE status = 'OFFERED';
+
+-- A passenger can only queue once per fare at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_waitlist_entries_active_passenger
+ON waitlist_entries(fare_id, passenger_email) WHERE status IN ('WAITING', 'OFFERED');
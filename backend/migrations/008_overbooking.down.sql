@@ -0,0 +1,9 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS denied_boarding_volunteer;
+
+ALTER TABLE fares DROP CONSTRAINT IF EXISTS fares_overbooking_check;
+ALTER TABLE fares DROP COLUMN IF EXISTS overbooking_percent;
+ALTER TABLE fares DROP COLUMN IF EXISTS overbooking_seats;
+
+ALTER TABLE flights DROP CONSTRAINT IF EXISTS flights_overbooking_check;
+ALTER TABLE flights DROP COLUMN IF EXISTS overbooking_percent;
+ALTER TABLE flights DROP COLUMN IF EXISTS overbooking_seats;
@@ -0,0 +1,28 @@
+-- Overbooking limits, either absolute or as a percentage of total_seats.
+-- A fare without its own limit uses the limit of its flight.
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS overbooking_seats INTEGER;
+ALTER TABLE flights ADD COLUMN IF NOT EXISTS overbooking_percent DECIMAL(5, 2);
+ALTER TABLE fares ADD COLUMN IF NOT EXISTS overbooking_seats INTEGER;
+ALTER TABLE fares ADD COLUMN IF NOT EXISTS overbooking_percent DECIMAL(5, 2);
+
+-- Passengers willing to give up their seat on an oversold flight
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS denied_boarding_volunteer BOOLEAN NOT NULL DEFAULT false;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'flights_overbooking_check') THEN
+        ALTER TABLE flights
+        ADD CONSTRAINT flights_overbooking_check
+        CHECK (overbooking_seats >= 0 AND overbooking_percent IS NULL
+            OR overbooking_percent BETWEEN 0 AND 100 AND overbooking_seats IS NULL
+            OR overbooking_seats IS NULL AND overbooking_percent IS NULL);
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fares_overbooking_check') THEN
+        ALTER TABLE fares
+        ADD CONSTRAINT fares_overbooking_check
+        CHECK (overbooking_seats >= 0 AND overbooking_percent IS NULL
+            OR overbooking_percent BETWEEN 0 AND 100 AND overbooking_seats IS NULL
+            OR overbooking_seats IS NULL AND overbooking_percent IS NULL);
+    END IF;
+END $$;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS booking_ancillaries;
+DROP TABLE IF EXISTS flight_ancillary_inventory;
+DROP TABLE IF EXISTS ancillary_route_prices;
+DROP TABLE IF EXISTS ancillary_products;
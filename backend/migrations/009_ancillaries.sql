@@ -0,0 +1,56 @@
+-- Ancillary products sold on top of a fare
+CREATE TABLE IF NOT EXISTS ancillary_products (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(30) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    category VARCHAR(20) NOT NULL
+        CHECK (category IN ('BAGGAGE', 'MEAL', 'PRIORITY_BOARDING', 'SEAT_SELECTION', 'LOUNGE_PASS')),
+    description TEXT NOT NULL DEFAULT '',
+    base_price DECIMAL(10, 2) NOT NULL CHECK (base_price >= 0),
+    max_per_booking INTEGER NOT NULL CHECK (max_per_booking > 0),
+    allowed_fare_classes TEXT[] NOT NULL DEFAULT '{PROMO,BASIC,PRO}',
+    -- Units available per flight, NULL for products without inventory
+    flight_inventory INTEGER CHECK (flight_inventory >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Route-specific prices overriding base_price
+CREATE TABLE IF NOT EXISTS ancillary_route_prices (
+    product_id UUID NOT NULL REFERENCES ancillary_products(id) ON DELETE CASCADE,
+    origin VARCHAR(3) NOT NULL,
+    destination VARCHAR(3) NOT NULL,
+    price DECIMAL(10, 2) NOT NULL CHECK (price >= 0),
+    PRIMARY KEY (product_id, origin, destination)
+);
+
+-- Units sold per flight for products with inventory
+CREATE TABLE IF NOT EXISTS flight_ancillary_inventory (
+    flight_id UUID NOT NULL REFERENCES flights(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES ancillary_products(id) ON DELETE CASCADE,
+    sold INTEGER NOT NULL DEFAULT 0 CHECK (sold >= 0),
+    PRIMARY KEY (flight_id, product_id)
+);
+
+-- Ancillaries bought on a booking, priced at purchase time
+CREATE TABLE IF NOT EXISTS booking_ancillaries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES ancillary_products(id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_price DECIMAL(10, 2) NOT NULL,
+    total_price DECIMAL(10, 2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_ancillaries_booking_id ON booking_ancillaries(booking_id);
+
+-- Default catalog
+INSERT INTO ancillary_products (code, name, category, description, base_price, max_per_booking, allowed_fare_classes, flight_inventory)
+VALUES
+    ('EXTRA_BAG', 'Extra checked bag', 'BAGGAGE', 'One additional checked bag up to 23 kg', 45.00, 3, '{PROMO,BASIC,PRO}', NULL),
+    ('HOT_MEAL', 'Hot meal', 'MEAL', 'Hot meal with a soft drink', 18.00, 2, '{PROMO,BASIC,PRO}', NULL),
+    ('PRIORITY_BOARDING', 'Priority boarding', 'PRIORITY_BOARDING', 'Board in the first group', 15.00, 1, '{PROMO,BASIC}', NULL),
+    ('SEAT_SELECTION', 'Seat selection', 'SEAT_SELECTION', 'Choose your seat before check-in', 20.00, 1, '{BASIC,PRO}', NULL),
+    ('LOUNGE_PASS', 'Lounge pass', 'LOUNGE_PASS', 'Departure lounge access', 50.00, 2, '{PROMO,BASIC,PRO}', 20)
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS passenger_documents;
+DROP TABLE IF EXISTS airports;
@@ -0,0 +1,23 @@
+-- Country of each airport, used to tell international routes apart
+CREATE TABLE IF NOT EXISTS airports (
+    code VARCHAR(3) PRIMARY KEY,
+    country CHAR(3) NOT NULL
+);
+
+INSERT INTO airports (code, country)
+VALUES
+    ('JFK', 'USA'), ('LAX', 'USA'), ('ORD', 'USA'), ('DFW', 'USA'),
+    ('ATL', 'USA'), ('BOS', 'USA'), ('SEA', 'USA'), ('MIA', 'USA'),
+    ('SFO', 'USA'), ('LAS', 'USA'), ('DEN', 'USA'), ('PHX', 'USA'),
+    ('IAD', 'USA'), ('SAN', 'USA'), ('DAL', 'USA'), ('EWR', 'USA'),
+    ('YYZ', 'CAN'), ('MEX', 'MEX'), ('LHR', 'GBR'), ('CDG', 'FRA'),
+    ('GRU', 'BRA'), ('NRT', 'JPN')
+ON CONFLICT (code) DO NOTHING;
+
+-- Passenger identity documents, encrypted by the application (AES-256-GCM)
+CREATE TABLE IF NOT EXISTS passenger_documents (
+    booking_id UUID PRIMARY KEY REFERENCES bookings(id) ON DELETE CASCADE,
+    ciphertext BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+-- Free-text requests shown to ground staff (wheelchair, infant, dietary, ...)
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS special_requests VARCHAR(500);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_bookings_user_id;
+ALTER TABLE bookings DROP COLUMN IF EXISTS user_id;
+
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,12 @@
+-- Roles of an account. Staff roles are granted by hand, e.g.
+-- UPDATE users SET roles = '{CUSTOMER,AGENT}' WHERE email = '...';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS roles TEXT[] NOT NULL DEFAULT '{CUSTOMER}';
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'users_roles_check') THEN
+        ALTER TABLE users
+        ADD CONSTRAINT users_roles_check
+        CHECK (roles <@ ARRAY['CUSTOMER', 'AGENT', 'OPS']::TEXT[]);
+    END IF;
+END $$;
@@ -0,0 +1,10 @@
+-- Token buckets of the Postgres rate limiter backend, shared by all server
+-- instances. Rows past idle_at are full again and can be deleted.
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key VARCHAR(200) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    idle_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_idle_at ON rate_limit_buckets(idle_at);
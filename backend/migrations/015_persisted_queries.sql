@@ -0,0 +1,6 @@
+-- Automatic persisted queries shared by all server instances
+CREATE TABLE IF NOT EXISTS persisted_queries (
+    hash CHAR(64) PRIMARY KEY,
+    query TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_persisted_queries_last_used_at;
+ALTER TABLE persisted_queries DROP COLUMN IF EXISTS last_used_at;
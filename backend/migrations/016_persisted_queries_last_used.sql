@@ -0,0 +1,5 @@
+-- When each persisted query was last registered or loaded by an instance, so
+-- queries no client sends any more can be pruned.
+ALTER TABLE persisted_queries ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_persisted_queries_last_used_at ON persisted_queries(last_used_at);
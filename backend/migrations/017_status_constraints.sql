@@ -0,0 +1,24 @@
+-- Recreate the status and fare class constraints where they are missing, with
+-- the values of the current enums. Runners before schema_migrations re-ran
+-- 003 on every start, and a run that failed after dropping a constraint left
+-- the table without one.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'flights_status_check') THEN
+        ALTER TABLE flights
+        ADD CONSTRAINT flights_status_check
+        CHECK (status IN ('SCHEDULED', 'BOARDING', 'DEPARTED', 'ARRIVED', 'CANCELLED'));
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fares_fare_class_check') THEN
+        ALTER TABLE fares
+        ADD CONSTRAINT fares_fare_class_check
+        CHECK (fare_class IN ('PROMO', 'BASIC', 'PRO'));
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'bookings_booking_status_check') THEN
+        ALTER TABLE bookings
+        ADD CONSTRAINT bookings_booking_status_check
+        CHECK (booking_status IN ('CONFIRMED', 'CANCELLED', 'CHECKED_IN', 'COMPLETED', 'DISRUPTED'));
+    END IF;
+END $$;
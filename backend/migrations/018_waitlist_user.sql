@@ -0,0 +1,3 @@
+-- Waitlist entries joined while signed in belong to the account, as do the
+-- bookings they turn into
+ALTER TABLE waitlist_entries ADD COLUMN IF NOT EXISTS user_id UUID REFERENCES users(id) ON DELETE SET NULL;
@@ -0,0 +1,10 @@
+// Package migrations embeds the SQL migrations, so the binaries that run them
+// do not depend on the working directory.
+package migrations
+
+import "embed"
+
+// FS holds the .sql files of this directory at its root.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,80 @@
+import { useState } from 'react';
+import type { FormEvent } from 'react';
+import { useMutation } from 'urql';
+import { LoginDocument, Role } from '../generated/graphql';
+import { saveAccessToken } from '../lib/auth';
+
+interface AdminLoginProps {
+  onSignIn: (accessToken: string) => void;
+}
+
+export function AdminLogin({ onSignIn }: AdminLoginProps) {
+  const [, login] = useMutation(LoginDocument);
+
+  const [email, setEmail] = useState('');
+  const [password, setPassword] = useState('');
+  const [submitError, setSubmitError] = useState<string | null>(null);
+  const [isSubmitting, setIsSubmitting] = useState(false);
+
+  const handleSubmit = async (e: FormEvent) => {
+    e.preventDefault();
+    setSubmitError(null);
+    setIsSubmitting(true);
+
+    const result = await login({ email: email.trim(), password });
+    setIsSubmitting(false);
+
+    if (result.error) {
+      setSubmitError(result.error.message);
+      return;
+    }
+    if (!result.data) return;
+
+    // Passenger lists need the AGENT role; the API would refuse them anyway.
+    const { accessToken, accessTokenExpiresAt, user } = result.data.login;
+    if (!user.roles.includes(Role.Agent)) {
+      setSubmitError('Only agents can view flight bookings.');
+      return;
+    }
+    saveAccessToken(accessToken, accessTokenExpiresAt);
+    onSignIn(accessToken);
+  };
+
+  return (
+    <div className="booking-form-container">
+      <h2>Agent Sign In</h2>
+
+      {submitError && <div className="error-message">{submitError}</div>}
+
+      <form onSubmit={handleSubmit} className="booking-form">
+        <div className="form-group">
+          <label htmlFor="email">Email</label>
+          <input
+            type="email"
+            id="email"
+            value={email}
+            onChange={(e) => setEmail(e.target.value)}
+            required
+            disabled={isSubmitting}
+          />
+        </div>
+
+        <div className="form-group">
+          <label htmlFor="password">Password</label>
+          <input
+            type="password"
+            id="password"
+            value={password}
+            onChange={(e) => setPassword(e.target.value)}
+            required
+            disabled={isSubmitting}
+          />
+        </div>
+
+        <button type="submit" className="btn btn-primary" disabled={isSubmitting}>
+          {isSubmitting ? 'Signing in...' : 'Sign In'}
+        </button>
+      </form>
+    </div>
+  );
+}
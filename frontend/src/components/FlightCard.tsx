@@ -1,8 +1,9 @@
+import { FareClass } from '../generated/graphql';
 import { formatTime, formatDate, formatDuration, formatPrice } from '../utils/formatters';
 
 interface Fare {
   id: string;
-  fareClass: string;
+  fareClass: FareClass;
   price: number;
   availableSeats: number;
 }
@@ -31,9 +32,9 @@ export function FlightCard({
   fares,
   onClick,
 }: FlightCardProps) {
-  const promoFare = fares.find((f) => f.fareClass === 'promo');
-  const basicFare = fares.find((f) => f.fareClass === 'basic');
-  const proFare = fares.find((f) => f.fareClass === 'pro');
+  const promoFare = fares.find((f) => f.fareClass === FareClass.Promo);
+  const basicFare = fares.find((f) => f.fareClass === FareClass.Basic);
+  const proFare = fares.find((f) => f.fareClass === FareClass.Pro);
 
   return (
     <div className="flight-card" onClick={onClick}>
@@ -0,0 +1,46 @@
+/* eslint-disable */
+import * as types from './graphql';
+import type { TypedDocumentNode as DocumentNode } from '@graphql-typed-document-node/core';
+
+/**
+ * Map of all GraphQL operations in the project.
+ *
+ * This map has several performance disadvantages:
+ * 1. It is not tree-shakeable, so it will include all operations in the project.
+ * 2. It is not minifiable, so the string of a GraphQL query will be multiple times inside the bundle.
+ * 3. It does not support dead code elimination, so it will add unused operations.
+ *
+ * Therefore it is highly recommended to use the babel or swc plugin for production.
+ * Learn more about it here: https://the-guild.dev/graphql/codegen/plugins/presets/preset-client#reducing-bundle-size
+ */
+type Documents = {
+    "query SearchFlights($origin: String, $destination: String, $limit: Int) {\n  flights(origin: $origin, destination: $destination, limit: $limit) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    availableSeats\n    status\n    fares {\n      id\n      fareClass\n      price\n      availableSeats\n    }\n  }\n}\n\nquery GetFlightDetails($id: ID!) {\n  flight(id: $id) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    totalSeats\n    availableSeats\n    status\n    fares {\n      id\n      fareClass\n      price\n      baggageAllowance\n      isRefundable\n      isChangeable\n      availableSeats\n    }\n  }\n}\n\nquery GetAirports {\n  airports\n}\n\nquery GetFlightWithBookings($id: ID!) {\n  flight(id: $id) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    totalSeats\n    availableSeats\n    status\n    bookings {\n      id\n      bookingReference\n      passengerName\n      passengerEmail\n      passengerPhone\n      seatNumber\n      totalPrice\n      bookingStatus\n      bookedAt\n      fare {\n        fareClass\n        price\n      }\n    }\n  }\n}\n\nquery GetBookingByReference($bookingReference: String!) {\n  booking(bookingReference: $bookingReference) {\n    id\n    bookingReference\n    passengerName\n    passengerEmail\n    passengerPhone\n    seatNumber\n    totalPrice\n    bookingStatus\n    bookedAt\n    flight {\n      flightNumber\n      origin\n      destination\n      departureTime\n      arrivalTime\n      aircraftType\n      status\n    }\n    fare {\n      fareClass\n      price\n      baggageAllowance\n      isRefundable\n      isChangeable\n    }\n  }\n}\n\nmutation CreateBooking($input: CreateBookingInput!) {\n  createBooking(input: $input) {\n    booking {\n      id\n      bookingReference\n      passengerName\n      passengerEmail\n      passengerPhone\n      seatNumber\n      totalPrice\n      bookingStatus\n      bookedAt\n      flight {\n        id\n        flightNumber\n        origin\n        destination\n        departureTime\n        arrivalTime\n        aircraftType\n      }\n      fare {\n        id\n        fareClass\n        price\n        baggageAllowance\n        isRefundable\n        isChangeable\n      }\n    }\n    token\n    expiresAt\n  }\n}\n\nmutation Login($email: String!, $password: String!) {\n  login(email: $email, password: $password) {\n    accessToken\n    accessTokenExpiresAt\n    user {\n      id\n      email\n      name\n      roles\n    }\n  }\n}\n": typeof types.SearchFlightsDocument,
+};
+const documents: Documents = {
+    "query SearchFlights($origin: String, $destination: String, $limit: Int) {\n  flights(origin: $origin, destination: $destination, limit: $limit) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    availableSeats\n    status\n    fares {\n      id\n      fareClass\n      price\n      availableSeats\n    }\n  }\n}\n\nquery GetFlightDetails($id: ID!) {\n  flight(id: $id) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    totalSeats\n    availableSeats\n    status\n    fares {\n      id\n      fareClass\n      price\n      baggageAllowance\n      isRefundable\n      isChangeable\n      availableSeats\n    }\n  }\n}\n\nquery GetAirports {\n  airports\n}\n\nquery GetFlightWithBookings($id: ID!) {\n  flight(id: $id) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    totalSeats\n    availableSeats\n    status\n    bookings {\n      id\n      bookingReference\n      passengerName\n      passengerEmail\n      passengerPhone\n      seatNumber\n      totalPrice\n      bookingStatus\n      bookedAt\n      fare {\n        fareClass\n        price\n      }\n    }\n  }\n}\n\nquery GetBookingByReference($bookingReference: String!) {\n  booking(bookingReference: $bookingReference) {\n    id\n    bookingReference\n    passengerName\n    passengerEmail\n    passengerPhone\n    seatNumber\n    totalPrice\n    bookingStatus\n    bookedAt\n    flight {\n      flightNumber\n      origin\n      destination\n      departureTime\n      arrivalTime\n      aircraftType\n      status\n    }\n    fare {\n      fareClass\n      price\n      baggageAllowance\n      isRefundable\n      isChangeable\n    }\n  }\n}\n\nmutation CreateBooking($input: CreateBookingInput!) {\n  createBooking(input: $input) {\n    booking {\n      id\n      bookingReference\n      passengerName\n      passengerEmail\n      passengerPhone\n      seatNumber\n      totalPrice\n      bookingStatus\n      bookedAt\n      flight {\n        id\n        flightNumber\n        origin\n        destination\n        departureTime\n        arrivalTime\n        aircraftType\n      }\n      fare {\n        id\n        fareClass\n        price\n        baggageAllowance\n        isRefundable\n        isChangeable\n      }\n    }\n    token\n    expiresAt\n  }\n}\n\nmutation Login($email: String!, $password: String!) {\n  login(email: $email, password: $password) {\n    accessToken\n    accessTokenExpiresAt\n    user {\n      id\n      email\n      name\n      roles\n    }\n  }\n}\n": types.SearchFlightsDocument,
+};
+
+/**
+ * The graphql function is used to parse GraphQL queries into a document that can be used by GraphQL clients.
+ *
+ *
+ * @example
+ * ```ts
+ * const query = graphql(`query GetUser($id: ID!) { user(id: $id) { name } }`);
+ * ```
+ *
+ * The query argument is unknown!
+ * Please regenerate the types.
+ */
+export function graphql(source: string): unknown;
+
+/**
+ * The graphql function is used to parse GraphQL queries into a document that can be used by GraphQL clients.
+ */
+export function graphql(source: "query SearchFlights($origin: String, $destination: String, $limit: Int) {\n  flights(origin: $origin, destination: $destination, limit: $limit) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    availableSeats\n    status\n    fares {\n      id\n      fareClass\n      price\n      availableSeats\n    }\n  }\n}\n\nquery GetFlightDetails($id: ID!) {\n  flight(id: $id) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    totalSeats\n    availableSeats\n    status\n    fares {\n      id\n      fareClass\n      price\n      baggageAllowance\n      isRefundable\n      isChangeable\n      availableSeats\n    }\n  }\n}\n\nquery GetAirports {\n  airports\n}\n\nquery GetFlightWithBookings($id: ID!) {\n  flight(id: $id) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    totalSeats\n    availableSeats\n    status\n    bookings {\n      id\n      bookingReference\n      passengerName\n      passengerEmail\n      passengerPhone\n      seatNumber\n      totalPrice\n      bookingStatus\n      bookedAt\n      fare {\n        fareClass\n        price\n      }\n    }\n  }\n}\n\nquery GetBookingByReference($bookingReference: String!) {\n  booking(bookingReference: $bookingReference) {\n    id\n    bookingReference\n    passengerName\n    passengerEmail\n    passengerPhone\n    seatNumber\n    totalPrice\n    bookingStatus\n    bookedAt\n    flight {\n      flightNumber\n      origin\n      destination\n      departureTime\n      arrivalTime\n      aircraftType\n      status\n    }\n    fare {\n      fareClass\n      price\n      baggageAllowance\n      isRefundable\n      isChangeable\n    }\n  }\n}\n\nmutation CreateBooking($input: CreateBookingInput!) {\n  createBooking(input: $input) {\n    booking {\n      id\n      bookingReference\n      passengerName\n      passengerEmail\n      passengerPhone\n      seatNumber\n      totalPrice\n      bookingStatus\n      bookedAt\n      flight {\n        id\n        flightNumber\n        origin\n        destination\n        departureTime\n        arrivalTime\n        aircraftType\n      }\n      fare {\n        id\n        fareClass\n        price\n        baggageAllowance\n        isRefundable\n        isChangeable\n      }\n    }\n    token\n    expiresAt\n  }\n}\n\nmutation Login($email: String!, $password: String!) {\n  login(email: $email, password: $password) {\n    accessToken\n    accessTokenExpiresAt\n    user {\n      id\n      email\n      name\n      roles\n    }\n  }\n}\n"): (typeof documents)["query SearchFlights($origin: String, $destination: String, $limit: Int) {\n  flights(origin: $origin, destination: $destination, limit: $limit) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    availableSeats\n    status\n    fares {\n      id\n      fareClass\n      price\n      availableSeats\n    }\n  }\n}\n\nquery GetFlightDetails($id: ID!) {\n  flight(id: $id) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    totalSeats\n    availableSeats\n    status\n    fares {\n      id\n      fareClass\n      price\n      baggageAllowance\n      isRefundable\n      isChangeable\n      availableSeats\n    }\n  }\n}\n\nquery GetAirports {\n  airports\n}\n\nquery GetFlightWithBookings($id: ID!) {\n  flight(id: $id) {\n    id\n    flightNumber\n    origin\n    destination\n    departureTime\n    arrivalTime\n    aircraftType\n    totalSeats\n    availableSeats\n    status\n    bookings {\n      id\n      bookingReference\n      passengerName\n      passengerEmail\n      passengerPhone\n      seatNumber\n      totalPrice\n      bookingStatus\n      bookedAt\n      fare {\n        fareClass\n        price\n      }\n    }\n  }\n}\n\nquery GetBookingByReference($bookingReference: String!) {\n  booking(bookingReference: $bookingReference) {\n    id\n    bookingReference\n    passengerName\n    passengerEmail\n    passengerPhone\n    seatNumber\n    totalPrice\n    bookingStatus\n    bookedAt\n    flight {\n      flightNumber\n      origin\n      destination\n      departureTime\n      arrivalTime\n      aircraftType\n      status\n    }\n    fare {\n      fareClass\n      price\n      baggageAllowance\n      isRefundable\n      isChangeable\n    }\n  }\n}\n\nmutation CreateBooking($input: CreateBookingInput!) {\n  createBooking(input: $input) {\n    booking {\n      id\n      bookingReference\n      passengerName\n      passengerEmail\n      passengerPhone\n      seatNumber\n      totalPrice\n      bookingStatus\n      bookedAt\n      flight {\n        id\n        flightNumber\n        origin\n        destination\n        departureTime\n        arrivalTime\n        aircraftType\n      }\n      fare {\n        id\n        fareClass\n        price\n        baggageAllowance\n        isRefundable\n        isChangeable\n      }\n    }\n    token\n    expiresAt\n  }\n}\n\nmutation Login($email: String!, $password: String!) {\n  login(email: $email, password: $password) {\n    accessToken\n    accessTokenExpiresAt\n    user {\n      id\n      email\n      name\n      roles\n    }\n  }\n}\n"];
+
+export function graphql(source: string) {
+  return (documents as any)[source] ?? {};
+}
+
+export type DocumentType<TDocumentNode extends DocumentNode<any, any>> = TDocumentNode extends DocumentNode<  infer TType,  any>  ? TType  : never;
\ No newline at end of file
@@ -1,3 +1,4 @@
+/* eslint-disable */
 import type { TypedDocumentNode as DocumentNode } from '@graphql-typed-document-node/core';
 export type Maybe<T> = T | null;
 export type InputMaybe<T> = Maybe<T>;
@@ -16,11 +17,79 @@ export type Scalars = {
   Time: { input: any; output: any; }
 };
 
+export type AcceptWaitlistOfferPayload = {
+  __typename?: 'AcceptWaitlistOfferPayload';
+  booking: Booking;
+  expiresAt?: Maybe<Scalars['Time']['output']>;
+  /** Bearer token for the booking mutations on the new booking, as createBooking returns; null when the waitlist was joined signed in. */
+  token?: Maybe<Scalars['String']['output']>;
+};
+
+export enum AncillaryCategory {
+  Baggage = 'BAGGAGE',
+  LoungePass = 'LOUNGE_PASS',
+  Meal = 'MEAL',
+  PriorityBoarding = 'PRIORITY_BOARDING',
+  SeatSelection = 'SEAT_SELECTION'
+}
+
+export type AncillaryOffer = {
+  __typename?: 'AncillaryOffer';
+  /** Price on the flight's route. */
+  price: Scalars['Float']['output'];
+  product: AncillaryProduct;
+  /** Units left on the flight, null when the product has no inventory. */
+  remaining?: Maybe<Scalars['Int']['output']>;
+};
+
+export type AncillaryProduct = {
+  __typename?: 'AncillaryProduct';
+  allowedFareClasses: Array<FareClass>;
+  basePrice: Scalars['Float']['output'];
+  category: AncillaryCategory;
+  code: Scalars['String']['output'];
+  description: Scalars['String']['output'];
+  id: Scalars['ID']['output'];
+  maxPerBooking: Scalars['Int']['output'];
+  name: Scalars['String']['output'];
+};
+
+export type AssignGateInput = {
+  flightId: Scalars['ID']['input'];
+  gate: Scalars['String']['input'];
+};
+
+export type AuthPayload = {
+  __typename?: 'AuthPayload';
+  /** Bearer token for the Authorization header. */
+  accessToken: Scalars['String']['output'];
+  accessTokenExpiresAt: Scalars['Time']['output'];
+  /** Single-use token for refreshToken; a new one is returned every time. */
+  refreshToken: Scalars['String']['output'];
+  user: User;
+};
+
+export type BoardingPass = {
+  __typename?: 'BoardingPass';
+  /** IATA Bar Coded Boarding Pass (BCBP) string, as encoded in the PDF417 barcode. */
+  barcode: Scalars['String']['output'];
+  booking: Booking;
+  /** Relative URL of the PNG barcode, valid for 15 minutes; append format=pdf for a printable pass. */
+  downloadUrl: Scalars['String']['output'];
+  flight: Flight;
+  seatNumber: Scalars['String']['output'];
+  sequenceNumber: Scalars['Int']['output'];
+};
+
 export type Booking = {
   __typename?: 'Booking';
+  ancillaries: Array<BookingAncillary>;
   bookedAt: Scalars['Time']['output'];
   bookingReference: Scalars['String']['output'];
-  bookingStatus: Scalars['String']['output'];
+  bookingStatus: BookingStatus;
+  checkedInAt?: Maybe<Scalars['Time']['output']>;
+  deniedBoardingVolunteer: Scalars['Boolean']['output'];
+  document?: Maybe<PassengerDocument>;
   fare: Fare;
   fareId: Scalars['ID']['output'];
   flight: Flight;
@@ -30,51 +99,261 @@ export type Booking = {
   passengerName: Scalars['String']['output'];
   passengerPhone?: Maybe<Scalars['String']['output']>;
   seatNumber?: Maybe<Scalars['String']['output']>;
+  specialRequests?: Maybe<Scalars['String']['output']>;
   totalPrice: Scalars['Float']['output'];
 };
 
+export type BookingAncillary = {
+  __typename?: 'BookingAncillary';
+  createdAt: Scalars['Time']['output'];
+  id: Scalars['ID']['output'];
+  product: AncillaryProduct;
+  quantity: Scalars['Int']['output'];
+  totalPrice: Scalars['Float']['output'];
+  unitPrice: Scalars['Float']['output'];
+};
+
+export enum BookingStatus {
+  Cancelled = 'CANCELLED',
+  CheckedIn = 'CHECKED_IN',
+  Completed = 'COMPLETED',
+  Confirmed = 'CONFIRMED',
+  Disrupted = 'DISRUPTED'
+}
+
 export type CreateBookingInput = {
+  document?: InputMaybe<PassengerDocumentInput>;
   fareId: Scalars['ID']['input'];
   flightId: Scalars['ID']['input'];
   passengerEmail: Scalars['String']['input'];
   passengerName: Scalars['String']['input'];
   passengerPhone?: InputMaybe<Scalars['String']['input']>;
+  /** Picks the seat and buys seat selection with the booking; PROMO fares cannot. */
   seatNumber?: InputMaybe<Scalars['String']['input']>;
+  specialRequests?: InputMaybe<Scalars['String']['input']>;
+};
+
+export type CreateBookingPayload = {
+  __typename?: 'CreateBookingPayload';
+  booking: Booking;
+  expiresAt?: Maybe<Scalars['Time']['output']>;
+  /** Bearer token for the booking mutations on the new booking, as manageBooking returns; null when signed in. */
+  token?: Maybe<Scalars['String']['output']>;
+};
+
+export type DelayFlightInput = {
+  flightId: Scalars['ID']['input'];
+  newDeparture: Scalars['Time']['input'];
+  reason?: InputMaybe<Scalars['String']['input']>;
 };
 
+export type DeniedBoardingList = {
+  __typename?: 'DeniedBoardingList';
+  /** Remaining passengers in the order they are denied boarding involuntarily. */
+  candidates: Array<Booking>;
+  flight: Flight;
+  oversoldSeats: Scalars['Int']['output'];
+  /** Passengers who offered to give up their seat, in booking order. */
+  volunteers: Array<Booking>;
+};
+
+export type DisruptionReport = {
+  __typename?: 'DisruptionReport';
+  flight: Flight;
+  rebookedCount: Scalars['Int']['output'];
+  rebookings: Array<Rebooking>;
+  totalAffected: Scalars['Int']['output'];
+  unaccommodatedCount: Scalars['Int']['output'];
+};
+
+export enum DocumentType {
+  IdCard = 'ID_CARD',
+  Passport = 'PASSPORT'
+}
+
 export type Fare = {
   __typename?: 'Fare';
+  /** Seats left in the allocation, 0 once the fare is overbooked. */
   availableSeats: Scalars['Int']['output'];
   baggageAllowance: Scalars['Int']['output'];
-  bookings: Array<Booking>;
-  fareClass: Scalars['String']['output'];
+  /** Every passenger of the fare; null with an error for callers without the AGENT role. */
+  bookings?: Maybe<Array<Booking>>;
+  fareClass: FareClass;
   flight: Flight;
   flightId: Scalars['ID']['output'];
   id: Scalars['ID']['output'];
   isChangeable: Scalars['Boolean']['output'];
   isRefundable: Scalars['Boolean']['output'];
+  /** Seats this fare may be sold beyond its allocation. */
+  overbookingLimit: Scalars['Int']['output'];
+  /** Seats sold beyond the allocation; null with an error for callers without the OPS role. */
+  oversoldSeats?: Maybe<Scalars['Int']['output']>;
   price: Scalars['Float']['output'];
+  /** Passengers waiting for or holding an offer on this fare. */
+  waitlistCount: Scalars['Int']['output'];
 };
 
+export enum FareClass {
+  Basic = 'BASIC',
+  Pro = 'PRO',
+  Promo = 'PROMO'
+}
+
 export type Flight = {
   __typename?: 'Flight';
   aircraftType: Scalars['String']['output'];
   arrivalTime: Scalars['Time']['output'];
   availableSeats: Scalars['Int']['output'];
-  bookings: Array<Booking>;
+  /** Bookings currently holding a seat. */
+  bookedSeats: Scalars['Int']['output'];
+  /** Every passenger of the flight; null with an error for callers without the AGENT role. */
+  bookings?: Maybe<Array<Booking>>;
+  delayMinutes: Scalars['Int']['output'];
   departureTime: Scalars['Time']['output'];
   destination: Scalars['String']['output'];
   fares: Array<Fare>;
   flightNumber: Scalars['String']['output'];
+  gate?: Maybe<Scalars['String']['output']>;
   id: Scalars['ID']['output'];
   origin: Scalars['String']['output'];
-  status: Scalars['String']['output'];
+  /** Seats the flight may be sold beyond totalSeats, null when only fare limits apply. */
+  overbookingLimit?: Maybe<Scalars['Int']['output']>;
+  /** Seats sold beyond totalSeats; null with an error for callers without the OPS role. */
+  oversoldSeats?: Maybe<Scalars['Int']['output']>;
+  status: FlightStatus;
+  statusHistory: Array<FlightEvent>;
   totalSeats: Scalars['Int']['output'];
 };
 
+export type FlightEvent = {
+  __typename?: 'FlightEvent';
+  /** Email of the operations user who made the change. */
+  actor: Scalars['String']['output'];
+  delayMinutes?: Maybe<Scalars['Int']['output']>;
+  eventType: FlightEventType;
+  flightId: Scalars['ID']['output'];
+  gate?: Maybe<Scalars['String']['output']>;
+  id: Scalars['ID']['output'];
+  newStatus?: Maybe<FlightStatus>;
+  occurredAt: Scalars['Time']['output'];
+  previousStatus?: Maybe<FlightStatus>;
+  reason?: Maybe<Scalars['String']['output']>;
+};
+
+export enum FlightEventType {
+  Delayed = 'DELAYED',
+  GateAssigned = 'GATE_ASSIGNED',
+  StatusChanged = 'STATUS_CHANGED'
+}
+
+export type FlightManifest = {
+  __typename?: 'FlightManifest';
+  /**
+   * Relative URL of the CSV download, which needs the same access token; append
+   * format=pdf for a printable manifest.
+   */
+  downloadUrl: Scalars['String']['output'];
+  /** Bookings that are not cancelled, sorted by seat row; passengers without a seat come last. */
+  entries: Array<ManifestEntry>;
+  flight: Flight;
+};
+
+export enum FlightStatus {
+  Arrived = 'ARRIVED',
+  Boarding = 'BOARDING',
+  Cancelled = 'CANCELLED',
+  Departed = 'DEPARTED',
+  Scheduled = 'SCHEDULED'
+}
+
+/** Guest access to a booking, returned by manageBooking. */
+export type ManagedBooking = {
+  __typename?: 'ManagedBooking';
+  booking: Booking;
+  expiresAt: Scalars['Time']['output'];
+  /** Bearer token for the booking mutations on this booking. */
+  token: Scalars['String']['output'];
+};
+
+export type ManifestEntry = {
+  __typename?: 'ManifestEntry';
+  booking: Booking;
+  bookingReference: Scalars['String']['output'];
+  bookingStatus: BookingStatus;
+  fareClass: FareClass;
+  passengerName: Scalars['String']['output'];
+  seatNumber?: Maybe<Scalars['String']['output']>;
+  specialRequests?: Maybe<Scalars['String']['output']>;
+};
+
+/**
+ * Booking mutations act on bookings of the signed-in user or of the booking token
+ * returned by manageBooking, sent as the bearer token.
+ */
 export type Mutation = {
   __typename?: 'Mutation';
-  createBooking: Booking;
+  acceptWaitlistOffer: AcceptWaitlistOfferPayload;
+  addAncillary: Booking;
+  addPassengerDocument: Booking;
+  assignGate: Flight;
+  cancelBooking: Booking;
+  /** Cancels a booking for a passenger without their last name. */
+  cancelBookingOnBehalf: Booking;
+  checkIn: BoardingPass;
+  createBooking: CreateBookingPayload;
+  delayFlight: Flight;
+  joinWaitlist: WaitlistEntry;
+  login: AuthPayload;
+  logout: Scalars['Boolean']['output'];
+  /** Looks up a booking by reference and passenger last name and returns a booking token, valid for 15 minutes. */
+  manageBooking: ManagedBooking;
+  refreshToken: AuthPayload;
+  /** Changes the seat of a booking that bought seat selection, before check-in. */
+  selectSeat: Booking;
+  setOverbookingLimit: Flight;
+  signup: AuthPayload;
+  updateFlightStatus: Flight;
+  volunteerForDeniedBoarding: Booking;
+};
+
+
+export type MutationAcceptWaitlistOfferArgs = {
+  entryId: Scalars['ID']['input'];
+  passengerEmail: Scalars['String']['input'];
+};
+
+
+export type MutationAddAncillaryArgs = {
+  bookingReference: Scalars['String']['input'];
+  productId: Scalars['ID']['input'];
+  quantity: Scalars['Int']['input'];
+};
+
+
+export type MutationAddPassengerDocumentArgs = {
+  bookingReference: Scalars['String']['input'];
+  document: PassengerDocumentInput;
+};
+
+
+export type MutationAssignGateArgs = {
+  input: AssignGateInput;
+};
+
+
+export type MutationCancelBookingArgs = {
+  bookingReference: Scalars['String']['input'];
+};
+
+
+export type MutationCancelBookingOnBehalfArgs = {
+  bookingReference: Scalars['String']['input'];
+};
+
+
+export type MutationCheckInArgs = {
+  bookingReference: Scalars['String']['input'];
+  document?: InputMaybe<PassengerDocumentInput>;
 };
 
 
@@ -82,13 +361,124 @@ export type MutationCreateBookingArgs = {
   input: CreateBookingInput;
 };
 
+
+export type MutationDelayFlightArgs = {
+  input: DelayFlightInput;
+};
+
+
+export type MutationJoinWaitlistArgs = {
+  fareId: Scalars['ID']['input'];
+  passenger: PassengerInput;
+};
+
+
+export type MutationLoginArgs = {
+  email: Scalars['String']['input'];
+  password: Scalars['String']['input'];
+};
+
+
+export type MutationLogoutArgs = {
+  refreshToken: Scalars['String']['input'];
+};
+
+
+export type MutationManageBookingArgs = {
+  bookingReference: Scalars['String']['input'];
+  lastName: Scalars['String']['input'];
+};
+
+
+export type MutationRefreshTokenArgs = {
+  refreshToken: Scalars['String']['input'];
+};
+
+
+export type MutationSelectSeatArgs = {
+  bookingReference: Scalars['String']['input'];
+  seatNumber: Scalars['String']['input'];
+};
+
+
+export type MutationSetOverbookingLimitArgs = {
+  input: SetOverbookingLimitInput;
+};
+
+
+export type MutationSignupArgs = {
+  input: SignupInput;
+};
+
+
+export type MutationUpdateFlightStatusArgs = {
+  input: UpdateFlightStatusInput;
+};
+
+
+export type MutationVolunteerForDeniedBoardingArgs = {
+  bookingReference: Scalars['String']['input'];
+  volunteer: Scalars['Boolean']['input'];
+};
+
+/** Identity document of a passenger, without the sensitive details. */
+export type PassengerDocument = {
+  __typename?: 'PassengerDocument';
+  documentType: DocumentType;
+  /** YYYY-MM-DD */
+  expiryDate: Scalars['String']['output'];
+  issuingCountry: Scalars['String']['output'];
+  /** Document number with all but the last three characters masked. */
+  maskedNumber: Scalars['String']['output'];
+  nationality: Scalars['String']['output'];
+};
+
+/**
+ * Identity document of a passenger. Either the two lines of a passport machine
+ * readable zone (mrz) or the individual fields; dates are YYYY-MM-DD and country
+ * codes ISO 3166 alpha-3.
+ */
+export type PassengerDocumentInput = {
+  dateOfBirth?: InputMaybe<Scalars['String']['input']>;
+  documentNumber?: InputMaybe<Scalars['String']['input']>;
+  documentType?: InputMaybe<DocumentType>;
+  expiryDate?: InputMaybe<Scalars['String']['input']>;
+  issuingCountry?: InputMaybe<Scalars['String']['input']>;
+  mrz?: InputMaybe<Scalars['String']['input']>;
+  nationality?: InputMaybe<Scalars['String']['input']>;
+  sex?: InputMaybe<Scalars['String']['input']>;
+};
+
+export type PassengerInput = {
+  email: Scalars['String']['input'];
+  name: Scalars['String']['input'];
+  phone?: InputMaybe<Scalars['String']['input']>;
+};
+
 export type Query = {
   __typename?: 'Query';
   airports: Array<Scalars['String']['output']>;
+  ancillaryOffers: Array<AncillaryOffer>;
+  /**
+   * A booking of the signed-in user or of the caller's booking token; agents can
+   * read any booking.
+   */
   booking?: Maybe<Booking>;
+  /** Bookings of the signed-in user, optionally filtered by passenger email. */
   bookings: Array<Booking>;
+  deniedBoardingList?: Maybe<DeniedBoardingList>;
+  disruptionReport?: Maybe<DisruptionReport>;
   flight?: Maybe<Flight>;
+  flightManifest?: Maybe<FlightManifest>;
+  /** limit defaults to 50 and is capped at 100, like bookings. */
   flights: Array<Flight>;
+  /** The signed-in user, null for anonymous requests. */
+  me?: Maybe<User>;
+};
+
+
+export type QueryAncillaryOffersArgs = {
+  flightId: Scalars['ID']['input'];
 };
 
 
@@ -103,17 +493,130 @@ export type QueryBookingsArgs = {
 };
 
 
+export type QueryDeniedBoardingListArgs = {
+  flightId: Scalars['ID']['input'];
+};
+
+
+export type QueryDisruptionReportArgs = {
+  flightId: Scalars['ID']['input'];
+};
+
+
 export type QueryFlightArgs = {
   id: Scalars['ID']['input'];
 };
 
 
+export type QueryFlightManifestArgs = {
+  flightId: Scalars['ID']['input'];
+};
+
+
 export type QueryFlightsArgs = {
   destination?: InputMaybe<Scalars['String']['input']>;
   limit?: InputMaybe<Scalars['Int']['input']>;
   origin?: InputMaybe<Scalars['String']['input']>;
 };
 
+export type Rebooking = {
+  __typename?: 'Rebooking';
+  booking: Booking;
+  id: Scalars['ID']['output'];
+  newFare?: Maybe<Fare>;
+  newFlight?: Maybe<Flight>;
+  originalFare: Fare;
+  originalFlight: Flight;
+  outcome: RebookingOutcome;
+  processedAt: Scalars['Time']['output'];
+};
+
+export enum RebookingOutcome {
+  Rebooked = 'REBOOKED',
+  Unaccommodated = 'UNACCOMMODATED'
+}
+
+export enum Role {
+  /** Customer service, acts on bookings on behalf of passengers. */
+  Agent = 'AGENT',
+  Customer = 'CUSTOMER',
+  /** Operations, manages flights. */
+  Ops = 'OPS'
+}
+
+/**
+ * Overbooking limit of a flight, or of one of its fares when fareClass is set.
+ * Give either seats or a percentage of totalSeats; leave both out to clear it.
+ */
+export type SetOverbookingLimitInput = {
+  fareClass?: InputMaybe<FareClass>;
+  flightId: Scalars['ID']['input'];
+  percent?: InputMaybe<Scalars['Float']['input']>;
+  seats?: InputMaybe<Scalars['Int']['input']>;
+};
+
+export type SignupInput = {
+  email: Scalars['String']['input'];
+  name: Scalars['String']['input'];
+  /** 8 to 72 bytes. */
+  password: Scalars['String']['input'];
+};
+
+export type Subscription = {
+  __typename?: 'Subscription';
+  bookingUpdated: Booking;
+  flightStatusChanged: Flight;
+};
+
+
+export type SubscriptionBookingUpdatedArgs = {
+  bookingReference: Scalars['String']['input'];
+};
+
+
+export type SubscriptionFlightStatusChangedArgs = {
+  flightId: Scalars['ID']['input'];
+};
+
+export type UpdateFlightStatusInput = {
+  flightId: Scalars['ID']['input'];
+  reason?: InputMaybe<Scalars['String']['input']>;
+  status: FlightStatus;
+};
+
+export type User = {
+  __typename?: 'User';
+  /** Bookings made while signed in, the 50 most recent first. */
+  bookings: Array<Booking>;
+  createdAt: Scalars['Time']['output'];
+  email: Scalars['String']['output'];
+  id: Scalars['ID']['output'];
+  name: Scalars['String']['output'];
+  roles: Array<Role>;
+};
+
+export type WaitlistEntry = {
+  __typename?: 'WaitlistEntry';
+  booking?: Maybe<Booking>;
+  createdAt: Scalars['Time']['output'];
+  fare: Fare;
+  id: Scalars['ID']['output'];
+  offerExpiresAt?: Maybe<Scalars['Time']['output']>;
+  passengerEmail: Scalars['String']['output'];
+  passengerName: Scalars['String']['output'];
+  passengerPhone?: Maybe<Scalars['String']['output']>;
+  /** 1-based place in the queue while WAITING. */
+  position?: Maybe<Scalars['Int']['output']>;
+  status: WaitlistStatus;
+};
+
+export enum WaitlistStatus {
+  Booked = 'BOOKED',
+  Expired = 'EXPIRED',
+  Offered = 'OFFERED',
+  Waiting = 'WAITING'
+}
+
 export type SearchFlightsQueryVariables = Exact<{
   origin?: InputMaybe<Scalars['String']['input']>;
   destination?: InputMaybe<Scalars['String']['input']>;
@@ -121,14 +624,14 @@ export type SearchFlightsQueryVariables = Exact<{
 }>;
 
 
-export type SearchFlightsQuery = { __typename?: 'Query', flights: Array<{ __typename?: 'Flight', id: string, flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string, availableSeats: number, status: string, fares: Array<{ __typename?: 'Fare', id: string, fareClass: string, price: number, availableSeats: number }> }> };
+export type SearchFlightsQuery = { __typename?: 'Query', flights: Array<{ __typename?: 'Flight', id: string, flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string, availableSeats: number, status: FlightStatus, fares: Array<{ __typename?: 'Fare', id: string, fareClass: FareClass, price: number, availableSeats: number }> }> };
 
 export type GetFlightDetailsQueryVariables = Exact<{
   id: Scalars['ID']['input'];
 }>;
 
 
-export type GetFlightDetailsQuery = { __typename?: 'Query', flight?: { __typename?: 'Flight', id: string, flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string, totalSeats: number, availableSeats: number, status: string, fares: Array<{ __typename?: 'Fare', id: string, fareClass: string, price: number, baggageAllowance: number, isRefundable: boolean, isChangeable: boolean, availableSeats: number }> } | null };
+export type GetFlightDetailsQuery = { __typename?: 'Query', flight?: { __typename?: 'Flight', id: string, flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string, totalSeats: number, availableSeats: number, status: FlightStatus, fares: Array<{ __typename?: 'Fare', id: string, fareClass: FareClass, price: number, baggageAllowance: number, isRefundable: boolean, isChangeable: boolean, availableSeats: number }> } | null };
 
 export type GetAirportsQueryVariables = Exact<{ [key: string]: never; }>;
 
@@ -140,26 +643,35 @@ export type GetFlightWithBookingsQueryVariables = Exact<{
 }>;
 
 
-export type GetFlightWithBookingsQuery = { __typename?: 'Query', flight?: { __typename?: 'Flight', id: string, flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string, totalSeats: number, availableSeats: number, status: string, bookings: Array<{ __typename?: 'Booking', id: string, bookingReference: string, passengerName: string, passengerEmail: string, passengerPhone?: string | null, seatNumber?: string | null, totalPrice: number, bookingStatus: string, bookedAt: any, fare: { __typename?: 'Fare', fareClass: string, price: number } }> } | null };
+export type GetFlightWithBookingsQuery = { __typename?: 'Query', flight?: { __typename?: 'Flight', id: string, flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string, totalSeats: number, availableSeats: number, status: FlightStatus, bookings?: Array<{ __typename?: 'Booking', id: string, bookingReference: string, passengerName: string, passengerEmail: string, passengerPhone?: string | null, seatNumber?: string | null, totalPrice: number, bookingStatus: BookingStatus, bookedAt: any, fare: { __typename?: 'Fare', fareClass: FareClass, price: number } }> | null } | null };
 
 export type GetBookingByReferenceQueryVariables = Exact<{
   bookingReference: Scalars['String']['input'];
 }>;
 
 
-export type GetBookingByReferenceQuery = { __typename?: 'Query', booking?: { __typename?: 'Booking', id: string, bookingReference: string, passengerName: string, passengerEmail: string, passengerPhone?: string | null, seatNumber?: string | null, totalPrice: number, bookingStatus: string, bookedAt: any, flight: { __typename?: 'Flight', flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string, status: string }, fare: { __typename?: 'Fare', fareClass: string, price: number, baggageAllowance: number, isRefundable: boolean, isChangeable: boolean } } | null };
+export type GetBookingByReferenceQuery = { __typename?: 'Query', booking?: { __typename?: 'Booking', id: string, bookingReference: string, passengerName: string, passengerEmail: string, passengerPhone?: string | null, seatNumber?: string | null, totalPrice: number, bookingStatus: BookingStatus, bookedAt: any, flight: { __typename?: 'Flight', flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string, status: FlightStatus }, fare: { __typename?: 'Fare', fareClass: FareClass, price: number, baggageAllowance: number, isRefundable: boolean, isChangeable: boolean } } | null };
 
 export type CreateBookingMutationVariables = Exact<{
   input: CreateBookingInput;
 }>;
 
 
-export type CreateBookingMutation = { __typename?: 'Mutation', createBooking: { __typename?: 'Booking', id: string, bookingReference: string, passengerName: string, passengerEmail: string, passengerPhone?: string | null, seatNumber?: string | null, totalPrice: number, bookingStatus: string, bookedAt: any, flight: { __typename?: 'Flight', id: string, flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string }, fare: { __typename?: 'Fare', id: string, fareClass: string, price: number, baggageAllowance: number, isRefundable: boolean, isChangeable: boolean } } };
+export type CreateBookingMutation = { __typename?: 'Mutation', createBooking: { __typename?: 'CreateBookingPayload', booking: { __typename?: 'Booking', id: string, bookingReference: string, passengerName: string, passengerEmail: string, passengerPhone?: string | null, seatNumber?: string | null, totalPrice: number, bookingStatus: BookingStatus, bookedAt: any, flight: { __typename?: 'Flight', id: string, flightNumber: string, origin: string, destination: string, departureTime: any, arrivalTime: any, aircraftType: string }, fare: { __typename?: 'Fare', id: string, fareClass: FareClass, price: number, baggageAllowance: number, isRefundable: boolean, isChangeable: boolean } }, token?: string | null, expiresAt?: any | null } };
+
+export type LoginMutationVariables = Exact<{
+  email: Scalars['String']['input'];
+  password: Scalars['String']['input'];
+}>;
+
+
+export type LoginMutation = { __typename?: 'Mutation', login: { __typename?: 'AuthPayload', accessToken: string, accessTokenExpiresAt: any, user: { __typename?: 'User', id: string, email: string, name: string, roles: Array<Role> } } };
 
 
-export const SearchFlightsDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"SearchFlights"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"origin"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"destination"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"limit"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"Int"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"flights"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"origin"},"value":{"kind":"Variable","name":{"kind":"Name","value":"origin"}}},{"kind":"Argument","name":{"kind":"Name","value":"destination"},"value":{"kind":"Variable","name":{"kind":"Name","value":"destination"}}},{"kind":"Argument","name":{"kind":"Name","value":"limit"},"value":{"kind":"Variable","name":{"kind":"Name","value":"limit"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"fares"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}}]}}]}}]}}]} as unknown as DocumentNode<SearchFlightsQuery, SearchFlightsQueryVariables>;
-export const GetFlightDetailsDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetFlightDetails"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"flight"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}},{"kind":"Field","name":{"kind":"Name","value":"totalSeats"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"fares"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}},{"kind":"Field","name":{"kind":"Name","value":"baggageAllowance"}},{"kind":"Field","name":{"kind":"Name","value":"isRefundable"}},{"kind":"Field","name":{"kind":"Name","value":"isChangeable"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}}]}}]}}]}}]} as unknown as DocumentNode<GetFlightDetailsQuery, GetFlightDetailsQueryVariables>;
-export const GetAirportsDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetAirports"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"airports"}}]}}]} as unknown as DocumentNode<GetAirportsQuery, GetAirportsQueryVariables>;
-export const GetFlightWithBookingsDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetFlightWithBookings"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"flight"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}},{"kind":"Field","name":{"kind":"Name","value":"totalSeats"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"bookings"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"bookingReference"}},{"kind":"Field","name":{"kind":"Name","value":"passengerName"}},{"kind":"Field","name":{"kind":"Name","value":"passengerEmail"}},{"kind":"Field","name":{"kind":"Name","value":"passengerPhone"}},{"kind":"Field","name":{"kind":"Name","value":"seatNumber"}},{"kind":"Field","name":{"kind":"Name","value":"totalPrice"}},{"kind":"Field","name":{"kind":"Name","value":"bookingStatus"}},{"kind":"Field","name":{"kind":"Name","value":"bookedAt"}},{"kind":"Field","name":{"kind":"Name","value":"fare"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}}]}}]}}]}}]}}]} as unknown as DocumentNode<GetFlightWithBookingsQuery, GetFlightWithBookingsQueryVariables>;
-export const GetBookingByReferenceDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetBookingByReference"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"bookingReference"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"booking"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"bookingReference"},"value":{"kind":"Variable","name":{"kind":"Name","value":"bookingReference"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"bookingReference"}},{"kind":"Field","name":{"kind":"Name","value":"passengerName"}},{"kind":"Field","name":{"kind":"Name","value":"passengerEmail"}},{"kind":"Field","name":{"kind":"Name","value":"passengerPhone"}},{"kind":"Field","name":{"kind":"Name","value":"seatNumber"}},{"kind":"Field","name":{"kind":"Name","value":"totalPrice"}},{"kind":"Field","name":{"kind":"Name","value":"bookingStatus"}},{"kind":"Field","name":{"kind":"Name","value":"bookedAt"}},{"kind":"Field","name":{"kind":"Name","value":"flight"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}},{"kind":"Field","name":{"kind":"Name","value":"status"}}]}},{"kind":"Field","name":{"kind":"Name","value":"fare"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}},{"kind":"Field","name":{"kind":"Name","value":"baggageAllowance"}},{"kind":"Field","name":{"kind":"Name","value":"isRefundable"}},{"kind":"Field","name":{"kind":"Name","value":"isChangeable"}}]}}]}}]}}]} as unknown as DocumentNode<GetBookingByReferenceQuery, GetBookingByReferenceQueryVariables>;
-export const CreateBookingDocument = {"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"CreateBooking"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"input"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"CreateBookingInput"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"createBooking"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"input"},"value":{"kind":"Variable","name":{"kind":"Name","value":"input"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"bookingReference"}},{"kind":"Field","name":{"kind":"Name","value":"passengerName"}},{"kind":"Field","name":{"kind":"Name","value":"passengerEmail"}},{"kind":"Field","name":{"kind":"Name","value":"passengerPhone"}},{"kind":"Field","name":{"kind":"Name","value":"seatNumber"}},{"kind":"Field","name":{"kind":"Name","value":"totalPrice"}},{"kind":"Field","name":{"kind":"Name","value":"bookingStatus"}},{"kind":"Field","name":{"kind":"Name","value":"bookedAt"}},{"kind":"Field","name":{"kind":"Name","value":"flight"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}}]}},{"kind":"Field","name":{"kind":"Name","value":"fare"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}},{"kind":"Field","name":{"kind":"Name","value":"baggageAllowance"}},{"kind":"Field","name":{"kind":"Name","value":"isRefundable"}},{"kind":"Field","name":{"kind":"Name","value":"isChangeable"}}]}}]}}]}}]} as unknown as DocumentNode<CreateBookingMutation, CreateBookingMutationVariables>;
\ No newline at end of file
+export const SearchFlightsDocument = {"__meta__":{"hash":"d827f538f3c1c8a155c949761b952b392486bc5c8f7ae30e2d0fb43c10168a88"},"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"SearchFlights"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"origin"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"destination"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"limit"}},"type":{"kind":"NamedType","name":{"kind":"Name","value":"Int"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"flights"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"origin"},"value":{"kind":"Variable","name":{"kind":"Name","value":"origin"}}},{"kind":"Argument","name":{"kind":"Name","value":"destination"},"value":{"kind":"Variable","name":{"kind":"Name","value":"destination"}}},{"kind":"Argument","name":{"kind":"Name","value":"limit"},"value":{"kind":"Variable","name":{"kind":"Name","value":"limit"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"fares"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}}]}}]}}]}}]} as unknown as DocumentNode<SearchFlightsQuery, SearchFlightsQueryVariables>;
+export const GetFlightDetailsDocument = {"__meta__":{"hash":"e6dba3e27cbe60100ef611d9c86d5085aa274f2ba98ab04dafcc48433ca80e3c"},"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetFlightDetails"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"flight"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}},{"kind":"Field","name":{"kind":"Name","value":"totalSeats"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"fares"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}},{"kind":"Field","name":{"kind":"Name","value":"baggageAllowance"}},{"kind":"Field","name":{"kind":"Name","value":"isRefundable"}},{"kind":"Field","name":{"kind":"Name","value":"isChangeable"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}}]}}]}}]}}]} as unknown as DocumentNode<GetFlightDetailsQuery, GetFlightDetailsQueryVariables>;
+export const GetAirportsDocument = {"__meta__":{"hash":"477ad88b1f7d8fd7f06060ea2b854f2acecae0a2b2739c84987d738702da05b4"},"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetAirports"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"airports"}}]}}]} as unknown as DocumentNode<GetAirportsQuery, GetAirportsQueryVariables>;
+export const GetFlightWithBookingsDocument = {"__meta__":{"hash":"c1c4a38e1d0c1d4ab78a6ffdd60de5d1fa6ba304d5a347b720c7dc56d1333aa3"},"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetFlightWithBookings"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"id"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"ID"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"flight"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"id"},"value":{"kind":"Variable","name":{"kind":"Name","value":"id"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}},{"kind":"Field","name":{"kind":"Name","value":"totalSeats"}},{"kind":"Field","name":{"kind":"Name","value":"availableSeats"}},{"kind":"Field","name":{"kind":"Name","value":"status"}},{"kind":"Field","name":{"kind":"Name","value":"bookings"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"bookingReference"}},{"kind":"Field","name":{"kind":"Name","value":"passengerName"}},{"kind":"Field","name":{"kind":"Name","value":"passengerEmail"}},{"kind":"Field","name":{"kind":"Name","value":"passengerPhone"}},{"kind":"Field","name":{"kind":"Name","value":"seatNumber"}},{"kind":"Field","name":{"kind":"Name","value":"totalPrice"}},{"kind":"Field","name":{"kind":"Name","value":"bookingStatus"}},{"kind":"Field","name":{"kind":"Name","value":"bookedAt"}},{"kind":"Field","name":{"kind":"Name","value":"fare"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}}]}}]}}]}}]}}]} as unknown as DocumentNode<GetFlightWithBookingsQuery, GetFlightWithBookingsQueryVariables>;
+export const GetBookingByReferenceDocument = {"__meta__":{"hash":"19c11b3c3ef18a022fea9306c4555ccff362aba81413582ad4c2a1b81d80b027"},"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"query","name":{"kind":"Name","value":"GetBookingByReference"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"bookingReference"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"booking"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"bookingReference"},"value":{"kind":"Variable","name":{"kind":"Name","value":"bookingReference"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"bookingReference"}},{"kind":"Field","name":{"kind":"Name","value":"passengerName"}},{"kind":"Field","name":{"kind":"Name","value":"passengerEmail"}},{"kind":"Field","name":{"kind":"Name","value":"passengerPhone"}},{"kind":"Field","name":{"kind":"Name","value":"seatNumber"}},{"kind":"Field","name":{"kind":"Name","value":"totalPrice"}},{"kind":"Field","name":{"kind":"Name","value":"bookingStatus"}},{"kind":"Field","name":{"kind":"Name","value":"bookedAt"}},{"kind":"Field","name":{"kind":"Name","value":"flight"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}},{"kind":"Field","name":{"kind":"Name","value":"status"}}]}},{"kind":"Field","name":{"kind":"Name","value":"fare"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}},{"kind":"Field","name":{"kind":"Name","value":"baggageAllowance"}},{"kind":"Field","name":{"kind":"Name","value":"isRefundable"}},{"kind":"Field","name":{"kind":"Name","value":"isChangeable"}}]}}]}}]}}]} as unknown as DocumentNode<GetBookingByReferenceQuery, GetBookingByReferenceQueryVariables>;
+export const CreateBookingDocument = {"__meta__":{"hash":"45075680f4abb4e640cd79e5761179164c3341e2607b1e5b9af19c9ecbba68ec"},"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"CreateBooking"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"input"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"CreateBookingInput"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"createBooking"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"input"},"value":{"kind":"Variable","name":{"kind":"Name","value":"input"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"booking"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"bookingReference"}},{"kind":"Field","name":{"kind":"Name","value":"passengerName"}},{"kind":"Field","name":{"kind":"Name","value":"passengerEmail"}},{"kind":"Field","name":{"kind":"Name","value":"passengerPhone"}},{"kind":"Field","name":{"kind":"Name","value":"seatNumber"}},{"kind":"Field","name":{"kind":"Name","value":"totalPrice"}},{"kind":"Field","name":{"kind":"Name","value":"bookingStatus"}},{"kind":"Field","name":{"kind":"Name","value":"bookedAt"}},{"kind":"Field","name":{"kind":"Name","value":"flight"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"flightNumber"}},{"kind":"Field","name":{"kind":"Name","value":"origin"}},{"kind":"Field","name":{"kind":"Name","value":"destination"}},{"kind":"Field","name":{"kind":"Name","value":"departureTime"}},{"kind":"Field","name":{"kind":"Name","value":"arrivalTime"}},{"kind":"Field","name":{"kind":"Name","value":"aircraftType"}}]}},{"kind":"Field","name":{"kind":"Name","value":"fare"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"fareClass"}},{"kind":"Field","name":{"kind":"Name","value":"price"}},{"kind":"Field","name":{"kind":"Name","value":"baggageAllowance"}},{"kind":"Field","name":{"kind":"Name","value":"isRefundable"}},{"kind":"Field","name":{"kind":"Name","value":"isChangeable"}}]}}]}},{"kind":"Field","name":{"kind":"Name","value":"token"}},{"kind":"Field","name":{"kind":"Name","value":"expiresAt"}}]}}]}}]} as unknown as DocumentNode<CreateBookingMutation, CreateBookingMutationVariables>;
+export const LoginDocument = {"__meta__":{"hash":"5456d51bb861e23c5137aaa2d3511db287c562a65acdfa46e82c749abe9e8149"},"kind":"Document","definitions":[{"kind":"OperationDefinition","operation":"mutation","name":{"kind":"Name","value":"Login"},"variableDefinitions":[{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"email"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}},{"kind":"VariableDefinition","variable":{"kind":"Variable","name":{"kind":"Name","value":"password"}},"type":{"kind":"NonNullType","type":{"kind":"NamedType","name":{"kind":"Name","value":"String"}}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"login"},"arguments":[{"kind":"Argument","name":{"kind":"Name","value":"email"},"value":{"kind":"Variable","name":{"kind":"Name","value":"email"}}},{"kind":"Argument","name":{"kind":"Name","value":"password"},"value":{"kind":"Variable","name":{"kind":"Name","value":"password"}}}],"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"accessToken"}},{"kind":"Field","name":{"kind":"Name","value":"accessTokenExpiresAt"}},{"kind":"Field","name":{"kind":"Name","value":"user"},"selectionSet":{"kind":"SelectionSet","selections":[{"kind":"Field","name":{"kind":"Name","value":"id"}},{"kind":"Field","name":{"kind":"Name","value":"email"}},{"kind":"Field","name":{"kind":"Name","value":"name"}},{"kind":"Field","name":{"kind":"Name","value":"roles"}}]}}]}}]}}]} as unknown as DocumentNode<LoginMutation, LoginMutationVariables>;
\ No newline at end of file
@@ -0,0 +1 @@
+export * from "./gql";
\ No newline at end of file
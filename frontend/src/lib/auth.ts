@@ -0,0 +1,51 @@
+// Tokens live in sessionStorage with their expiry, so they last for the tab
+// and are dropped once the API would reject them.
+function saveToken(key: string, token: string, expiresAt: string) {
+  sessionStorage.setItem(key, JSON.stringify({ token, expiresAt }));
+}
+
+function getToken(key: string): string | null {
+  const stored = sessionStorage.getItem(key);
+  if (!stored) return null;
+
+  const { token, expiresAt } = JSON.parse(stored) as { token: string; expiresAt: string };
+  if (new Date(expiresAt) <= new Date()) {
+    sessionStorage.removeItem(key);
+    return null;
+  }
+  return token;
+}
+
+// Booking tokens let guests read and manage the bookings they made in this
+// tab, for 15 minutes.
+const bookingTokenKey = (bookingReference: string) => `bookingToken:${bookingReference}`;
+
+export function saveBookingToken(bookingReference: string, token: string, expiresAt: string) {
+  saveToken(bookingTokenKey(bookingReference), token, expiresAt);
+}
+
+export function getBookingToken(bookingReference: string): string | null {
+  return getToken(bookingTokenKey(bookingReference));
+}
+
+// The access token of the staff member signed in to the admin pages.
+const accessTokenKey = 'accessToken';
+
+export function saveAccessToken(token: string, expiresAt: string) {
+  saveToken(accessTokenKey, token, expiresAt);
+}
+
+export function getAccessToken(): string | null {
+  return getToken(accessTokenKey);
+}
+
+export function clearAccessToken() {
+  sessionStorage.removeItem(accessTokenKey);
+}
+
+// bearer returns the urql operation context sending token, or undefined to
+// send the request without one.
+export function bearer(token: string | null) {
+  if (!token) return undefined;
+  return { fetchOptions: { headers: { Authorization: `Bearer ${token}` } } };
+}
@@ -0,0 +1,3 @@
+// The API caps list queries such as flights at 100 items and returns 50 when
+// no limit is sent, so a page holding exactly the limit may leave results out.
+export const MAX_LIST_LIMIT = 100;
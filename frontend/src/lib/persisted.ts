@@ -0,0 +1,22 @@
+import { makeOperation, mapExchange } from 'urql';
+
+// trustedDocumentsExchange sends operations by the hash codegen embedded in
+// their document instead of their text, for a server that only runs the
+// documents of src/generated/persisted-documents.json
+// (TRUSTED_DOCUMENTS_MANIFEST). Such a server knows every hash, so the text
+// is never needed.
+export const trustedDocumentsExchange = mapExchange({
+  onOperation(operation) {
+    if (operation.kind === 'teardown' || !('__meta__' in operation.query)) return operation;
+
+    const { hash } = operation.query.__meta__ as { hash: string };
+    return makeOperation(
+      operation.kind,
+      {
+        ...operation,
+        extensions: { ...operation.extensions, persistedQuery: { version: 1, sha256Hash: hash } },
+      },
+      operation.context
+    );
+  },
+});
@@ -1,8 +1,15 @@
 import { createClient, cacheExchange, fetchExchange } from 'urql';
+import { trustedDocumentsExchange } from './persisted';
+
+// Set VITE_TRUSTED_DOCUMENTS=true when the API runs with
+// TRUSTED_DOCUMENTS_MANIFEST; otherwise operations are sent in full.
+const trustedDocuments = import.meta.env.VITE_TRUSTED_DOCUMENTS === 'true';
 
 export const client = createClient({
   url: 'http://localhost:8080/query',
-  exchanges: [cacheExchange, fetchExchange],
+  exchanges: trustedDocuments
+    ? [cacheExchange, trustedDocumentsExchange, fetchExchange]
+    : [cacheExchange, fetchExchange],
   fetchOptions: () => ({
     method: 'POST',
     headers: {
@@ -1,8 +1,11 @@
-import { useState } from 'react';
+import { useEffect, useMemo, useState } from 'react';
 import { useQuery } from 'urql';
 import { SearchForm } from '../components/SearchForm';
 import { Loading } from '../components/Loading';
+import { AdminLogin } from '../components/AdminLogin';
 import { SearchFlightsDocument, GetFlightWithBookingsDocument } from '../generated/graphql';
+import { bearer, clearAccessToken, getAccessToken } from '../lib/auth';
+import { MAX_LIST_LIMIT } from '../lib/limits';
 import { formatDateTime, formatPrice, formatTime, formatStatus } from '../utils/formatters';
 
 export function AdminBookings() {
@@ -12,16 +15,29 @@ export function AdminBookings() {
 
   const [flightsResult] = useQuery({
     query: SearchFlightsDocument,
-    variables: { origin, destination, limit: 50 },
+    variables: { origin, destination, limit: MAX_LIST_LIMIT },
     pause: !origin && !destination,
   });
 
+  const [accessToken, setAccessToken] = useState(getAccessToken);
+  const context = useMemo(() => bearer(accessToken), [accessToken]);
+
   const [flightWithBookingsResult] = useQuery({
     query: GetFlightWithBookingsDocument,
     variables: { id: selectedFlightId! },
-    pause: !selectedFlightId,
+    pause: !selectedFlightId || !accessToken,
+    context,
   });
 
+  // An expired or revoked token is rejected with 401: sign in again.
+  const rejected = flightWithBookingsResult.error?.response?.status === 401;
+  useEffect(() => {
+    if (rejected) {
+      clearAccessToken();
+      setAccessToken(null);
+    }
+  }, [rejected]);
+
   const handleSearch = (searchOrigin: string, searchDestination: string) => {
     setOrigin(searchOrigin || undefined);
     setDestination(searchDestination || undefined);
@@ -36,6 +52,23 @@ export function AdminBookings() {
     setSelectedFlightId(null);
   };
 
+  const handleSignOut = () => {
+    clearAccessToken();
+    setAccessToken(null);
+  };
+
+  if (!accessToken) {
+    return (
+      <div className="admin-container">
+        <div className="admin-header">
+          <h1>Admin - Flight Bookings</h1>
+          <p>Sign in with an agent account to view passenger lists</p>
+        </div>
+        <AdminLogin onSignIn={setAccessToken} />
+      </div>
+    );
+  }
+
   if (selectedFlightId) {
     const { data, fetching, error } = flightWithBookingsResult;
 
@@ -161,6 +194,13 @@ export function AdminBookings() {
       <div className="admin-header">
         <h1>Admin - Flight Bookings</h1>
         <p>Search for a flight to view all bookings</p>
+        <button
+          onClick={handleSignOut}
+          className="btn btn-secondary btn-sm"
+          style={{ marginTop: '1rem' }}
+        >
+          Sign Out
+        </button>
       </div>
 
       <SearchForm onSearch={handleSearch} />
@@ -235,6 +275,11 @@ export function AdminBookings() {
                 </tbody>
               </table>
             </div>
+            {flightsResult.data.flights.length === MAX_LIST_LIMIT && (
+              <p className="list-truncated">
+                Showing the first {MAX_LIST_LIMIT} flights. Narrow your search to see the rest.
+              </p>
+            )}
           </div>
         )}
     </div>
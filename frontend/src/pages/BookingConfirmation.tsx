@@ -1,17 +1,26 @@
+import { useMemo } from 'react';
 import { useParams, useNavigate } from 'react-router-dom';
 import { useQuery } from 'urql';
 import { Loading } from '../components/Loading';
 import { formatDateTime, formatPrice, formatStatus } from '../utils/formatters';
 import { GetBookingByReferenceDocument } from '../generated/graphql';
+import { bearer, getBookingToken } from '../lib/auth';
 
 export function BookingConfirmation() {
   const { bookingReference } = useParams<{ bookingReference: string }>();
   const navigate = useNavigate();
 
+  // Guests read their booking with the token createBooking returned.
+  const context = useMemo(
+    () => bearer(bookingReference ? getBookingToken(bookingReference) : null),
+    [bookingReference]
+  );
+
   const [result] = useQuery({
     query: GetBookingByReferenceDocument,
     variables: { bookingReference: bookingReference || '' },
     pause: !bookingReference,
+    context,
   });
 
   const { data, fetching, error } = result;
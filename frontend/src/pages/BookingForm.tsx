@@ -5,6 +5,7 @@ import { useQuery, useMutation } from 'urql';
 import { Loading } from '../components/Loading';
 import { formatDateTime, formatPrice } from '../utils/formatters';
 import { GetFlightDetailsDocument, CreateBookingDocument } from '../generated/graphql';
+import { saveBookingToken } from '../lib/auth';
 
 export function BookingForm() {
   const { flightId, fareId } = useParams<{ flightId: string; fareId: string }>();
@@ -127,7 +128,11 @@ export function BookingForm() {
       }
 
       if (result.data?.createBooking) {
-        navigate(`/confirmation/${result.data.createBooking.bookingReference}`);
+        const { booking, token, expiresAt } = result.data.createBooking;
+        if (token && expiresAt) {
+          saveBookingToken(booking.bookingReference, token, expiresAt);
+        }
+        navigate(`/confirmation/${booking.bookingReference}`);
       }
     } catch (err) {
       setSubmitError('An unexpected error occurred. Please try again.');
@@ -236,7 +241,7 @@ export function BookingForm() {
           </div>
 
           <div className="form-group">
-            <label htmlFor="seatNumber">Seat Preference (Optional)</label>
+            <label htmlFor="seatNumber">Seat Selection (Optional, extra charge)</label>
             <input
               type="text"
               id="seatNumber"
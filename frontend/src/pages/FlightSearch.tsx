@@ -5,6 +5,7 @@ import { SearchForm } from '../components/SearchForm';
 import { FlightCard } from '../components/FlightCard';
 import { Loading } from '../components/Loading';
 import { SearchFlightsDocument } from '../generated/graphql';
+import { MAX_LIST_LIMIT } from '../lib/limits';
 
 export function FlightSearch() {
   const [origin, setOrigin] = useState<string | undefined>(undefined);
@@ -13,7 +14,7 @@ export function FlightSearch() {
 
   const [result] = useQuery({
     query: SearchFlightsDocument,
-    variables: { origin, destination, limit: 50 },
+    variables: { origin, destination, limit: MAX_LIST_LIMIT },
     pause: !origin && !destination,
   });
 
@@ -68,6 +69,11 @@ export function FlightSearch() {
               onClick={() => navigate(`/flights/${flight.id}`)}
             />
           ))}
+          {data.flights.length === MAX_LIST_LIMIT && (
+            <p className="list-truncated">
+              Showing the first {MAX_LIST_LIMIT} flights. Narrow your search to see the rest.
+            </p>
+          )}
         </div>
       )}
     </div>